@@ -33,6 +33,17 @@ const (
 	// Triton Inference Server.
 	ModelFormat_MODEL_FORMAT_NVIDIA_TRITON ModelFormat = 3
 	ModelFormat_MODEL_FORMAT_OLLAMA        ModelFormat = 4
+	// Model format for ONNX Runtime. The model files include one or more .onnx files.
+	ModelFormat_MODEL_FORMAT_ONNX ModelFormat = 5
+	// Model format for MLX on Apple silicon. The model files are in the Hugging Face layout and quantized by MLX.
+	ModelFormat_MODEL_FORMAT_MLX ModelFormat = 6
+	// Model format of safetensors files quantized with GPTQ.
+	ModelFormat_MODEL_FORMAT_GPTQ ModelFormat = 7
+	// Model format of safetensors files quantized with AWQ.
+	ModelFormat_MODEL_FORMAT_AWQ ModelFormat = 8
+	// Model format of a directory that vLLM can serve as it is. The directory has config.json, a tokenizer,
+	// and the weights in safetensors files.
+	ModelFormat_MODEL_FORMAT_VLLM ModelFormat = 9
 )
 
 // Enum value maps for ModelFormat.
@@ -43,6 +54,11 @@ var (
 		2: "MODEL_FORMAT_HUGGING_FACE",
 		3: "MODEL_FORMAT_NVIDIA_TRITON",
 		4: "MODEL_FORMAT_OLLAMA",
+		5: "MODEL_FORMAT_ONNX",
+		6: "MODEL_FORMAT_MLX",
+		7: "MODEL_FORMAT_GPTQ",
+		8: "MODEL_FORMAT_AWQ",
+		9: "MODEL_FORMAT_VLLM",
 	}
 	ModelFormat_value = map[string]int32{
 		"MODEL_FORMAT_UNSPECIFIED":   0,
@@ -50,6 +66,11 @@ var (
 		"MODEL_FORMAT_HUGGING_FACE":  2,
 		"MODEL_FORMAT_NVIDIA_TRITON": 3,
 		"MODEL_FORMAT_OLLAMA":        4,
+		"MODEL_FORMAT_ONNX":          5,
+		"MODEL_FORMAT_MLX":           6,
+		"MODEL_FORMAT_GPTQ":          7,
+		"MODEL_FORMAT_AWQ":           8,
+		"MODEL_FORMAT_VLLM":          9,
 	}
 )
 
@@ -92,6 +113,13 @@ const (
 	ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED ModelLoadingStatus = 3
 	// Failed status when the model loading is failed.
 	ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED ModelLoadingStatus = 4
+	// Cancelling status when the cancellation of the loading is requested, but the loader has not stopped yet.
+	ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING ModelLoadingStatus = 5
+	// Cancelled status when the model loading is cancelled.
+	ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED ModelLoadingStatus = 6
+	// Degraded status when the files of a loaded model are missing or partially present in the object store.
+	// The reason is set in loading_failure_reason. The model can be loaded again with RetryModelLoad.
+	ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED ModelLoadingStatus = 7
 )
 
 // Enum value maps for ModelLoadingStatus.
@@ -102,6 +130,9 @@ var (
 		2: "MODEL_LOADING_STATUS_LOADING",
 		3: "MODEL_LOADING_STATUS_SUCCEEDED",
 		4: "MODEL_LOADING_STATUS_FAILED",
+		5: "MODEL_LOADING_STATUS_CANCELLING",
+		6: "MODEL_LOADING_STATUS_CANCELLED",
+		7: "MODEL_LOADING_STATUS_DEGRADED",
 	}
 	ModelLoadingStatus_value = map[string]int32{
 		"MODEL_LOADING_STATUS_UNSPECIFIED": 0,
@@ -109,6 +140,9 @@ var (
 		"MODEL_LOADING_STATUS_LOADING":     2,
 		"MODEL_LOADING_STATUS_SUCCEEDED":   3,
 		"MODEL_LOADING_STATUS_FAILED":      4,
+		"MODEL_LOADING_STATUS_CANCELLING":  5,
+		"MODEL_LOADING_STATUS_CANCELLED":   6,
+		"MODEL_LOADING_STATUS_DEGRADED":    7,
 	}
 )
 
@@ -147,6 +181,12 @@ const (
 	SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE SourceRepository = 2
 	SourceRepository_SOURCE_REPOSITORY_OLLAMA       SourceRepository = 3
 	SourceRepository_SOURCE_REPOSITORY_FINE_TUNING  SourceRepository = 4
+	// OCI registries. The model ID is a reference of the form "<registry>/<repository>:<tag>"
+	// or "<registry>/<repository>@<digest>".
+	SourceRepository_SOURCE_REPOSITORY_OCI SourceRepository = 5
+	// HTTP(S) servers. The model file or the archive of the model files (.tar, .tar.gz, or .zip)
+	// is downloaded from the URL in model_file_location.
+	SourceRepository_SOURCE_REPOSITORY_HTTP SourceRepository = 6
 )
 
 // Enum value maps for SourceRepository.
@@ -157,6 +197,8 @@ var (
 		2: "SOURCE_REPOSITORY_HUGGING_FACE",
 		3: "SOURCE_REPOSITORY_OLLAMA",
 		4: "SOURCE_REPOSITORY_FINE_TUNING",
+		5: "SOURCE_REPOSITORY_OCI",
+		6: "SOURCE_REPOSITORY_HTTP",
 	}
 	SourceRepository_value = map[string]int32{
 		"SOURCE_REPOSITORY_UNSPECIFIED":  0,
@@ -164,6 +206,8 @@ var (
 		"SOURCE_REPOSITORY_HUGGING_FACE": 2,
 		"SOURCE_REPOSITORY_OLLAMA":       3,
 		"SOURCE_REPOSITORY_FINE_TUNING":  4,
+		"SOURCE_REPOSITORY_OCI":          5,
+		"SOURCE_REPOSITORY_HTTP":         6,
 	}
 )
 
@@ -243,6 +287,81 @@ func (ActivationStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{3}
 }
 
+type ModelEventType int32
+
+const (
+	ModelEventType_MODEL_EVENT_TYPE_UNSPECIFIED ModelEventType = 0
+	ModelEventType_MODEL_EVENT_TYPE_CREATED     ModelEventType = 1
+	// MODEL_EVENT_TYPE_LOADING_PROGRESS is sent when a loader reports the progress of loading.
+	ModelEventType_MODEL_EVENT_TYPE_LOADING_PROGRESS ModelEventType = 2
+	ModelEventType_MODEL_EVENT_TYPE_LOADED           ModelEventType = 3
+	ModelEventType_MODEL_EVENT_TYPE_FAILED           ModelEventType = 4
+	ModelEventType_MODEL_EVENT_TYPE_ACTIVATED        ModelEventType = 5
+	ModelEventType_MODEL_EVENT_TYPE_DEACTIVATED      ModelEventType = 6
+	ModelEventType_MODEL_EVENT_TYPE_CONFIG_UPDATED   ModelEventType = 7
+	ModelEventType_MODEL_EVENT_TYPE_DELETED          ModelEventType = 8
+	ModelEventType_MODEL_EVENT_TYPE_CANCELLED        ModelEventType = 9
+	// MODEL_EVENT_TYPE_DEGRADED is sent when the files of a loaded model are found missing in the object store.
+	ModelEventType_MODEL_EVENT_TYPE_DEGRADED ModelEventType = 10
+)
+
+// Enum value maps for ModelEventType.
+var (
+	ModelEventType_name = map[int32]string{
+		0:  "MODEL_EVENT_TYPE_UNSPECIFIED",
+		1:  "MODEL_EVENT_TYPE_CREATED",
+		2:  "MODEL_EVENT_TYPE_LOADING_PROGRESS",
+		3:  "MODEL_EVENT_TYPE_LOADED",
+		4:  "MODEL_EVENT_TYPE_FAILED",
+		5:  "MODEL_EVENT_TYPE_ACTIVATED",
+		6:  "MODEL_EVENT_TYPE_DEACTIVATED",
+		7:  "MODEL_EVENT_TYPE_CONFIG_UPDATED",
+		8:  "MODEL_EVENT_TYPE_DELETED",
+		9:  "MODEL_EVENT_TYPE_CANCELLED",
+		10: "MODEL_EVENT_TYPE_DEGRADED",
+	}
+	ModelEventType_value = map[string]int32{
+		"MODEL_EVENT_TYPE_UNSPECIFIED":      0,
+		"MODEL_EVENT_TYPE_CREATED":          1,
+		"MODEL_EVENT_TYPE_LOADING_PROGRESS": 2,
+		"MODEL_EVENT_TYPE_LOADED":           3,
+		"MODEL_EVENT_TYPE_FAILED":           4,
+		"MODEL_EVENT_TYPE_ACTIVATED":        5,
+		"MODEL_EVENT_TYPE_DEACTIVATED":      6,
+		"MODEL_EVENT_TYPE_CONFIG_UPDATED":   7,
+		"MODEL_EVENT_TYPE_DELETED":          8,
+		"MODEL_EVENT_TYPE_CANCELLED":        9,
+		"MODEL_EVENT_TYPE_DEGRADED":         10,
+	}
+)
+
+func (x ModelEventType) Enum() *ModelEventType {
+	p := new(ModelEventType)
+	*p = x
+	return p
+}
+
+func (x ModelEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModelEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_model_manager_service_proto_enumTypes[4].Descriptor()
+}
+
+func (ModelEventType) Type() protoreflect.EnumType {
+	return &file_api_v1_model_manager_service_proto_enumTypes[4]
+}
+
+func (x ModelEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModelEventType.Descriptor instead.
+func (ModelEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{4}
+}
+
 type AdapterType int32
 
 const (
@@ -276,11 +395,11 @@ func (x AdapterType) String() string {
 }
 
 func (AdapterType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_model_manager_service_proto_enumTypes[4].Descriptor()
+	return file_api_v1_model_manager_service_proto_enumTypes[5].Descriptor()
 }
 
 func (AdapterType) Type() protoreflect.EnumType {
-	return &file_api_v1_model_manager_service_proto_enumTypes[4]
+	return &file_api_v1_model_manager_service_proto_enumTypes[5]
 }
 
 func (x AdapterType) Number() protoreflect.EnumNumber {
@@ -289,7 +408,7 @@ func (x AdapterType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AdapterType.Descriptor instead.
 func (AdapterType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{4}
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{5}
 }
 
 type QuantizationType int32
@@ -325,11 +444,11 @@ func (x QuantizationType) String() string {
 }
 
 func (QuantizationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_model_manager_service_proto_enumTypes[5].Descriptor()
+	return file_api_v1_model_manager_service_proto_enumTypes[6].Descriptor()
 }
 
 func (QuantizationType) Type() protoreflect.EnumType {
-	return &file_api_v1_model_manager_service_proto_enumTypes[5]
+	return &file_api_v1_model_manager_service_proto_enumTypes[6]
 }
 
 func (x QuantizationType) Number() protoreflect.EnumNumber {
@@ -338,7 +457,7 @@ func (x QuantizationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use QuantizationType.Descriptor instead.
 func (QuantizationType) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{5}
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{6}
 }
 
 // ModelFormats is a list of model formats. Used to store marshalled data into a store.
@@ -389,21 +508,19 @@ func (x *ModelFormats) GetFormats() []ModelFormat {
 	return nil
 }
 
-type ModelConfig struct {
+// ModelSize is the size of the model files in the object store.
+type ModelSize struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// runtime_config is the runtime configuration of the model.
-	// This can be unset if the configuration is determined by the runtime configuration
-	// of inference-manager-engine running in the cluster.
-	RuntimeConfig *ModelConfig_RuntimeConfig `protobuf:"bytes,1,opt,name=runtime_config,json=runtimeConfig,proto3" json:"runtime_config,omitempty"`
-	// cluster_allocation_policy defines the policy to allocate the model to clusters.
-	ClusterAllocationPolicy *ModelConfig_ClusterAllocationPolicy `protobuf:"bytes,2,opt,name=cluster_allocation_policy,json=clusterAllocationPolicy,proto3" json:"cluster_allocation_policy,omitempty"`
+	// total_bytes is the total size of the model files.
+	TotalBytes  int64                   `protobuf:"varint,1,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	FormatSizes []*ModelSize_FormatSize `protobuf:"bytes,2,rep,name=format_sizes,json=formatSizes,proto3" json:"format_sizes,omitempty"`
 }
 
-func (x *ModelConfig) Reset() {
-	*x = ModelConfig{}
+func (x *ModelSize) Reset() {
+	*x = ModelSize{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -411,13 +528,13 @@ func (x *ModelConfig) Reset() {
 	}
 }
 
-func (x *ModelConfig) String() string {
+func (x *ModelSize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelConfig) ProtoMessage() {}
+func (*ModelSize) ProtoMessage() {}
 
-func (x *ModelConfig) ProtoReflect() protoreflect.Message {
+func (x *ModelSize) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -429,42 +546,47 @@ func (x *ModelConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelConfig.ProtoReflect.Descriptor instead.
-func (*ModelConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelSize.ProtoReflect.Descriptor instead.
+func (*ModelSize) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ModelConfig) GetRuntimeConfig() *ModelConfig_RuntimeConfig {
+func (x *ModelSize) GetTotalBytes() int64 {
 	if x != nil {
-		return x.RuntimeConfig
+		return x.TotalBytes
 	}
-	return nil
+	return 0
 }
 
-func (x *ModelConfig) GetClusterAllocationPolicy() *ModelConfig_ClusterAllocationPolicy {
+func (x *ModelSize) GetFormatSizes() []*ModelSize_FormatSize {
 	if x != nil {
-		return x.ClusterAllocationPolicy
+		return x.FormatSizes
 	}
 	return nil
 }
 
-type ProjectAssignment struct {
+// GGUFMetadata is the metadata extracted from the header of a GGUF file.
+type GGUFMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// cluster_id specifies a cluster assigned to this project. It is empty
-	// when the namespace of any registered clusters is assigned to this project.
-	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// kueue_queue_name is the name of the Kueue queue assigned to this project.
-	KueueQueueName string `protobuf:"bytes,3,opt,name=kueue_queue_name,json=kueueQueueName,proto3" json:"kueue_queue_name,omitempty"`
-	// node_selector specifies nodes where this project can run its workloads.
-	NodeSelector []*ProjectAssignment_NodeSelector `protobuf:"bytes,4,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty"`
-}
-
-func (x *ProjectAssignment) Reset() {
-	*x = ProjectAssignment{}
+	// architecture is the architecture of the model (e.g., "llama").
+	Architecture string `protobuf:"bytes,1,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// parameter_count is the number of the parameters. It is zero if the model is split into multiple files.
+	ParameterCount int64 `protobuf:"varint,2,opt,name=parameter_count,json=parameterCount,proto3" json:"parameter_count,omitempty"`
+	// context_length is the context length that the model was trained with.
+	ContextLength int64 `protobuf:"varint,3,opt,name=context_length,json=contextLength,proto3" json:"context_length,omitempty"`
+	// quantization_type is the quantization type of the model (e.g., "Q4_K_M", "Q8_0").
+	QuantizationType string `protobuf:"bytes,4,opt,name=quantization_type,json=quantizationType,proto3" json:"quantization_type,omitempty"`
+	// tokenizer is the model of the tokenizer (e.g., "gpt2").
+	Tokenizer string `protobuf:"bytes,5,opt,name=tokenizer,proto3" json:"tokenizer,omitempty"`
+	// chat_template is the Jinja template of the chat messages.
+	ChatTemplate string `protobuf:"bytes,6,opt,name=chat_template,json=chatTemplate,proto3" json:"chat_template,omitempty"`
+}
+
+func (x *GGUFMetadata) Reset() {
+	*x = GGUFMetadata{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,13 +594,13 @@ func (x *ProjectAssignment) Reset() {
 	}
 }
 
-func (x *ProjectAssignment) String() string {
+func (x *GGUFMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectAssignment) ProtoMessage() {}
+func (*GGUFMetadata) ProtoMessage() {}
 
-func (x *ProjectAssignment) ProtoReflect() protoreflect.Message {
+func (x *GGUFMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,53 +612,68 @@ func (x *ProjectAssignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectAssignment.ProtoReflect.Descriptor instead.
-func (*ProjectAssignment) Descriptor() ([]byte, []int) {
+// Deprecated: Use GGUFMetadata.ProtoReflect.Descriptor instead.
+func (*GGUFMetadata) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ProjectAssignment) GetClusterId() string {
+func (x *GGUFMetadata) GetArchitecture() string {
 	if x != nil {
-		return x.ClusterId
+		return x.Architecture
 	}
 	return ""
 }
 
-func (x *ProjectAssignment) GetNamespace() string {
+func (x *GGUFMetadata) GetParameterCount() int64 {
 	if x != nil {
-		return x.Namespace
+		return x.ParameterCount
+	}
+	return 0
+}
+
+func (x *GGUFMetadata) GetContextLength() int64 {
+	if x != nil {
+		return x.ContextLength
+	}
+	return 0
+}
+
+func (x *GGUFMetadata) GetQuantizationType() string {
+	if x != nil {
+		return x.QuantizationType
 	}
 	return ""
 }
 
-func (x *ProjectAssignment) GetKueueQueueName() string {
+func (x *GGUFMetadata) GetTokenizer() string {
 	if x != nil {
-		return x.KueueQueueName
+		return x.Tokenizer
 	}
 	return ""
 }
 
-func (x *ProjectAssignment) GetNodeSelector() []*ProjectAssignment_NodeSelector {
+func (x *GGUFMetadata) GetChatTemplate() string {
 	if x != nil {
-		return x.NodeSelector
+		return x.ChatTemplate
 	}
-	return nil
+	return ""
 }
 
-type Project struct {
+// FormatArtifact is the artifact of a model in a format.
+type FormatArtifact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// assignments is the list of assigned clusters and namespaces for the project.
-	Assignments []*ProjectAssignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
-	// kubernetes_namespace is the namespace where the fine-tuning jobs for the project run.
-	KubernetesNamespace string `protobuf:"bytes,3,opt,name=kubernetes_namespace,json=kubernetesNamespace,proto3" json:"kubernetes_namespace,omitempty"`
+	Format ModelFormat `protobuf:"varint,1,opt,name=format,proto3,enum=llmariner.models.server.v1.ModelFormat" json:"format,omitempty"`
+	// path is the path of the entry point of the format (e.g., the GGUF file, the directory of the model).
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// attributes are the format-specific attributes of the artifact (e.g., "quantization_type" of a GGUF file).
+	Attributes map[string]string `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *Project) Reset() {
-	*x = Project{}
+func (x *FormatArtifact) Reset() {
+	*x = FormatArtifact{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -544,13 +681,13 @@ func (x *Project) Reset() {
 	}
 }
 
-func (x *Project) String() string {
+func (x *FormatArtifact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Project) ProtoMessage() {}
+func (*FormatArtifact) ProtoMessage() {}
 
-func (x *Project) ProtoReflect() protoreflect.Message {
+func (x *FormatArtifact) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -562,68 +699,43 @@ func (x *Project) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Project.ProtoReflect.Descriptor instead.
-func (*Project) Descriptor() ([]byte, []int) {
+// Deprecated: Use FormatArtifact.ProtoReflect.Descriptor instead.
+func (*FormatArtifact) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Project) GetId() string {
+func (x *FormatArtifact) GetFormat() ModelFormat {
 	if x != nil {
-		return x.Id
+		return x.Format
 	}
-	return ""
+	return ModelFormat_MODEL_FORMAT_UNSPECIFIED
 }
 
-func (x *Project) GetAssignments() []*ProjectAssignment {
+func (x *FormatArtifact) GetPath() string {
 	if x != nil {
-		return x.Assignments
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *Project) GetKubernetesNamespace() string {
+func (x *FormatArtifact) GetAttributes() map[string]string {
 	if x != nil {
-		return x.KubernetesNamespace
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
-type Model struct {
+// FormatArtifactAttributes is the attributes of a format artifact. Used to store marshalled data into a store.
+type FormatArtifactAttributes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	Object  string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
-	OwnedBy string `protobuf:"bytes,4,opt,name=owned_by,json=ownedBy,proto3" json:"owned_by,omitempty"`
-	// loading_status is set when the model is being loaded.
-	// This is not in the Open AI API specification.
-	LoadingStatus ModelLoadingStatus `protobuf:"varint,5,opt,name=loading_status,json=loadingStatus,proto3,enum=llmariner.models.server.v1.ModelLoadingStatus" json:"loading_status,omitempty"`
-	// source_repository is the source repository of the model. Set for base models created from the CreateModel API request.
-	// This is not in the Open AI API specification.
-	SourceRepository SourceRepository `protobuf:"varint,6,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
-	// loading_failure_reason is set when the model loading is failed to show the failure reason.
-	// This is not in the Open AI API specification.
-	LoadingFailureReason string `protobuf:"bytes,7,opt,name=loading_failure_reason,json=loadingFailureReason,proto3" json:"loading_failure_reason,omitempty"`
-	// loading_status_message is a message to show the loading status of the model. It is set
-	// when the loading_status is MODEL_LOADING_STATUS_LOADING or MODEL_LOADING_STATUS_FAILED.
-	LoadingStatusMessage string `protobuf:"bytes,13,opt,name=loading_status_message,json=loadingStatusMessage,proto3" json:"loading_status_message,omitempty"`
-	// formats is the supported formats of the model.
-	// This is not in the Open AI API specification.
-	Formats []ModelFormat `protobuf:"varint,8,rep,packed,name=formats,proto3,enum=llmariner.models.server.v1.ModelFormat" json:"formats,omitempty"`
-	// This is not in the Open AI API specification.
-	IsBaseModel bool `protobuf:"varint,9,opt,name=is_base_model,json=isBaseModel,proto3" json:"is_base_model,omitempty"`
-	// base_model_id is the ID of the base model. Only set if the model is a fine-tuned model.
-	// This is not in the Open AI API specification.
-	BaseModelId      string           `protobuf:"bytes,10,opt,name=base_model_id,json=baseModelId,proto3" json:"base_model_id,omitempty"`
-	ActivationStatus ActivationStatus `protobuf:"varint,11,opt,name=activation_status,json=activationStatus,proto3,enum=llmariner.models.server.v1.ActivationStatus" json:"activation_status,omitempty"`
-	Config           *ModelConfig     `protobuf:"bytes,12,opt,name=config,proto3" json:"config,omitempty"`
-	Project          *Project         `protobuf:"bytes,14,opt,name=project,proto3" json:"project,omitempty"`
+	Attributes map[string]string `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *Model) Reset() {
-	*x = Model{}
+func (x *FormatArtifactAttributes) Reset() {
+	*x = FormatArtifactAttributes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -631,13 +743,13 @@ func (x *Model) Reset() {
 	}
 }
 
-func (x *Model) String() string {
+func (x *FormatArtifactAttributes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Model) ProtoMessage() {}
+func (*FormatArtifactAttributes) ProtoMessage() {}
 
-func (x *Model) ProtoReflect() protoreflect.Message {
+func (x *FormatArtifactAttributes) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -649,146 +761,154 @@ func (x *Model) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Model.ProtoReflect.Descriptor instead.
-func (*Model) Descriptor() ([]byte, []int) {
+// Deprecated: Use FormatArtifactAttributes.ProtoReflect.Descriptor instead.
+func (*FormatArtifactAttributes) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Model) GetId() string {
+func (x *FormatArtifactAttributes) GetAttributes() map[string]string {
 	if x != nil {
-		return x.Id
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
-func (x *Model) GetCreated() int64 {
-	if x != nil {
-		return x.Created
-	}
-	return 0
-}
+// HuggingFaceMetadata is the metadata extracted from the configuration files and the safetensors files
+// of a model in the Hugging Face format.
+type HuggingFaceMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Model) GetObject() string {
-	if x != nil {
-		return x.Object
+	// architecture is the class of the model (e.g., "LlamaForCausalLM").
+	Architecture string `protobuf:"bytes,1,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	// model_type is the type of the model (e.g., "llama").
+	ModelType string `protobuf:"bytes,2,opt,name=model_type,json=modelType,proto3" json:"model_type,omitempty"`
+	// dtype is the data type of the weights (e.g., "bfloat16").
+	Dtype string `protobuf:"bytes,3,opt,name=dtype,proto3" json:"dtype,omitempty"`
+	// parameter_count is the number of the parameters computed from the safetensors files. It is zero
+	// if the model has no safetensors file.
+	ParameterCount int64 `protobuf:"varint,4,opt,name=parameter_count,json=parameterCount,proto3" json:"parameter_count,omitempty"`
+	// max_position_embeddings is the maximum sequence length that the model supports.
+	MaxPositionEmbeddings int64 `protobuf:"varint,5,opt,name=max_position_embeddings,json=maxPositionEmbeddings,proto3" json:"max_position_embeddings,omitempty"`
+	// vocab_size is the size of the vocabulary.
+	VocabSize int64 `protobuf:"varint,6,opt,name=vocab_size,json=vocabSize,proto3" json:"vocab_size,omitempty"`
+	// has_chat_template is true if the tokenizer has a chat template.
+	HasChatTemplate bool `protobuf:"varint,7,opt,name=has_chat_template,json=hasChatTemplate,proto3" json:"has_chat_template,omitempty"`
+}
+
+func (x *HuggingFaceMetadata) Reset() {
+	*x = HuggingFaceMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Model) GetOwnedBy() string {
-	if x != nil {
-		return x.OwnedBy
-	}
-	return ""
+func (x *HuggingFaceMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Model) GetLoadingStatus() ModelLoadingStatus {
-	if x != nil {
-		return x.LoadingStatus
-	}
-	return ModelLoadingStatus_MODEL_LOADING_STATUS_UNSPECIFIED
-}
+func (*HuggingFaceMetadata) ProtoMessage() {}
 
-func (x *Model) GetSourceRepository() SourceRepository {
-	if x != nil {
-		return x.SourceRepository
+func (x *HuggingFaceMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Model) GetLoadingFailureReason() string {
-	if x != nil {
-		return x.LoadingFailureReason
-	}
-	return ""
+// Deprecated: Use HuggingFaceMetadata.ProtoReflect.Descriptor instead.
+func (*HuggingFaceMetadata) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Model) GetLoadingStatusMessage() string {
+func (x *HuggingFaceMetadata) GetArchitecture() string {
 	if x != nil {
-		return x.LoadingStatusMessage
+		return x.Architecture
 	}
 	return ""
 }
 
-func (x *Model) GetFormats() []ModelFormat {
+func (x *HuggingFaceMetadata) GetModelType() string {
 	if x != nil {
-		return x.Formats
+		return x.ModelType
 	}
-	return nil
+	return ""
 }
 
-func (x *Model) GetIsBaseModel() bool {
+func (x *HuggingFaceMetadata) GetDtype() string {
 	if x != nil {
-		return x.IsBaseModel
+		return x.Dtype
 	}
-	return false
+	return ""
 }
 
-func (x *Model) GetBaseModelId() string {
+func (x *HuggingFaceMetadata) GetParameterCount() int64 {
 	if x != nil {
-		return x.BaseModelId
+		return x.ParameterCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Model) GetActivationStatus() ActivationStatus {
+func (x *HuggingFaceMetadata) GetMaxPositionEmbeddings() int64 {
 	if x != nil {
-		return x.ActivationStatus
+		return x.MaxPositionEmbeddings
 	}
-	return ActivationStatus_ACTIVATION_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *Model) GetConfig() *ModelConfig {
+func (x *HuggingFaceMetadata) GetVocabSize() int64 {
 	if x != nil {
-		return x.Config
+		return x.VocabSize
 	}
-	return nil
+	return 0
 }
 
-func (x *Model) GetProject() *Project {
+func (x *HuggingFaceMetadata) GetHasChatTemplate() bool {
 	if x != nil {
-		return x.Project
+		return x.HasChatTemplate
 	}
-	return nil
+	return false
 }
 
-type CreateModelRequest struct {
+// FilePatterns selects the model files to load. Patterns are matched against the slash-separated
+// paths relative to the root of the model. '*' matches any sequence of characters including '/', and
+// a pattern that ends with '/' matches all the files under the directory.
+type FilePatterns struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SourceRepository SourceRepository `protobuf:"varint,2,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
-	// is_fine_tuned_model is a flag to indicate if the model is a fine-tuned model.
-	IsFineTunedModel bool `protobuf:"varint,3,opt,name=is_fine_tuned_model,json=isFineTunedModel,proto3" json:"is_fine_tuned_model,omitempty"`
-	// base_model_id is the ID of the base model. Only set if the model is a fine-tuned model.
-	BaseModelId string `protobuf:"bytes,4,opt,name=base_model_id,json=baseModelId,proto3" json:"base_model_id,omitempty"`
-	// suffix is the suffix of the model. Only set if the model is a fine-tuned model.
-	Suffix            string       `protobuf:"bytes,5,opt,name=suffix,proto3" json:"suffix,omitempty"`
-	ModelFileLocation string       `protobuf:"bytes,6,opt,name=model_file_location,json=modelFileLocation,proto3" json:"model_file_location,omitempty"`
-	Config            *ModelConfig `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
-	// is_project_scoped is true if the model has a project scope. Only meaningful for
-	// base models.
-	IsProjectScoped bool `protobuf:"varint,8,opt,name=is_project_scoped,json=isProjectScoped,proto3" json:"is_project_scoped,omitempty"`
+	// include is the list of patterns of the files to load. All files are loaded if empty.
+	Include []string `protobuf:"bytes,1,rep,name=include,proto3" json:"include,omitempty"`
+	// exclude is the list of patterns of the files not to load. It takes precedence over include.
+	Exclude []string `protobuf:"bytes,2,rep,name=exclude,proto3" json:"exclude,omitempty"`
 }
 
-func (x *CreateModelRequest) Reset() {
-	*x = CreateModelRequest{}
+func (x *FilePatterns) Reset() {
+	*x = FilePatterns{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[5]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateModelRequest) String() string {
+func (x *FilePatterns) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateModelRequest) ProtoMessage() {}
+func (*FilePatterns) ProtoMessage() {}
 
-func (x *CreateModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[5]
+func (x *FilePatterns) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -799,101 +919,55 @@ func (x *CreateModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateModelRequest.ProtoReflect.Descriptor instead.
-func (*CreateModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *CreateModelRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CreateModelRequest) GetSourceRepository() SourceRepository {
-	if x != nil {
-		return x.SourceRepository
-	}
-	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
-}
-
-func (x *CreateModelRequest) GetIsFineTunedModel() bool {
-	if x != nil {
-		return x.IsFineTunedModel
-	}
-	return false
-}
-
-func (x *CreateModelRequest) GetBaseModelId() string {
-	if x != nil {
-		return x.BaseModelId
-	}
-	return ""
-}
-
-func (x *CreateModelRequest) GetSuffix() string {
-	if x != nil {
-		return x.Suffix
-	}
-	return ""
-}
-
-func (x *CreateModelRequest) GetModelFileLocation() string {
-	if x != nil {
-		return x.ModelFileLocation
-	}
-	return ""
+// Deprecated: Use FilePatterns.ProtoReflect.Descriptor instead.
+func (*FilePatterns) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateModelRequest) GetConfig() *ModelConfig {
+func (x *FilePatterns) GetInclude() []string {
 	if x != nil {
-		return x.Config
+		return x.Include
 	}
 	return nil
 }
 
-func (x *CreateModelRequest) GetIsProjectScoped() bool {
+func (x *FilePatterns) GetExclude() []string {
 	if x != nil {
-		return x.IsProjectScoped
+		return x.Exclude
 	}
-	return false
+	return nil
 }
 
-type ListModelsRequest struct {
+type ModelConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// include_loading_models is a flag to include loading models in the response.
-	//
-	// This is not in the Open AI API specification.
-	IncludeLoadingModels bool `protobuf:"varint,1,opt,name=include_loading_models,json=includeLoadingModels,proto3" json:"include_loading_models,omitempty"`
-	// after is the identifier for the last model from the previous pagination request.
-	// This is not in the Open AI API specification.
-	After string `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
-	// limit is the number of non-base models to retrieve. Defaults to 100.
-	// This is not in the Open AI API specification.
-	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// runtime_config is the runtime configuration of the model.
+	// This can be unset if the configuration is determined by the runtime configuration
+	// of inference-manager-engine running in the cluster.
+	RuntimeConfig *ModelConfig_RuntimeConfig `protobuf:"bytes,1,opt,name=runtime_config,json=runtimeConfig,proto3" json:"runtime_config,omitempty"`
+	// cluster_allocation_policy defines the policy to allocate the model to clusters.
+	ClusterAllocationPolicy *ModelConfig_ClusterAllocationPolicy `protobuf:"bytes,2,opt,name=cluster_allocation_policy,json=clusterAllocationPolicy,proto3" json:"cluster_allocation_policy,omitempty"`
 }
 
-func (x *ListModelsRequest) Reset() {
-	*x = ListModelsRequest{}
+func (x *ModelConfig) Reset() {
+	*x = ModelConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[6]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListModelsRequest) String() string {
+func (x *ModelConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListModelsRequest) ProtoMessage() {}
+func (*ModelConfig) ProtoMessage() {}
 
-func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[6]
+func (x *ModelConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,63 +978,57 @@ func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListModelsRequest.ProtoReflect.Descriptor instead.
-func (*ListModelsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ListModelsRequest) GetIncludeLoadingModels() bool {
-	if x != nil {
-		return x.IncludeLoadingModels
-	}
-	return false
+// Deprecated: Use ModelConfig.ProtoReflect.Descriptor instead.
+func (*ModelConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListModelsRequest) GetAfter() string {
+func (x *ModelConfig) GetRuntimeConfig() *ModelConfig_RuntimeConfig {
 	if x != nil {
-		return x.After
+		return x.RuntimeConfig
 	}
-	return ""
+	return nil
 }
 
-func (x *ListModelsRequest) GetLimit() int32 {
+func (x *ModelConfig) GetClusterAllocationPolicy() *ModelConfig_ClusterAllocationPolicy {
 	if x != nil {
-		return x.Limit
+		return x.ClusterAllocationPolicy
 	}
-	return 0
+	return nil
 }
 
-type ListModelsResponse struct {
+type ProjectAssignment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Object string   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	Data   []*Model `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
-	// This is not in the Open AI API specification.
-	HasMore bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
-	// total_items is the total number of non-base models. This is not defined in the
-	// OpenAI API spec, but we include here for better UX in the frontend.
-	TotalItems int32 `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	// cluster_id specifies a cluster assigned to this project. It is empty
+	// when the namespace of any registered clusters is assigned to this project.
+	ClusterId string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// kueue_queue_name is the name of the Kueue queue assigned to this project.
+	KueueQueueName string `protobuf:"bytes,3,opt,name=kueue_queue_name,json=kueueQueueName,proto3" json:"kueue_queue_name,omitempty"`
+	// node_selector specifies nodes where this project can run its workloads.
+	NodeSelector []*ProjectAssignment_NodeSelector `protobuf:"bytes,4,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty"`
 }
 
-func (x *ListModelsResponse) Reset() {
-	*x = ListModelsResponse{}
+func (x *ProjectAssignment) Reset() {
+	*x = ProjectAssignment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[7]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListModelsResponse) String() string {
+func (x *ProjectAssignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListModelsResponse) ProtoMessage() {}
+func (*ProjectAssignment) ProtoMessage() {}
 
-func (x *ListModelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[7]
+func (x *ProjectAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,68 +1039,68 @@ func (x *ListModelsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListModelsResponse.ProtoReflect.Descriptor instead.
-func (*ListModelsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ProjectAssignment.ProtoReflect.Descriptor instead.
+func (*ProjectAssignment) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ListModelsResponse) GetObject() string {
+func (x *ProjectAssignment) GetClusterId() string {
 	if x != nil {
-		return x.Object
+		return x.ClusterId
 	}
 	return ""
 }
 
-func (x *ListModelsResponse) GetData() []*Model {
+func (x *ProjectAssignment) GetNamespace() string {
 	if x != nil {
-		return x.Data
+		return x.Namespace
 	}
-	return nil
+	return ""
 }
 
-func (x *ListModelsResponse) GetHasMore() bool {
+func (x *ProjectAssignment) GetKueueQueueName() string {
 	if x != nil {
-		return x.HasMore
+		return x.KueueQueueName
 	}
-	return false
+	return ""
 }
 
-func (x *ListModelsResponse) GetTotalItems() int32 {
+func (x *ProjectAssignment) GetNodeSelector() []*ProjectAssignment_NodeSelector {
 	if x != nil {
-		return x.TotalItems
+		return x.NodeSelector
 	}
-	return 0
+	return nil
 }
 
-type GetModelRequest struct {
+type Project struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// include_loading_model is a flag to include an loading model in the response.
-	//
-	// This is not in the Open AI API specification.
-	IncludeLoadingModel bool `protobuf:"varint,2,opt,name=include_loading_model,json=includeLoadingModel,proto3" json:"include_loading_model,omitempty"`
+	// assignments is the list of assigned clusters and namespaces for the project.
+	Assignments []*ProjectAssignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	// kubernetes_namespace is the namespace where the fine-tuning jobs for the project run.
+	KubernetesNamespace string `protobuf:"bytes,3,opt,name=kubernetes_namespace,json=kubernetesNamespace,proto3" json:"kubernetes_namespace,omitempty"`
 }
 
-func (x *GetModelRequest) Reset() {
-	*x = GetModelRequest{}
+func (x *Project) Reset() {
+	*x = Project{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[8]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetModelRequest) String() string {
+func (x *Project) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModelRequest) ProtoMessage() {}
+func (*Project) ProtoMessage() {}
 
-func (x *GetModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[8]
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1043,50 +1111,68 @@ func (x *GetModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModelRequest.ProtoReflect.Descriptor instead.
-func (*GetModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetModelRequest) GetId() string {
+func (x *Project) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetModelRequest) GetIncludeLoadingModel() bool {
+func (x *Project) GetAssignments() []*ProjectAssignment {
 	if x != nil {
-		return x.IncludeLoadingModel
+		return x.Assignments
 	}
-	return false
+	return nil
 }
 
-type DeleteModelRequest struct {
+func (x *Project) GetKubernetesNamespace() string {
+	if x != nil {
+		return x.KubernetesNamespace
+	}
+	return ""
+}
+
+// ModelLoadingProgress is the byte-level progress of loading a model. A loader downloads model files
+// from the source repository and then uploads them to the object store.
+type ModelLoadingProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// total_bytes is the total size of the model files. It is zero if the size is not known yet.
+	TotalBytes int64 `protobuf:"varint,1,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// downloaded_bytes is the number of bytes downloaded from the source repository.
+	DownloadedBytes int64 `protobuf:"varint,2,opt,name=downloaded_bytes,json=downloadedBytes,proto3" json:"downloaded_bytes,omitempty"`
+	// uploaded_bytes is the number of bytes uploaded to the object store.
+	UploadedBytes int64 `protobuf:"varint,3,opt,name=uploaded_bytes,json=uploadedBytes,proto3" json:"uploaded_bytes,omitempty"`
+	// bytes_per_second is the recent throughput of the download and the upload.
+	BytesPerSecond int64 `protobuf:"varint,4,opt,name=bytes_per_second,json=bytesPerSecond,proto3" json:"bytes_per_second,omitempty"`
+	// eta_seconds is the estimated number of seconds until the loading completes. It is zero if unknown.
+	EtaSeconds int64 `protobuf:"varint,5,opt,name=eta_seconds,json=etaSeconds,proto3" json:"eta_seconds,omitempty"`
 }
 
-func (x *DeleteModelRequest) Reset() {
-	*x = DeleteModelRequest{}
+func (x *ModelLoadingProgress) Reset() {
+	*x = ModelLoadingProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[9]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteModelRequest) String() string {
+func (x *ModelLoadingProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteModelRequest) ProtoMessage() {}
+func (*ModelLoadingProgress) ProtoMessage() {}
 
-func (x *DeleteModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[9]
+func (x *ModelLoadingProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,45 +1183,128 @@ func (x *DeleteModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteModelRequest.ProtoReflect.Descriptor instead.
-func (*DeleteModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ModelLoadingProgress.ProtoReflect.Descriptor instead.
+func (*ModelLoadingProgress) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteModelRequest) GetId() string {
+func (x *ModelLoadingProgress) GetTotalBytes() int64 {
 	if x != nil {
-		return x.Id
+		return x.TotalBytes
 	}
-	return ""
+	return 0
 }
 
-type DeleteModelResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Object  string `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
-	Deleted bool   `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+func (x *ModelLoadingProgress) GetDownloadedBytes() int64 {
+	if x != nil {
+		return x.DownloadedBytes
+	}
+	return 0
 }
 
-func (x *DeleteModelResponse) Reset() {
-	*x = DeleteModelResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ModelLoadingProgress) GetUploadedBytes() int64 {
+	if x != nil {
+		return x.UploadedBytes
 	}
+	return 0
 }
 
-func (x *DeleteModelResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ModelLoadingProgress) GetBytesPerSecond() int64 {
+	if x != nil {
+		return x.BytesPerSecond
+	}
+	return 0
 }
 
-func (*DeleteModelResponse) ProtoMessage() {}
+func (x *ModelLoadingProgress) GetEtaSeconds() int64 {
+	if x != nil {
+		return x.EtaSeconds
+	}
+	return 0
+}
 
-func (x *DeleteModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[10]
+type Model struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Object  string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	OwnedBy string `protobuf:"bytes,4,opt,name=owned_by,json=ownedBy,proto3" json:"owned_by,omitempty"`
+	// loading_status is set when the model is being loaded.
+	// This is not in the Open AI API specification.
+	LoadingStatus ModelLoadingStatus `protobuf:"varint,5,opt,name=loading_status,json=loadingStatus,proto3,enum=llmariner.models.server.v1.ModelLoadingStatus" json:"loading_status,omitempty"`
+	// source_repository is the source repository of the model. Set for base models created from the CreateModel API request.
+	// This is not in the Open AI API specification.
+	SourceRepository SourceRepository `protobuf:"varint,6,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
+	// loading_failure_reason is set when the model loading is failed to show the failure reason.
+	// This is not in the Open AI API specification.
+	LoadingFailureReason string `protobuf:"bytes,7,opt,name=loading_failure_reason,json=loadingFailureReason,proto3" json:"loading_failure_reason,omitempty"`
+	// loading_status_message is a message to show the loading status of the model. It is set
+	// when the loading_status is MODEL_LOADING_STATUS_LOADING or MODEL_LOADING_STATUS_FAILED.
+	LoadingStatusMessage string `protobuf:"bytes,13,opt,name=loading_status_message,json=loadingStatusMessage,proto3" json:"loading_status_message,omitempty"`
+	// formats is the supported formats of the model.
+	// This is not in the Open AI API specification.
+	Formats []ModelFormat `protobuf:"varint,8,rep,packed,name=formats,proto3,enum=llmariner.models.server.v1.ModelFormat" json:"formats,omitempty"`
+	// This is not in the Open AI API specification.
+	IsBaseModel bool `protobuf:"varint,9,opt,name=is_base_model,json=isBaseModel,proto3" json:"is_base_model,omitempty"`
+	// base_model_id is the ID of the base model. Only set if the model is a fine-tuned model.
+	// This is not in the Open AI API specification.
+	BaseModelId      string           `protobuf:"bytes,10,opt,name=base_model_id,json=baseModelId,proto3" json:"base_model_id,omitempty"`
+	ActivationStatus ActivationStatus `protobuf:"varint,11,opt,name=activation_status,json=activationStatus,proto3,enum=llmariner.models.server.v1.ActivationStatus" json:"activation_status,omitempty"`
+	Config           *ModelConfig     `protobuf:"bytes,12,opt,name=config,proto3" json:"config,omitempty"`
+	Project          *Project         `protobuf:"bytes,14,opt,name=project,proto3" json:"project,omitempty"`
+	// loading_progress is the byte-level progress of the loading. It is set when the loading_status
+	// is MODEL_LOADING_STATUS_LOADING and the loader reports the progress.
+	// This is not in the Open AI API specification.
+	LoadingProgress *ModelLoadingProgress `protobuf:"bytes,15,opt,name=loading_progress,json=loadingProgress,proto3" json:"loading_progress,omitempty"`
+	// revision is the revision (a branch, a tag, or a commit SHA) of the source repository that was requested.
+	// Empty if the default branch was requested. Only set for base models downloaded from Hugging Face.
+	// This is not in the Open AI API specification.
+	Revision string `protobuf:"bytes,16,opt,name=revision,proto3" json:"revision,omitempty"`
+	// resolved_revision is the commit SHA that the revision was resolved to when the model was downloaded.
+	// Only set for base models downloaded from Hugging Face.
+	// This is not in the Open AI API specification.
+	ResolvedRevision string `protobuf:"bytes,17,opt,name=resolved_revision,json=resolvedRevision,proto3" json:"resolved_revision,omitempty"`
+	// file_patterns is the patterns of the files that were requested to be loaded.
+	// This is not in the Open AI API specification.
+	FilePatterns *FilePatterns `protobuf:"bytes,18,opt,name=file_patterns,json=filePatterns,proto3" json:"file_patterns,omitempty"`
+	// queue_position is the 1-based position of the model in the load queue. It is set when the loading_status
+	// is MODEL_LOADING_STATUS_REQUESTED. The position is an estimate as loaders only acquire the models that they
+	// can load.
+	// This is not in the Open AI API specification.
+	QueuePosition int32 `protobuf:"varint,19,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	// size is the size of the model files. It is set when the model has been loaded by a loader that reports
+	// the size.
+	// This is not in the Open AI API specification.
+	Size *ModelSize `protobuf:"bytes,20,opt,name=size,proto3" json:"size,omitempty"`
+	// gguf_metadata is the metadata of the GGUF file of the model. It is set for base models that have the GGUF format.
+	// This is not in the Open AI API specification.
+	GgufMetadata *GGUFMetadata `protobuf:"bytes,21,opt,name=gguf_metadata,json=ggufMetadata,proto3" json:"gguf_metadata,omitempty"`
+	// hugging_face_metadata is the metadata of the model in the Hugging Face format. It is set for base
+	// models that have the Hugging Face format.
+	// This is not in the Open AI API specification.
+	HuggingFaceMetadata *HuggingFaceMetadata `protobuf:"bytes,22,opt,name=hugging_face_metadata,json=huggingFaceMetadata,proto3" json:"hugging_face_metadata,omitempty"`
+}
+
+func (x *Model) Reset() {
+	*x = Model{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Model) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Model) ProtoMessage() {}
+
+func (x *Model) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1146,161 +1315,220 @@ func (x *DeleteModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteModelResponse.ProtoReflect.Descriptor instead.
-func (*DeleteModelResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Model.ProtoReflect.Descriptor instead.
+func (*Model) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteModelResponse) GetId() string {
+func (x *Model) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteModelResponse) GetObject() string {
+func (x *Model) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *Model) GetObject() string {
 	if x != nil {
 		return x.Object
 	}
 	return ""
 }
 
-func (x *DeleteModelResponse) GetDeleted() bool {
+func (x *Model) GetOwnedBy() string {
 	if x != nil {
-		return x.Deleted
+		return x.OwnedBy
 	}
-	return false
+	return ""
 }
 
-type UpdateModelRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Model) GetLoadingStatus() ModelLoadingStatus {
+	if x != nil {
+		return x.LoadingStatus
+	}
+	return ModelLoadingStatus_MODEL_LOADING_STATUS_UNSPECIFIED
+}
 
-	// model is the model to update.
-	//
-	// The model's id is used to identify the key to update.
-	Model *Model `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
-	// The list of fields to update.
-	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+func (x *Model) GetSourceRepository() SourceRepository {
+	if x != nil {
+		return x.SourceRepository
+	}
+	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
 }
 
-func (x *UpdateModelRequest) Reset() {
-	*x = UpdateModelRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Model) GetLoadingFailureReason() string {
+	if x != nil {
+		return x.LoadingFailureReason
 	}
+	return ""
 }
 
-func (x *UpdateModelRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Model) GetLoadingStatusMessage() string {
+	if x != nil {
+		return x.LoadingStatusMessage
+	}
+	return ""
 }
 
-func (*UpdateModelRequest) ProtoMessage() {}
+func (x *Model) GetFormats() []ModelFormat {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
 
-func (x *UpdateModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Model) GetIsBaseModel() bool {
+	if x != nil {
+		return x.IsBaseModel
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use UpdateModelRequest.ProtoReflect.Descriptor instead.
-func (*UpdateModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{11}
+func (x *Model) GetBaseModelId() string {
+	if x != nil {
+		return x.BaseModelId
+	}
+	return ""
 }
 
-func (x *UpdateModelRequest) GetModel() *Model {
+func (x *Model) GetActivationStatus() ActivationStatus {
 	if x != nil {
-		return x.Model
+		return x.ActivationStatus
+	}
+	return ActivationStatus_ACTIVATION_STATUS_UNSPECIFIED
+}
+
+func (x *Model) GetConfig() *ModelConfig {
+	if x != nil {
+		return x.Config
 	}
 	return nil
 }
 
-func (x *UpdateModelRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+func (x *Model) GetProject() *Project {
 	if x != nil {
-		return x.UpdateMask
+		return x.Project
 	}
 	return nil
 }
 
-type ActivateModelRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Model) GetLoadingProgress() *ModelLoadingProgress {
+	if x != nil {
+		return x.LoadingProgress
+	}
+	return nil
+}
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *Model) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
 }
 
-func (x *ActivateModelRequest) Reset() {
-	*x = ActivateModelRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Model) GetResolvedRevision() string {
+	if x != nil {
+		return x.ResolvedRevision
 	}
+	return ""
 }
 
-func (x *ActivateModelRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Model) GetFilePatterns() *FilePatterns {
+	if x != nil {
+		return x.FilePatterns
+	}
+	return nil
 }
 
-func (*ActivateModelRequest) ProtoMessage() {}
+func (x *Model) GetQueuePosition() int32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
 
-func (x *ActivateModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Model) GetSize() *ModelSize {
+	if x != nil {
+		return x.Size
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ActivateModelRequest.ProtoReflect.Descriptor instead.
-func (*ActivateModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{12}
+func (x *Model) GetGgufMetadata() *GGUFMetadata {
+	if x != nil {
+		return x.GgufMetadata
+	}
+	return nil
 }
 
-func (x *ActivateModelRequest) GetId() string {
+func (x *Model) GetHuggingFaceMetadata() *HuggingFaceMetadata {
 	if x != nil {
-		return x.Id
+		return x.HuggingFaceMetadata
 	}
-	return ""
+	return nil
 }
 
-type ActivateModelResponse struct {
+type CreateModelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id               string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceRepository SourceRepository `protobuf:"varint,2,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
+	// is_fine_tuned_model is a flag to indicate if the model is a fine-tuned model.
+	IsFineTunedModel bool `protobuf:"varint,3,opt,name=is_fine_tuned_model,json=isFineTunedModel,proto3" json:"is_fine_tuned_model,omitempty"`
+	// base_model_id is the ID of the base model. Only set if the model is a fine-tuned model.
+	BaseModelId string `protobuf:"bytes,4,opt,name=base_model_id,json=baseModelId,proto3" json:"base_model_id,omitempty"`
+	// suffix is the suffix of the model. Only set if the model is a fine-tuned model.
+	Suffix string `protobuf:"bytes,5,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	// model_file_location is the location of the model files. It is required for fine-tuned models and
+	// base models whose source_repository is SOURCE_REPOSITORY_HTTP. For the latter, it is the URL of
+	// the model file or the archive of the model files.
+	ModelFileLocation string       `protobuf:"bytes,6,opt,name=model_file_location,json=modelFileLocation,proto3" json:"model_file_location,omitempty"`
+	Config            *ModelConfig `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
+	// is_project_scoped is true if the model has a project scope. Only meaningful for
+	// base models.
+	IsProjectScoped bool `protobuf:"varint,8,opt,name=is_project_scoped,json=isProjectScoped,proto3" json:"is_project_scoped,omitempty"`
+	// revision is the revision (a branch, a tag, or a commit SHA) of the Hugging Face repository to download.
+	// The default branch is used if not set. Only meaningful for base models whose source_repository is
+	// SOURCE_REPOSITORY_HUGGING_FACE.
+	Revision string `protobuf:"bytes,9,opt,name=revision,proto3" json:"revision,omitempty"`
+	// file_patterns selects the model files to load. All files are loaded if not set.
+	FilePatterns *FilePatterns `protobuf:"bytes,10,opt,name=file_patterns,json=filePatterns,proto3" json:"file_patterns,omitempty"`
+	// checksum is the expected checksum of the file downloaded from model_file_location in the form of
+	// "sha256:<hex>" with lowercase hex digits. The checksum is not verified if not set. Only meaningful for
+	// base models whose source_repository is SOURCE_REPOSITORY_HTTP.
+	Checksum string `protobuf:"bytes,11,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// priority is the priority of loading the model. Models with higher priorities are loaded first. Models
+	// of the same priority are loaded in a round-robin fashion across projects. The default is 0.
+	// The absolute value is limited by the server configuration (10 by default). The number of models with
+	// positive priorities that a project can have waiting to be loaded is also limited.
+	Priority int32 `protobuf:"varint,12,opt,name=priority,proto3" json:"priority,omitempty"`
 }
 
-func (x *ActivateModelResponse) Reset() {
-	*x = ActivateModelResponse{}
+func (x *CreateModelRequest) Reset() {
+	*x = CreateModelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[13]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActivateModelResponse) String() string {
+func (x *CreateModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateModelResponse) ProtoMessage() {}
+func (*CreateModelRequest) ProtoMessage() {}
 
-func (x *ActivateModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[13]
+func (x *CreateModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,81 +1539,129 @@ func (x *ActivateModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateModelResponse.ProtoReflect.Descriptor instead.
-func (*ActivateModelResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CreateModelRequest.ProtoReflect.Descriptor instead.
+func (*CreateModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{12}
 }
 
-type DeactivateModelRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CreateModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *CreateModelRequest) GetSourceRepository() SourceRepository {
+	if x != nil {
+		return x.SourceRepository
+	}
+	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
 }
 
-func (x *DeactivateModelRequest) Reset() {
-	*x = DeactivateModelRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateModelRequest) GetIsFineTunedModel() bool {
+	if x != nil {
+		return x.IsFineTunedModel
 	}
+	return false
 }
 
-func (x *DeactivateModelRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateModelRequest) GetBaseModelId() string {
+	if x != nil {
+		return x.BaseModelId
+	}
+	return ""
 }
 
-func (*DeactivateModelRequest) ProtoMessage() {}
+func (x *CreateModelRequest) GetSuffix() string {
+	if x != nil {
+		return x.Suffix
+	}
+	return ""
+}
 
-func (x *DeactivateModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateModelRequest) GetModelFileLocation() string {
+	if x != nil {
+		return x.ModelFileLocation
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeactivateModelRequest.ProtoReflect.Descriptor instead.
-func (*DeactivateModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{14}
+func (x *CreateModelRequest) GetConfig() *ModelConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
 }
 
-func (x *DeactivateModelRequest) GetId() string {
+func (x *CreateModelRequest) GetIsProjectScoped() bool {
 	if x != nil {
-		return x.Id
+		return x.IsProjectScoped
+	}
+	return false
+}
+
+func (x *CreateModelRequest) GetRevision() string {
+	if x != nil {
+		return x.Revision
 	}
 	return ""
 }
 
-type DeactivateModelResponse struct {
+func (x *CreateModelRequest) GetFilePatterns() *FilePatterns {
+	if x != nil {
+		return x.FilePatterns
+	}
+	return nil
+}
+
+func (x *CreateModelRequest) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *CreateModelRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type ListModelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// include_loading_models is a flag to include loading models in the response.
+	//
+	// This is not in the Open AI API specification.
+	IncludeLoadingModels bool `protobuf:"varint,1,opt,name=include_loading_models,json=includeLoadingModels,proto3" json:"include_loading_models,omitempty"`
+	// after is the identifier for the last model from the previous pagination request.
+	// This is not in the Open AI API specification.
+	After string `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	// limit is the number of non-base models to retrieve. Defaults to 100.
+	// This is not in the Open AI API specification.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *DeactivateModelResponse) Reset() {
-	*x = DeactivateModelResponse{}
+func (x *ListModelsRequest) Reset() {
+	*x = ListModelsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[15]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeactivateModelResponse) String() string {
+func (x *ListModelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeactivateModelResponse) ProtoMessage() {}
+func (*ListModelsRequest) ProtoMessage() {}
 
-func (x *DeactivateModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[15]
+func (x *ListModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1396,36 +1672,63 @@ func (x *DeactivateModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeactivateModelResponse.ProtoReflect.Descriptor instead.
-func (*DeactivateModelResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListModelsRequest.ProtoReflect.Descriptor instead.
+func (*ListModelsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{13}
 }
 
-type StorageConfig struct {
+func (x *ListModelsRequest) GetIncludeLoadingModels() bool {
+	if x != nil {
+		return x.IncludeLoadingModels
+	}
+	return false
+}
+
+func (x *ListModelsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *ListModelsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListModelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PathPrefix string `protobuf:"bytes,1,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	Object string   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	Data   []*Model `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	// This is not in the Open AI API specification.
+	HasMore bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	// total_items is the total number of non-base models. This is not defined in the
+	// OpenAI API spec, but we include here for better UX in the frontend.
+	TotalItems int32 `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
 }
 
-func (x *StorageConfig) Reset() {
-	*x = StorageConfig{}
+func (x *ListModelsResponse) Reset() {
+	*x = ListModelsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[16]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageConfig) String() string {
+func (x *ListModelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageConfig) ProtoMessage() {}
+func (*ListModelsResponse) ProtoMessage() {}
 
-func (x *StorageConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[16]
+func (x *ListModelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,43 +1739,68 @@ func (x *StorageConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageConfig.ProtoReflect.Descriptor instead.
-func (*StorageConfig) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListModelsResponse.ProtoReflect.Descriptor instead.
+func (*ListModelsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *StorageConfig) GetPathPrefix() string {
+func (x *ListModelsResponse) GetObject() string {
 	if x != nil {
-		return x.PathPrefix
+		return x.Object
 	}
 	return ""
 }
 
-type CreateStorageConfigRequest struct {
+func (x *ListModelsResponse) GetData() []*Model {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ListModelsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListModelsResponse) GetTotalItems() int32 {
+	if x != nil {
+		return x.TotalItems
+	}
+	return 0
+}
+
+type GetModelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PathPrefix string `protobuf:"bytes,1,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// include_loading_model is a flag to include an loading model in the response.
+	//
+	// This is not in the Open AI API specification.
+	IncludeLoadingModel bool `protobuf:"varint,2,opt,name=include_loading_model,json=includeLoadingModel,proto3" json:"include_loading_model,omitempty"`
 }
 
-func (x *CreateStorageConfigRequest) Reset() {
-	*x = CreateStorageConfigRequest{}
+func (x *GetModelRequest) Reset() {
+	*x = GetModelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[17]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateStorageConfigRequest) String() string {
+func (x *GetModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStorageConfigRequest) ProtoMessage() {}
+func (*GetModelRequest) ProtoMessage() {}
 
-func (x *CreateStorageConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[17]
+func (x *GetModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1483,41 +1811,50 @@ func (x *CreateStorageConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStorageConfigRequest.ProtoReflect.Descriptor instead.
-func (*CreateStorageConfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetModelRequest.ProtoReflect.Descriptor instead.
+func (*GetModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateStorageConfigRequest) GetPathPrefix() string {
+func (x *GetModelRequest) GetId() string {
 	if x != nil {
-		return x.PathPrefix
+		return x.Id
 	}
 	return ""
 }
 
-type GetStorageConfigRequest struct {
+func (x *GetModelRequest) GetIncludeLoadingModel() bool {
+	if x != nil {
+		return x.IncludeLoadingModel
+	}
+	return false
+}
+
+type DeleteModelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetStorageConfigRequest) Reset() {
-	*x = GetStorageConfigRequest{}
+func (x *DeleteModelRequest) Reset() {
+	*x = DeleteModelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[18]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStorageConfigRequest) String() string {
+func (x *DeleteModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStorageConfigRequest) ProtoMessage() {}
+func (*DeleteModelRequest) ProtoMessage() {}
 
-func (x *GetStorageConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[18]
+func (x *DeleteModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,44 +1865,45 @@ func (x *GetStorageConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStorageConfigRequest.ProtoReflect.Descriptor instead.
-func (*GetStorageConfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteModelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{16}
 }
 
-type RegisterModelRequest struct {
+func (x *DeleteModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteModelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the model name.
-	Id             string           `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
-	BaseModel      string           `protobuf:"bytes,1,opt,name=base_model,json=baseModel,proto3" json:"base_model,omitempty"`
-	Suffix         string           `protobuf:"bytes,2,opt,name=suffix,proto3" json:"suffix,omitempty"`
-	OrganizationId string           `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	ProjectId      string           `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Adapter        AdapterType      `protobuf:"varint,5,opt,name=adapter,proto3,enum=llmariner.models.server.v1.AdapterType" json:"adapter,omitempty"`
-	Quantization   QuantizationType `protobuf:"varint,6,opt,name=quantization,proto3,enum=llmariner.models.server.v1.QuantizationType" json:"quantization,omitempty"`
-	Path           string           `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Object  string `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	Deleted bool   `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
 }
 
-func (x *RegisterModelRequest) Reset() {
-	*x = RegisterModelRequest{}
+func (x *DeleteModelResponse) Reset() {
+	*x = DeleteModelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[19]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterModelRequest) String() string {
+func (x *DeleteModelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterModelRequest) ProtoMessage() {}
+func (*DeleteModelResponse) ProtoMessage() {}
 
-func (x *RegisterModelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[19]
+func (x *DeleteModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1576,94 +1914,116 @@ func (x *RegisterModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterModelRequest.ProtoReflect.Descriptor instead.
-func (*RegisterModelRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use DeleteModelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteModelResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RegisterModelRequest) GetId() string {
+func (x *DeleteModelResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *RegisterModelRequest) GetBaseModel() string {
+func (x *DeleteModelResponse) GetObject() string {
 	if x != nil {
-		return x.BaseModel
+		return x.Object
 	}
 	return ""
 }
 
-func (x *RegisterModelRequest) GetSuffix() string {
+func (x *DeleteModelResponse) GetDeleted() bool {
 	if x != nil {
-		return x.Suffix
+		return x.Deleted
 	}
-	return ""
+	return false
 }
 
-func (x *RegisterModelRequest) GetOrganizationId() string {
-	if x != nil {
-		return x.OrganizationId
-	}
-	return ""
+type UpdateModelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// model is the model to update.
+	//
+	// The model's id is used to identify the key to update.
+	Model *Model `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// The list of fields to update.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
 }
 
-func (x *RegisterModelRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
+func (x *UpdateModelRequest) Reset() {
+	*x = UpdateModelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RegisterModelRequest) GetAdapter() AdapterType {
-	if x != nil {
-		return x.Adapter
+func (x *UpdateModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelRequest) ProtoMessage() {}
+
+func (x *UpdateModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return AdapterType_ADAPTER_TYPE_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *RegisterModelRequest) GetQuantization() QuantizationType {
+// Deprecated: Use UpdateModelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateModelRequest) GetModel() *Model {
 	if x != nil {
-		return x.Quantization
+		return x.Model
 	}
-	return QuantizationType_QUANTIZATION_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *RegisterModelRequest) GetPath() string {
+func (x *UpdateModelRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Path
+		return x.UpdateMask
 	}
-	return ""
+	return nil
 }
 
-type RegisterModelResponse struct {
+type ActivateModelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// path is the path where the model is stored.
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *RegisterModelResponse) Reset() {
-	*x = RegisterModelResponse{}
+func (x *ActivateModelRequest) Reset() {
+	*x = ActivateModelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[20]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RegisterModelResponse) String() string {
+func (x *ActivateModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisterModelResponse) ProtoMessage() {}
+func (*ActivateModelRequest) ProtoMessage() {}
 
-func (x *RegisterModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[20]
+func (x *ActivateModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1674,26 +2034,57 @@ func (x *RegisterModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisterModelResponse.ProtoReflect.Descriptor instead.
-func (*RegisterModelResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ActivateModelRequest.ProtoReflect.Descriptor instead.
+func (*ActivateModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *RegisterModelResponse) GetId() string {
+func (x *ActivateModelRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *RegisterModelResponse) GetPath() string {
-	if x != nil {
-		return x.Path
+type ActivateModelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ActivateModelResponse) Reset() {
+	*x = ActivateModelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type PublishModelRequest struct {
+func (x *ActivateModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateModelResponse) ProtoMessage() {}
+
+func (x *ActivateModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateModelResponse.ProtoReflect.Descriptor instead.
+func (*ActivateModelResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{20}
+}
+
+type DeactivateModelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1701,8 +2092,8 @@ type PublishModelRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *PublishModelRequest) Reset() {
-	*x = PublishModelRequest{}
+func (x *DeactivateModelRequest) Reset() {
+	*x = DeactivateModelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1710,13 +2101,13 @@ func (x *PublishModelRequest) Reset() {
 	}
 }
 
-func (x *PublishModelRequest) String() string {
+func (x *DeactivateModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishModelRequest) ProtoMessage() {}
+func (*DeactivateModelRequest) ProtoMessage() {}
 
-func (x *PublishModelRequest) ProtoReflect() protoreflect.Message {
+func (x *DeactivateModelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1728,26 +2119,26 @@ func (x *PublishModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishModelRequest.ProtoReflect.Descriptor instead.
-func (*PublishModelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeactivateModelRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateModelRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *PublishModelRequest) GetId() string {
+func (x *DeactivateModelRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type PublishModelResponse struct {
+type DeactivateModelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PublishModelResponse) Reset() {
-	*x = PublishModelResponse{}
+func (x *DeactivateModelResponse) Reset() {
+	*x = DeactivateModelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1755,13 +2146,13 @@ func (x *PublishModelResponse) Reset() {
 	}
 }
 
-func (x *PublishModelResponse) String() string {
+func (x *DeactivateModelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishModelResponse) ProtoMessage() {}
+func (*DeactivateModelResponse) ProtoMessage() {}
 
-func (x *PublishModelResponse) ProtoReflect() protoreflect.Message {
+func (x *DeactivateModelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1773,13 +2164,12 @@ func (x *PublishModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishModelResponse.ProtoReflect.Descriptor instead.
-func (*PublishModelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeactivateModelResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateModelResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{22}
 }
 
-// GetModelPathRequest is deprecated, use GetModelAttributesRequest instead.
-type GetModelPathRequest struct {
+type RetryModelLoadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1787,8 +2177,8 @@ type GetModelPathRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetModelPathRequest) Reset() {
-	*x = GetModelPathRequest{}
+func (x *RetryModelLoadRequest) Reset() {
+	*x = RetryModelLoadRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1796,13 +2186,13 @@ func (x *GetModelPathRequest) Reset() {
 	}
 }
 
-func (x *GetModelPathRequest) String() string {
+func (x *RetryModelLoadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModelPathRequest) ProtoMessage() {}
+func (*RetryModelLoadRequest) ProtoMessage() {}
 
-func (x *GetModelPathRequest) ProtoReflect() protoreflect.Message {
+func (x *RetryModelLoadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1814,29 +2204,26 @@ func (x *GetModelPathRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModelPathRequest.ProtoReflect.Descriptor instead.
-func (*GetModelPathRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetryModelLoadRequest.ProtoReflect.Descriptor instead.
+func (*RetryModelLoadRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetModelPathRequest) GetId() string {
+func (x *RetryModelLoadRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// GetModelPathResponse is deprecated, use GetModelAttributesResponse instead.
-type GetModelPathResponse struct {
+type RetryModelLoadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *GetModelPathResponse) Reset() {
-	*x = GetModelPathResponse{}
+func (x *RetryModelLoadResponse) Reset() {
+	*x = RetryModelLoadResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1844,13 +2231,13 @@ func (x *GetModelPathResponse) Reset() {
 	}
 }
 
-func (x *GetModelPathResponse) String() string {
+func (x *RetryModelLoadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModelPathResponse) ProtoMessage() {}
+func (*RetryModelLoadResponse) ProtoMessage() {}
 
-func (x *GetModelPathResponse) ProtoReflect() protoreflect.Message {
+func (x *RetryModelLoadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1862,31 +2249,21 @@ func (x *GetModelPathResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModelPathResponse.ProtoReflect.Descriptor instead.
-func (*GetModelPathResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetryModelLoadResponse.ProtoReflect.Descriptor instead.
+func (*RetryModelLoadResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetModelPathResponse) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-type ModelAttributes struct {
+type CancelModelLoadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path         string           `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	BaseModel    string           `protobuf:"bytes,2,opt,name=base_model,json=baseModel,proto3" json:"base_model,omitempty"`
-	Adapter      AdapterType      `protobuf:"varint,3,opt,name=adapter,proto3,enum=llmariner.models.server.v1.AdapterType" json:"adapter,omitempty"`
-	Quantization QuantizationType `protobuf:"varint,4,opt,name=quantization,proto3,enum=llmariner.models.server.v1.QuantizationType" json:"quantization,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ModelAttributes) Reset() {
-	*x = ModelAttributes{}
+func (x *CancelModelLoadRequest) Reset() {
+	*x = CancelModelLoadRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1894,13 +2271,13 @@ func (x *ModelAttributes) Reset() {
 	}
 }
 
-func (x *ModelAttributes) String() string {
+func (x *CancelModelLoadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelAttributes) ProtoMessage() {}
+func (*CancelModelLoadRequest) ProtoMessage() {}
 
-func (x *ModelAttributes) ProtoReflect() protoreflect.Message {
+func (x *CancelModelLoadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1912,49 +2289,26 @@ func (x *ModelAttributes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelAttributes.ProtoReflect.Descriptor instead.
-func (*ModelAttributes) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelModelLoadRequest.ProtoReflect.Descriptor instead.
+func (*CancelModelLoadRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ModelAttributes) GetPath() string {
+func (x *CancelModelLoadRequest) GetId() string {
 	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *ModelAttributes) GetBaseModel() string {
-	if x != nil {
-		return x.BaseModel
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ModelAttributes) GetAdapter() AdapterType {
-	if x != nil {
-		return x.Adapter
-	}
-	return AdapterType_ADAPTER_TYPE_UNSPECIFIED
-}
-
-func (x *ModelAttributes) GetQuantization() QuantizationType {
-	if x != nil {
-		return x.Quantization
-	}
-	return QuantizationType_QUANTIZATION_TYPE_UNSPECIFIED
-}
-
-type GetModelAttributesRequest struct {
+type CancelModelLoadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetModelAttributesRequest) Reset() {
-	*x = GetModelAttributesRequest{}
+func (x *CancelModelLoadResponse) Reset() {
+	*x = CancelModelLoadResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1962,13 +2316,13 @@ func (x *GetModelAttributesRequest) Reset() {
 	}
 }
 
-func (x *GetModelAttributesRequest) String() string {
+func (x *CancelModelLoadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModelAttributesRequest) ProtoMessage() {}
+func (*CancelModelLoadResponse) ProtoMessage() {}
 
-func (x *GetModelAttributesRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelModelLoadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1980,36 +2334,19 @@ func (x *GetModelAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModelAttributesRequest.ProtoReflect.Descriptor instead.
-func (*GetModelAttributesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelModelLoadResponse.ProtoReflect.Descriptor instead.
+func (*CancelModelLoadResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetModelAttributesRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type CreateBaseModelRequest struct {
+type ListModelLoadQueueRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	// formats is the supported formats of the base model. If not set, the format is considered as GGUF
-	// for backward compatibility.
-	Formats []ModelFormat `protobuf:"varint,4,rep,packed,name=formats,proto3,enum=llmariner.models.server.v1.ModelFormat" json:"formats,omitempty"`
-	// gguf_model_path is the path of the GGUF model. Set if the model suppors the GGUF formta.
-	GgufModelPath    string           `protobuf:"bytes,3,opt,name=gguf_model_path,json=ggufModelPath,proto3" json:"gguf_model_path,omitempty"`
-	SourceRepository SourceRepository `protobuf:"varint,5,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
-	ProjectId        string           `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 }
 
-func (x *CreateBaseModelRequest) Reset() {
-	*x = CreateBaseModelRequest{}
+func (x *ListModelLoadQueueRequest) Reset() {
+	*x = ListModelLoadQueueRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2017,13 +2354,13 @@ func (x *CreateBaseModelRequest) Reset() {
 	}
 }
 
-func (x *CreateBaseModelRequest) String() string {
+func (x *ListModelLoadQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBaseModelRequest) ProtoMessage() {}
+func (*ListModelLoadQueueRequest) ProtoMessage() {}
 
-func (x *CreateBaseModelRequest) ProtoReflect() protoreflect.Message {
+func (x *ListModelLoadQueueRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2035,65 +2372,28 @@ func (x *CreateBaseModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBaseModelRequest.ProtoReflect.Descriptor instead.
-func (*CreateBaseModelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListModelLoadQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListModelLoadQueueRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *CreateBaseModelRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *CreateBaseModelRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *CreateBaseModelRequest) GetFormats() []ModelFormat {
-	if x != nil {
-		return x.Formats
-	}
-	return nil
-}
-
-func (x *CreateBaseModelRequest) GetGgufModelPath() string {
-	if x != nil {
-		return x.GgufModelPath
-	}
-	return ""
-}
-
-func (x *CreateBaseModelRequest) GetSourceRepository() SourceRepository {
-	if x != nil {
-		return x.SourceRepository
-	}
-	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
-}
-
-func (x *CreateBaseModelRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
-}
-
-type BaseModel struct {
+type ModelLoadQueueEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	Object  string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+	ModelId     string `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	IsBaseModel bool   `protobuf:"varint,2,opt,name=is_base_model,json=isBaseModel,proto3" json:"is_base_model,omitempty"`
+	// project_id is the ID of the project of the model. Empty for globally scoped base models.
+	ProjectId        string           `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SourceRepository SourceRepository `protobuf:"varint,4,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
+	Priority         int32            `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	// position is the 1-based position in the load queue.
+	Position int32 `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *BaseModel) Reset() {
-	*x = BaseModel{}
+func (x *ModelLoadQueueEntry) Reset() {
+	*x = ModelLoadQueueEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2101,13 +2401,13 @@ func (x *BaseModel) Reset() {
 	}
 }
 
-func (x *BaseModel) String() string {
+func (x *ModelLoadQueueEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaseModel) ProtoMessage() {}
+func (*ModelLoadQueueEntry) ProtoMessage() {}
 
-func (x *BaseModel) ProtoReflect() protoreflect.Message {
+func (x *ModelLoadQueueEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2119,45 +2419,65 @@ func (x *BaseModel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaseModel.ProtoReflect.Descriptor instead.
-func (*BaseModel) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelLoadQueueEntry.ProtoReflect.Descriptor instead.
+func (*ModelLoadQueueEntry) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *BaseModel) GetId() string {
+func (x *ModelLoadQueueEntry) GetModelId() string {
 	if x != nil {
-		return x.Id
+		return x.ModelId
 	}
 	return ""
 }
 
-func (x *BaseModel) GetCreated() int64 {
+func (x *ModelLoadQueueEntry) GetIsBaseModel() bool {
 	if x != nil {
-		return x.Created
+		return x.IsBaseModel
 	}
-	return 0
+	return false
 }
 
-func (x *BaseModel) GetObject() string {
+func (x *ModelLoadQueueEntry) GetProjectId() string {
 	if x != nil {
-		return x.Object
+		return x.ProjectId
 	}
 	return ""
 }
 
-type GetBaseModelPathRequest struct {
+func (x *ModelLoadQueueEntry) GetSourceRepository() SourceRepository {
+	if x != nil {
+		return x.SourceRepository
+	}
+	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
+}
+
+func (x *ModelLoadQueueEntry) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ModelLoadQueueEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ListModelLoadQueueResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// project_id is set to a non-empty value if the project-scoped base model is requested. If empty,
-	// the RPC returns either the globally-scoped base model or a project-scoped base model.
-	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// entries is the list of the models waiting to be loaded in the order of the positions. Only the models
+	// visible to the caller are listed, but the positions take all the queued models into account.
+	Entries []*ModelLoadQueueEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *GetBaseModelPathRequest) Reset() {
-	*x = GetBaseModelPathRequest{}
+func (x *ListModelLoadQueueResponse) Reset() {
+	*x = ListModelLoadQueueResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2165,13 +2485,13 @@ func (x *GetBaseModelPathRequest) Reset() {
 	}
 }
 
-func (x *GetBaseModelPathRequest) String() string {
+func (x *ListModelLoadQueueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBaseModelPathRequest) ProtoMessage() {}
+func (*ListModelLoadQueueResponse) ProtoMessage() {}
 
-func (x *GetBaseModelPathRequest) ProtoReflect() protoreflect.Message {
+func (x *ListModelLoadQueueResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2183,38 +2503,34 @@ func (x *GetBaseModelPathRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBaseModelPathRequest.ProtoReflect.Descriptor instead.
-func (*GetBaseModelPathRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListModelLoadQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListModelLoadQueueResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetBaseModelPathRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *GetBaseModelPathRequest) GetProjectId() string {
+func (x *ListModelLoadQueueResponse) GetEntries() []*ModelLoadQueueEntry {
 	if x != nil {
-		return x.ProjectId
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type GetBaseModelPathResponse struct {
+// ModelFile is a file of a loaded model in the object store.
+type ModelFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Formats []ModelFormat `protobuf:"varint,3,rep,packed,name=formats,proto3,enum=llmariner.models.server.v1.ModelFormat" json:"formats,omitempty"`
-	Path    string        `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	// gguf_model_path is the path of the GGUF model. Set if the model suppors the GGUF formta.
-	GgufModelPath string `protobuf:"bytes,2,opt,name=gguf_model_path,json=ggufModelPath,proto3" json:"gguf_model_path,omitempty"`
+	// path is the path of the file relative to the base path of the model files.
+	Path      string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	SizeBytes int64  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// sha256 is the hex-encoded SHA-256 digest of the file content.
+	Sha256      string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
 }
 
-func (x *GetBaseModelPathResponse) Reset() {
-	*x = GetBaseModelPathResponse{}
+func (x *ModelFile) Reset() {
+	*x = ModelFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2222,13 +2538,13 @@ func (x *GetBaseModelPathResponse) Reset() {
 	}
 }
 
-func (x *GetBaseModelPathResponse) String() string {
+func (x *ModelFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBaseModelPathResponse) ProtoMessage() {}
+func (*ModelFile) ProtoMessage() {}
 
-func (x *GetBaseModelPathResponse) ProtoReflect() protoreflect.Message {
+func (x *ModelFile) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2240,43 +2556,49 @@ func (x *GetBaseModelPathResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBaseModelPathResponse.ProtoReflect.Descriptor instead.
-func (*GetBaseModelPathResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelFile.ProtoReflect.Descriptor instead.
+func (*ModelFile) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetBaseModelPathResponse) GetFormats() []ModelFormat {
+func (x *ModelFile) GetPath() string {
 	if x != nil {
-		return x.Formats
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBaseModelPathResponse) GetPath() string {
+func (x *ModelFile) GetSizeBytes() int64 {
 	if x != nil {
-		return x.Path
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ModelFile) GetSha256() string {
+	if x != nil {
+		return x.Sha256
 	}
 	return ""
 }
 
-func (x *GetBaseModelPathResponse) GetGgufModelPath() string {
+func (x *ModelFile) GetContentType() string {
 	if x != nil {
-		return x.GgufModelPath
+		return x.ContentType
 	}
 	return ""
 }
 
-type CreateHFModelRepoRequest struct {
+type GetModelFilesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CreateHFModelRepoRequest) Reset() {
-	*x = CreateHFModelRepoRequest{}
+func (x *GetModelFilesRequest) Reset() {
+	*x = GetModelFilesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2284,13 +2606,13 @@ func (x *CreateHFModelRepoRequest) Reset() {
 	}
 }
 
-func (x *CreateHFModelRepoRequest) String() string {
+func (x *GetModelFilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateHFModelRepoRequest) ProtoMessage() {}
+func (*GetModelFilesRequest) ProtoMessage() {}
 
-func (x *CreateHFModelRepoRequest) ProtoReflect() protoreflect.Message {
+func (x *GetModelFilesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2302,35 +2624,33 @@ func (x *CreateHFModelRepoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateHFModelRepoRequest.ProtoReflect.Descriptor instead.
-func (*CreateHFModelRepoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetModelFilesRequest.ProtoReflect.Descriptor instead.
+func (*GetModelFilesRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CreateHFModelRepoRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateHFModelRepoRequest) GetProjectId() string {
+func (x *GetModelFilesRequest) GetId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.Id
 	}
 	return ""
 }
 
-type HFModelRepo struct {
+type GetModelFilesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// base_path is the object store path that the paths of the files are relative to. This is the model path
+	// except for a base model created for each GGUF file in a repository, whose files are stored in the parent
+	// path of the model path.
+	BasePath string `protobuf:"bytes,1,opt,name=base_path,json=basePath,proto3" json:"base_path,omitempty"`
+	// files is the list of the files of the model. Empty if the model was loaded before the files were recorded.
+	Files []*ModelFile `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
 }
 
-func (x *HFModelRepo) Reset() {
-	*x = HFModelRepo{}
+func (x *GetModelFilesResponse) Reset() {
+	*x = GetModelFilesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2338,13 +2658,13 @@ func (x *HFModelRepo) Reset() {
 	}
 }
 
-func (x *HFModelRepo) String() string {
+func (x *GetModelFilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HFModelRepo) ProtoMessage() {}
+func (*GetModelFilesResponse) ProtoMessage() {}
 
-func (x *HFModelRepo) ProtoReflect() protoreflect.Message {
+func (x *GetModelFilesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2356,29 +2676,42 @@ func (x *HFModelRepo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HFModelRepo.ProtoReflect.Descriptor instead.
-func (*HFModelRepo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetModelFilesResponse.ProtoReflect.Descriptor instead.
+func (*GetModelFilesResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *HFModelRepo) GetName() string {
+func (x *GetModelFilesResponse) GetBasePath() string {
 	if x != nil {
-		return x.Name
+		return x.BasePath
 	}
 	return ""
 }
 
-type GetHFModelRepoRequest struct {
+func (x *GetModelFilesResponse) GetFiles() []*ModelFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type ModelEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// revision identifies the event. Revisions monotonically increase.
+	Revision int64          `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Type     ModelEventType `protobuf:"varint,2,opt,name=type,proto3,enum=llmariner.models.server.v1.ModelEventType" json:"type,omitempty"`
+	// model is the model at the time the event is sent, so it might reflect later changes.
+	// Only the id is set for a deleted model.
+	Model *Model `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	// created is the Unix timestamp (in seconds) when the event occurred.
+	Created int64 `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
 }
 
-func (x *GetHFModelRepoRequest) Reset() {
-	*x = GetHFModelRepoRequest{}
+func (x *ModelEvent) Reset() {
+	*x = ModelEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2386,13 +2719,13 @@ func (x *GetHFModelRepoRequest) Reset() {
 	}
 }
 
-func (x *GetHFModelRepoRequest) String() string {
+func (x *ModelEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHFModelRepoRequest) ProtoMessage() {}
+func (*ModelEvent) ProtoMessage() {}
 
-func (x *GetHFModelRepoRequest) ProtoReflect() protoreflect.Message {
+func (x *ModelEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2404,33 +2737,58 @@ func (x *GetHFModelRepoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHFModelRepoRequest.ProtoReflect.Descriptor instead.
-func (*GetHFModelRepoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelEvent.ProtoReflect.Descriptor instead.
+func (*ModelEvent) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetHFModelRepoRequest) GetName() string {
+func (x *ModelEvent) GetRevision() int64 {
 	if x != nil {
-		return x.Name
+		return x.Revision
 	}
-	return ""
+	return 0
 }
 
-func (x *GetHFModelRepoRequest) GetProjectId() string {
+func (x *ModelEvent) GetType() ModelEventType {
 	if x != nil {
-		return x.ProjectId
+		return x.Type
 	}
-	return ""
+	return ModelEventType_MODEL_EVENT_TYPE_UNSPECIFIED
 }
 
-type AcquireUnloadedBaseModelRequest struct {
+func (x *ModelEvent) GetModel() *Model {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *ModelEvent) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+type WatchModelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *AcquireUnloadedBaseModelRequest) Reset() {
-	*x = AcquireUnloadedBaseModelRequest{}
+	// after_revision is the greatest revision of the events that the caller has received. Events
+	// with greater revisions are sent. If zero, only the events that occur after the call are sent.
+	//
+	// An event can be sent after events with greater revisions if it is committed late. Recent events
+	// are sent again on resumption so that such events are not missed.
+	//
+	// The call fails with OUT_OF_RANGE if the events after the revision have been pruned.
+	AfterRevision int64 `protobuf:"varint,1,opt,name=after_revision,json=afterRevision,proto3" json:"after_revision,omitempty"`
+	// include_loading_models is a flag to include the events of loading models.
+	IncludeLoadingModels bool `protobuf:"varint,2,opt,name=include_loading_models,json=includeLoadingModels,proto3" json:"include_loading_models,omitempty"`
+}
+
+func (x *WatchModelsRequest) Reset() {
+	*x = WatchModelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2438,13 +2796,13 @@ func (x *AcquireUnloadedBaseModelRequest) Reset() {
 	}
 }
 
-func (x *AcquireUnloadedBaseModelRequest) String() string {
+func (x *WatchModelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcquireUnloadedBaseModelRequest) ProtoMessage() {}
+func (*WatchModelsRequest) ProtoMessage() {}
 
-func (x *AcquireUnloadedBaseModelRequest) ProtoReflect() protoreflect.Message {
+func (x *WatchModelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2456,24 +2814,35 @@ func (x *AcquireUnloadedBaseModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcquireUnloadedBaseModelRequest.ProtoReflect.Descriptor instead.
-func (*AcquireUnloadedBaseModelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchModelsRequest.ProtoReflect.Descriptor instead.
+func (*WatchModelsRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{34}
 }
 
-type AcquireUnloadedBaseModelResponse struct {
+func (x *WatchModelsRequest) GetAfterRevision() int64 {
+	if x != nil {
+		return x.AfterRevision
+	}
+	return 0
+}
+
+func (x *WatchModelsRequest) GetIncludeLoadingModels() bool {
+	if x != nil {
+		return x.IncludeLoadingModels
+	}
+	return false
+}
+
+type StorageConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// base_model_id is ID of the acquired base model. Empty if there is no unloaded base model.
-	BaseModelId      string           `protobuf:"bytes,1,opt,name=base_model_id,json=baseModelId,proto3" json:"base_model_id,omitempty"`
-	SourceRepository SourceRepository `protobuf:"varint,2,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
-	ProjectId        string           `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	PathPrefix string `protobuf:"bytes,1,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
 }
 
-func (x *AcquireUnloadedBaseModelResponse) Reset() {
-	*x = AcquireUnloadedBaseModelResponse{}
+func (x *StorageConfig) Reset() {
+	*x = StorageConfig{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2481,13 +2850,13 @@ func (x *AcquireUnloadedBaseModelResponse) Reset() {
 	}
 }
 
-func (x *AcquireUnloadedBaseModelResponse) String() string {
+func (x *StorageConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcquireUnloadedBaseModelResponse) ProtoMessage() {}
+func (*StorageConfig) ProtoMessage() {}
 
-func (x *AcquireUnloadedBaseModelResponse) ProtoReflect() protoreflect.Message {
+func (x *StorageConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2499,51 +2868,28 @@ func (x *AcquireUnloadedBaseModelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcquireUnloadedBaseModelResponse.ProtoReflect.Descriptor instead.
-func (*AcquireUnloadedBaseModelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StorageConfig.ProtoReflect.Descriptor instead.
+func (*StorageConfig) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *AcquireUnloadedBaseModelResponse) GetBaseModelId() string {
-	if x != nil {
-		return x.BaseModelId
-	}
-	return ""
-}
-
-func (x *AcquireUnloadedBaseModelResponse) GetSourceRepository() SourceRepository {
-	if x != nil {
-		return x.SourceRepository
-	}
-	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
-}
-
-func (x *AcquireUnloadedBaseModelResponse) GetProjectId() string {
+func (x *StorageConfig) GetPathPrefix() string {
 	if x != nil {
-		return x.ProjectId
+		return x.PathPrefix
 	}
 	return ""
 }
 
-type UpdateBaseModelLoadingStatusRequest struct {
+type CreateStorageConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId string `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	// loading_result is the result of the model loading. Unset if the model is still loading.
-	//
-	// Types that are assignable to LoadingResult:
-	//
-	//	*UpdateBaseModelLoadingStatusRequest_Success_
-	//	*UpdateBaseModelLoadingStatusRequest_Failure_
-	LoadingResult isUpdateBaseModelLoadingStatusRequest_LoadingResult `protobuf_oneof:"loading_result"`
-	StatusMessage string                                              `protobuf:"bytes,5,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	PathPrefix string `protobuf:"bytes,1,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
 }
 
-func (x *UpdateBaseModelLoadingStatusRequest) Reset() {
-	*x = UpdateBaseModelLoadingStatusRequest{}
+func (x *CreateStorageConfigRequest) Reset() {
+	*x = CreateStorageConfigRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2551,13 +2897,13 @@ func (x *UpdateBaseModelLoadingStatusRequest) Reset() {
 	}
 }
 
-func (x *UpdateBaseModelLoadingStatusRequest) String() string {
+func (x *CreateStorageConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBaseModelLoadingStatusRequest) ProtoMessage() {}
+func (*CreateStorageConfigRequest) ProtoMessage() {}
 
-func (x *UpdateBaseModelLoadingStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateStorageConfigRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2569,79 +2915,26 @@ func (x *UpdateBaseModelLoadingStatusRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBaseModelLoadingStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBaseModelLoadingStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateStorageConfigRequest.ProtoReflect.Descriptor instead.
+func (*CreateStorageConfigRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *UpdateBaseModelLoadingStatusRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateBaseModelLoadingStatusRequest) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
-}
-
-func (m *UpdateBaseModelLoadingStatusRequest) GetLoadingResult() isUpdateBaseModelLoadingStatusRequest_LoadingResult {
-	if m != nil {
-		return m.LoadingResult
-	}
-	return nil
-}
-
-func (x *UpdateBaseModelLoadingStatusRequest) GetSuccess() *UpdateBaseModelLoadingStatusRequest_Success {
-	if x, ok := x.GetLoadingResult().(*UpdateBaseModelLoadingStatusRequest_Success_); ok {
-		return x.Success
-	}
-	return nil
-}
-
-func (x *UpdateBaseModelLoadingStatusRequest) GetFailure() *UpdateBaseModelLoadingStatusRequest_Failure {
-	if x, ok := x.GetLoadingResult().(*UpdateBaseModelLoadingStatusRequest_Failure_); ok {
-		return x.Failure
-	}
-	return nil
-}
-
-func (x *UpdateBaseModelLoadingStatusRequest) GetStatusMessage() string {
+func (x *CreateStorageConfigRequest) GetPathPrefix() string {
 	if x != nil {
-		return x.StatusMessage
+		return x.PathPrefix
 	}
 	return ""
 }
 
-type isUpdateBaseModelLoadingStatusRequest_LoadingResult interface {
-	isUpdateBaseModelLoadingStatusRequest_LoadingResult()
-}
-
-type UpdateBaseModelLoadingStatusRequest_Success_ struct {
-	Success *UpdateBaseModelLoadingStatusRequest_Success `protobuf:"bytes,2,opt,name=success,proto3,oneof"`
-}
-
-type UpdateBaseModelLoadingStatusRequest_Failure_ struct {
-	Failure *UpdateBaseModelLoadingStatusRequest_Failure `protobuf:"bytes,3,opt,name=failure,proto3,oneof"`
-}
-
-func (*UpdateBaseModelLoadingStatusRequest_Success_) isUpdateBaseModelLoadingStatusRequest_LoadingResult() {
-}
-
-func (*UpdateBaseModelLoadingStatusRequest_Failure_) isUpdateBaseModelLoadingStatusRequest_LoadingResult() {
-}
-
-type UpdateBaseModelLoadingStatusResponse struct {
+type GetStorageConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateBaseModelLoadingStatusResponse) Reset() {
-	*x = UpdateBaseModelLoadingStatusResponse{}
+func (x *GetStorageConfigRequest) Reset() {
+	*x = GetStorageConfigRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2649,13 +2942,13 @@ func (x *UpdateBaseModelLoadingStatusResponse) Reset() {
 	}
 }
 
-func (x *UpdateBaseModelLoadingStatusResponse) String() string {
+func (x *GetStorageConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBaseModelLoadingStatusResponse) ProtoMessage() {}
+func (*GetStorageConfigRequest) ProtoMessage() {}
 
-func (x *UpdateBaseModelLoadingStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStorageConfigRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2667,19 +2960,29 @@ func (x *UpdateBaseModelLoadingStatusResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBaseModelLoadingStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBaseModelLoadingStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStorageConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageConfigRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{37}
 }
 
-type AcquireUnloadedModelRequest struct {
+type RegisterModelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// id is the model name.
+	Id             string           `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	BaseModel      string           `protobuf:"bytes,1,opt,name=base_model,json=baseModel,proto3" json:"base_model,omitempty"`
+	Suffix         string           `protobuf:"bytes,2,opt,name=suffix,proto3" json:"suffix,omitempty"`
+	OrganizationId string           `protobuf:"bytes,3,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ProjectId      string           `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Adapter        AdapterType      `protobuf:"varint,5,opt,name=adapter,proto3,enum=llmariner.models.server.v1.AdapterType" json:"adapter,omitempty"`
+	Quantization   QuantizationType `protobuf:"varint,6,opt,name=quantization,proto3,enum=llmariner.models.server.v1.QuantizationType" json:"quantization,omitempty"`
+	Path           string           `protobuf:"bytes,8,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *AcquireUnloadedModelRequest) Reset() {
-	*x = AcquireUnloadedModelRequest{}
+func (x *RegisterModelRequest) Reset() {
+	*x = RegisterModelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2687,13 +2990,13 @@ func (x *AcquireUnloadedModelRequest) Reset() {
 	}
 }
 
-func (x *AcquireUnloadedModelRequest) String() string {
+func (x *RegisterModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcquireUnloadedModelRequest) ProtoMessage() {}
+func (*RegisterModelRequest) ProtoMessage() {}
 
-func (x *AcquireUnloadedModelRequest) ProtoReflect() protoreflect.Message {
+func (x *RegisterModelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2705,123 +3008,94 @@ func (x *AcquireUnloadedModelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcquireUnloadedModelRequest.ProtoReflect.Descriptor instead.
-func (*AcquireUnloadedModelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegisterModelRequest.ProtoReflect.Descriptor instead.
+func (*RegisterModelRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{38}
 }
 
-type AcquireUnloadedModelResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ModelId           string           `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
-	IsBaseModel       bool             `protobuf:"varint,2,opt,name=is_base_model,json=isBaseModel,proto3" json:"is_base_model,omitempty"`
-	SourceRepository  SourceRepository `protobuf:"varint,3,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
-	ModelFileLocation string           `protobuf:"bytes,4,opt,name=model_file_location,json=modelFileLocation,proto3" json:"model_file_location,omitempty"`
-	DestPath          string           `protobuf:"bytes,5,opt,name=dest_path,json=destPath,proto3" json:"dest_path,omitempty"`
-}
-
-func (x *AcquireUnloadedModelResponse) Reset() {
-	*x = AcquireUnloadedModelResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[39]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AcquireUnloadedModelResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AcquireUnloadedModelResponse) ProtoMessage() {}
-
-func (x *AcquireUnloadedModelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[39]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AcquireUnloadedModelResponse.ProtoReflect.Descriptor instead.
-func (*AcquireUnloadedModelResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *AcquireUnloadedModelResponse) GetModelId() string {
+func (x *RegisterModelRequest) GetId() string {
 	if x != nil {
-		return x.ModelId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AcquireUnloadedModelResponse) GetIsBaseModel() bool {
+func (x *RegisterModelRequest) GetBaseModel() string {
 	if x != nil {
-		return x.IsBaseModel
+		return x.BaseModel
 	}
-	return false
+	return ""
 }
 
-func (x *AcquireUnloadedModelResponse) GetSourceRepository() SourceRepository {
+func (x *RegisterModelRequest) GetSuffix() string {
 	if x != nil {
-		return x.SourceRepository
+		return x.Suffix
 	}
-	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
+	return ""
 }
 
-func (x *AcquireUnloadedModelResponse) GetModelFileLocation() string {
+func (x *RegisterModelRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.ModelFileLocation
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *AcquireUnloadedModelResponse) GetDestPath() string {
+func (x *RegisterModelRequest) GetProjectId() string {
 	if x != nil {
-		return x.DestPath
+		return x.ProjectId
 	}
 	return ""
 }
 
-type UpdateModelLoadingStatusRequest struct {
+func (x *RegisterModelRequest) GetAdapter() AdapterType {
+	if x != nil {
+		return x.Adapter
+	}
+	return AdapterType_ADAPTER_TYPE_UNSPECIFIED
+}
+
+func (x *RegisterModelRequest) GetQuantization() QuantizationType {
+	if x != nil {
+		return x.Quantization
+	}
+	return QuantizationType_QUANTIZATION_TYPE_UNSPECIFIED
+}
+
+func (x *RegisterModelRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RegisterModelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// loading_result is the result of the model loading. Unset if the model is still loading.
-	//
-	// Types that are assignable to LoadingResult:
-	//
-	//	*UpdateModelLoadingStatusRequest_Success_
-	//	*UpdateModelLoadingStatusRequest_Failure_
-	LoadingResult isUpdateModelLoadingStatusRequest_LoadingResult `protobuf_oneof:"loading_result"`
-	StatusMessage string                                          `protobuf:"bytes,5,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	// path is the path where the model is stored.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *UpdateModelLoadingStatusRequest) Reset() {
-	*x = UpdateModelLoadingStatusRequest{}
+func (x *RegisterModelResponse) Reset() {
+	*x = RegisterModelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[40]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateModelLoadingStatusRequest) String() string {
+func (x *RegisterModelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateModelLoadingStatusRequest) ProtoMessage() {}
+func (*RegisterModelResponse) ProtoMessage() {}
 
-func (x *UpdateModelLoadingStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[40]
+func (x *RegisterModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2832,70 +3106,80 @@ func (x *UpdateModelLoadingStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateModelLoadingStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateModelLoadingStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use RegisterModelResponse.ProtoReflect.Descriptor instead.
+func (*RegisterModelResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UpdateModelLoadingStatusRequest) GetId() string {
+func (x *RegisterModelResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (m *UpdateModelLoadingStatusRequest) GetLoadingResult() isUpdateModelLoadingStatusRequest_LoadingResult {
-	if m != nil {
-		return m.LoadingResult
+func (x *RegisterModelResponse) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateModelLoadingStatusRequest) GetSuccess() *UpdateModelLoadingStatusRequest_Success {
-	if x, ok := x.GetLoadingResult().(*UpdateModelLoadingStatusRequest_Success_); ok {
-		return x.Success
-	}
-	return nil
-}
+type PublishModelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *UpdateModelLoadingStatusRequest) GetFailure() *UpdateModelLoadingStatusRequest_Failure {
-	if x, ok := x.GetLoadingResult().(*UpdateModelLoadingStatusRequest_Failure_); ok {
-		return x.Failure
-	}
-	return nil
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *UpdateModelLoadingStatusRequest) GetStatusMessage() string {
-	if x != nil {
-		return x.StatusMessage
+func (x *PublishModelRequest) Reset() {
+	*x = PublishModelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type isUpdateModelLoadingStatusRequest_LoadingResult interface {
-	isUpdateModelLoadingStatusRequest_LoadingResult()
+func (x *PublishModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type UpdateModelLoadingStatusRequest_Success_ struct {
-	Success *UpdateModelLoadingStatusRequest_Success `protobuf:"bytes,3,opt,name=success,proto3,oneof"`
-}
+func (*PublishModelRequest) ProtoMessage() {}
 
-type UpdateModelLoadingStatusRequest_Failure_ struct {
-	Failure *UpdateModelLoadingStatusRequest_Failure `protobuf:"bytes,4,opt,name=failure,proto3,oneof"`
+func (x *PublishModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*UpdateModelLoadingStatusRequest_Success_) isUpdateModelLoadingStatusRequest_LoadingResult() {}
+// Deprecated: Use PublishModelRequest.ProtoReflect.Descriptor instead.
+func (*PublishModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{40}
+}
 
-func (*UpdateModelLoadingStatusRequest_Failure_) isUpdateModelLoadingStatusRequest_LoadingResult() {}
+func (x *PublishModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
 
-type UpdateModelLoadingStatusResponse struct {
+type PublishModelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateModelLoadingStatusResponse) Reset() {
-	*x = UpdateModelLoadingStatusResponse{}
+func (x *PublishModelResponse) Reset() {
+	*x = PublishModelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2903,13 +3187,13 @@ func (x *UpdateModelLoadingStatusResponse) Reset() {
 	}
 }
 
-func (x *UpdateModelLoadingStatusResponse) String() string {
+func (x *PublishModelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateModelLoadingStatusResponse) ProtoMessage() {}
+func (*PublishModelResponse) ProtoMessage() {}
 
-func (x *UpdateModelLoadingStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *PublishModelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2921,26 +3205,22 @@ func (x *UpdateModelLoadingStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateModelLoadingStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateModelLoadingStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublishModelResponse.ProtoReflect.Descriptor instead.
+func (*PublishModelResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{41}
 }
 
-type ModelConfig_RuntimeConfig struct {
+// GetModelPathRequest is deprecated, use GetModelAttributesRequest instead.
+type GetModelPathRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// resources is the resources required to run the model.
-	Resources *ModelConfig_RuntimeConfig_Resources `protobuf:"bytes,1,opt,name=resources,proto3" json:"resources,omitempty"`
-	// replicas is the number of replicas to run the model.
-	Replicas int32 `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
-	// extra_args is a list of extra arguments to pass to the model runtime.
-	ExtraArgs []string `protobuf:"bytes,3,rep,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ModelConfig_RuntimeConfig) Reset() {
-	*x = ModelConfig_RuntimeConfig{}
+func (x *GetModelPathRequest) Reset() {
+	*x = GetModelPathRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2948,13 +3228,13 @@ func (x *ModelConfig_RuntimeConfig) Reset() {
 	}
 }
 
-func (x *ModelConfig_RuntimeConfig) String() string {
+func (x *GetModelPathRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelConfig_RuntimeConfig) ProtoMessage() {}
+func (*GetModelPathRequest) ProtoMessage() {}
 
-func (x *ModelConfig_RuntimeConfig) ProtoReflect() protoreflect.Message {
+func (x *GetModelPathRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2966,46 +3246,29 @@ func (x *ModelConfig_RuntimeConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelConfig_RuntimeConfig.ProtoReflect.Descriptor instead.
-func (*ModelConfig_RuntimeConfig) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{1, 0}
-}
-
-func (x *ModelConfig_RuntimeConfig) GetResources() *ModelConfig_RuntimeConfig_Resources {
-	if x != nil {
-		return x.Resources
-	}
-	return nil
-}
-
-func (x *ModelConfig_RuntimeConfig) GetReplicas() int32 {
-	if x != nil {
-		return x.Replicas
-	}
-	return 0
+// Deprecated: Use GetModelPathRequest.ProtoReflect.Descriptor instead.
+func (*GetModelPathRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ModelConfig_RuntimeConfig) GetExtraArgs() []string {
+func (x *GetModelPathRequest) GetId() string {
 	if x != nil {
-		return x.ExtraArgs
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ModelConfig_ClusterAllocationPolicy struct {
+// GetModelPathResponse is deprecated, use GetModelAttributesResponse instead.
+type GetModelPathResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// allowed_cluster_ids is a list of cluster IDs where the model can be allocated.
-	// If this field is empty, the model can be allocated to any cluster.
-	AllowedClusterIds []string `protobuf:"bytes,1,rep,name=allowed_cluster_ids,json=allowedClusterIds,proto3" json:"allowed_cluster_ids,omitempty"`
-	// enable_on_demand_allocation is set to true if on-demand allocation of the model is enabled.
-	EnableOnDemandAllocation bool `protobuf:"varint,2,opt,name=enable_on_demand_allocation,json=enableOnDemandAllocation,proto3" json:"enable_on_demand_allocation,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *ModelConfig_ClusterAllocationPolicy) Reset() {
-	*x = ModelConfig_ClusterAllocationPolicy{}
+func (x *GetModelPathResponse) Reset() {
+	*x = GetModelPathResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3013,13 +3276,13 @@ func (x *ModelConfig_ClusterAllocationPolicy) Reset() {
 	}
 }
 
-func (x *ModelConfig_ClusterAllocationPolicy) String() string {
+func (x *GetModelPathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelConfig_ClusterAllocationPolicy) ProtoMessage() {}
+func (*GetModelPathResponse) ProtoMessage() {}
 
-func (x *ModelConfig_ClusterAllocationPolicy) ProtoReflect() protoreflect.Message {
+func (x *GetModelPathResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3031,35 +3294,31 @@ func (x *ModelConfig_ClusterAllocationPolicy) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelConfig_ClusterAllocationPolicy.ProtoReflect.Descriptor instead.
-func (*ModelConfig_ClusterAllocationPolicy) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{1, 1}
-}
-
-func (x *ModelConfig_ClusterAllocationPolicy) GetAllowedClusterIds() []string {
-	if x != nil {
-		return x.AllowedClusterIds
-	}
-	return nil
+// Deprecated: Use GetModelPathResponse.ProtoReflect.Descriptor instead.
+func (*GetModelPathResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ModelConfig_ClusterAllocationPolicy) GetEnableOnDemandAllocation() bool {
+func (x *GetModelPathResponse) GetPath() string {
 	if x != nil {
-		return x.EnableOnDemandAllocation
+		return x.Path
 	}
-	return false
+	return ""
 }
 
-type ModelConfig_RuntimeConfig_Resources struct {
+type ModelAttributes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Gpu int32 `protobuf:"varint,1,opt,name=gpu,proto3" json:"gpu,omitempty"`
+	Path         string           `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	BaseModel    string           `protobuf:"bytes,2,opt,name=base_model,json=baseModel,proto3" json:"base_model,omitempty"`
+	Adapter      AdapterType      `protobuf:"varint,3,opt,name=adapter,proto3,enum=llmariner.models.server.v1.AdapterType" json:"adapter,omitempty"`
+	Quantization QuantizationType `protobuf:"varint,4,opt,name=quantization,proto3,enum=llmariner.models.server.v1.QuantizationType" json:"quantization,omitempty"`
 }
 
-func (x *ModelConfig_RuntimeConfig_Resources) Reset() {
-	*x = ModelConfig_RuntimeConfig_Resources{}
+func (x *ModelAttributes) Reset() {
+	*x = ModelAttributes{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3067,13 +3326,13 @@ func (x *ModelConfig_RuntimeConfig_Resources) Reset() {
 	}
 }
 
-func (x *ModelConfig_RuntimeConfig_Resources) String() string {
+func (x *ModelAttributes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelConfig_RuntimeConfig_Resources) ProtoMessage() {}
+func (*ModelAttributes) ProtoMessage() {}
 
-func (x *ModelConfig_RuntimeConfig_Resources) ProtoReflect() protoreflect.Message {
+func (x *ModelAttributes) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3085,29 +3344,49 @@ func (x *ModelConfig_RuntimeConfig_Resources) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelConfig_RuntimeConfig_Resources.ProtoReflect.Descriptor instead.
-func (*ModelConfig_RuntimeConfig_Resources) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{1, 0, 0}
+// Deprecated: Use ModelAttributes.ProtoReflect.Descriptor instead.
+func (*ModelAttributes) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ModelConfig_RuntimeConfig_Resources) GetGpu() int32 {
+func (x *ModelAttributes) GetPath() string {
 	if x != nil {
-		return x.Gpu
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-type ProjectAssignment_NodeSelector struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (x *ModelAttributes) GetBaseModel() string {
+	if x != nil {
+		return x.BaseModel
+	}
+	return ""
 }
 
-func (x *ProjectAssignment_NodeSelector) Reset() {
-	*x = ProjectAssignment_NodeSelector{}
+func (x *ModelAttributes) GetAdapter() AdapterType {
+	if x != nil {
+		return x.Adapter
+	}
+	return AdapterType_ADAPTER_TYPE_UNSPECIFIED
+}
+
+func (x *ModelAttributes) GetQuantization() QuantizationType {
+	if x != nil {
+		return x.Quantization
+	}
+	return QuantizationType_QUANTIZATION_TYPE_UNSPECIFIED
+}
+
+type GetModelAttributesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetModelAttributesRequest) Reset() {
+	*x = GetModelAttributesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3115,13 +3394,13 @@ func (x *ProjectAssignment_NodeSelector) Reset() {
 	}
 }
 
-func (x *ProjectAssignment_NodeSelector) String() string {
+func (x *GetModelAttributesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProjectAssignment_NodeSelector) ProtoMessage() {}
+func (*GetModelAttributesRequest) ProtoMessage() {}
 
-func (x *ProjectAssignment_NodeSelector) ProtoReflect() protoreflect.Message {
+func (x *GetModelAttributesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3133,33 +3412,67 @@ func (x *ProjectAssignment_NodeSelector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectAssignment_NodeSelector.ProtoReflect.Descriptor instead.
-func (*ProjectAssignment_NodeSelector) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *ProjectAssignment_NodeSelector) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use GetModelAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetModelAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ProjectAssignment_NodeSelector) GetValue() string {
+func (x *GetModelAttributesRequest) GetId() string {
 	if x != nil {
-		return x.Value
+		return x.Id
 	}
 	return ""
 }
 
-type UpdateBaseModelLoadingStatusRequest_Success struct {
+type CreateBaseModelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// formats is the supported formats of the base model. If not set, the format is considered as GGUF
+	// for backward compatibility.
+	Formats []ModelFormat `protobuf:"varint,4,rep,packed,name=formats,proto3,enum=llmariner.models.server.v1.ModelFormat" json:"formats,omitempty"`
+	// gguf_model_path is the path of the GGUF model. Set if the model suppors the GGUF formta.
+	GgufModelPath    string           `protobuf:"bytes,3,opt,name=gguf_model_path,json=ggufModelPath,proto3" json:"gguf_model_path,omitempty"`
+	SourceRepository SourceRepository `protobuf:"varint,5,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
+	ProjectId        string           `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// revision is the revision of the source repository that was requested.
+	Revision string `protobuf:"bytes,7,opt,name=revision,proto3" json:"revision,omitempty"`
+	// resolved_revision is the commit SHA that the revision was resolved to.
+	ResolvedRevision string `protobuf:"bytes,8,opt,name=resolved_revision,json=resolvedRevision,proto3" json:"resolved_revision,omitempty"`
+	// file_patterns is the patterns of the files that were loaded.
+	FilePatterns *FilePatterns `protobuf:"bytes,9,opt,name=file_patterns,json=filePatterns,proto3" json:"file_patterns,omitempty"`
+	// files is the list of the files of the model. The paths are relative to files_base_path.
+	Files         []*ModelFile `protobuf:"bytes,10,rep,name=files,proto3" json:"files,omitempty"`
+	FilesBasePath string       `protobuf:"bytes,11,opt,name=files_base_path,json=filesBasePath,proto3" json:"files_base_path,omitempty"`
+	Size          *ModelSize   `protobuf:"bytes,12,opt,name=size,proto3" json:"size,omitempty"`
+	// gguf_metadata is the metadata of the GGUF file at gguf_model_path.
+	GgufMetadata *GGUFMetadata `protobuf:"bytes,13,opt,name=gguf_metadata,json=ggufMetadata,proto3" json:"gguf_metadata,omitempty"`
+	// hugging_face_metadata is the metadata of the model in the Hugging Face format.
+	HuggingFaceMetadata *HuggingFaceMetadata `protobuf:"bytes,14,opt,name=hugging_face_metadata,json=huggingFaceMetadata,proto3" json:"hugging_face_metadata,omitempty"`
+	// artifacts are the artifacts of the formats. An artifact is required for each of the GGUF, ONNX, MLX,
+	// GPTQ, AWQ, and vLLM formats, and its path must be under the path except for GGUF. The artifacts of
+	// the other formats default to the path.
+	Artifacts []*FormatArtifact `protobuf:"bytes,20,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	// onnx_model_path is the path of the main ONNX file.
+	OnnxModelPath string `protobuf:"bytes,15,opt,name=onnx_model_path,json=onnxModelPath,proto3" json:"onnx_model_path,omitempty"`
+	// mlx_model_path is the path of the directory of the MLX model.
+	MlxModelPath string `protobuf:"bytes,16,opt,name=mlx_model_path,json=mlxModelPath,proto3" json:"mlx_model_path,omitempty"`
+	// gptq_model_path is the path of the directory of the GPTQ model.
+	GptqModelPath string `protobuf:"bytes,17,opt,name=gptq_model_path,json=gptqModelPath,proto3" json:"gptq_model_path,omitempty"`
+	// awq_model_path is the path of the directory of the AWQ model.
+	AwqModelPath string `protobuf:"bytes,18,opt,name=awq_model_path,json=awqModelPath,proto3" json:"awq_model_path,omitempty"`
+	// vllm_model_path is the path of the directory that vLLM serves.
+	VllmModelPath string `protobuf:"bytes,19,opt,name=vllm_model_path,json=vllmModelPath,proto3" json:"vllm_model_path,omitempty"`
+	// loader_id is the ID of the loader that holds the lease of the model. The model is not updated
+	// if the loader no longer holds the lease.
+	LoaderId string `protobuf:"bytes,21,opt,name=loader_id,json=loaderId,proto3" json:"loader_id,omitempty"`
 }
 
-func (x *UpdateBaseModelLoadingStatusRequest_Success) Reset() {
-	*x = UpdateBaseModelLoadingStatusRequest_Success{}
+func (x *CreateBaseModelRequest) Reset() {
+	*x = CreateBaseModelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_v1_model_manager_service_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3167,13 +3480,13 @@ func (x *UpdateBaseModelLoadingStatusRequest_Success) Reset() {
 	}
 }
 
-func (x *UpdateBaseModelLoadingStatusRequest_Success) String() string {
+func (x *CreateBaseModelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBaseModelLoadingStatusRequest_Success) ProtoMessage() {}
+func (*CreateBaseModelRequest) ProtoMessage() {}
 
-func (x *UpdateBaseModelLoadingStatusRequest_Success) ProtoReflect() protoreflect.Message {
+func (x *CreateBaseModelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_model_manager_service_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3185,121 +3498,185 @@ func (x *UpdateBaseModelLoadingStatusRequest_Success) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBaseModelLoadingStatusRequest_Success.ProtoReflect.Descriptor instead.
-func (*UpdateBaseModelLoadingStatusRequest_Success) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{36, 0}
+// Deprecated: Use CreateBaseModelRequest.ProtoReflect.Descriptor instead.
+func (*CreateBaseModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{46}
 }
 
-type UpdateBaseModelLoadingStatusRequest_Failure struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CreateBaseModelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
 
-	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+func (x *CreateBaseModelRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-func (x *UpdateBaseModelLoadingStatusRequest_Failure) Reset() {
-	*x = UpdateBaseModelLoadingStatusRequest_Failure{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[47]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateBaseModelRequest) GetFormats() []ModelFormat {
+	if x != nil {
+		return x.Formats
 	}
+	return nil
 }
 
-func (x *UpdateBaseModelLoadingStatusRequest_Failure) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateBaseModelRequest) GetGgufModelPath() string {
+	if x != nil {
+		return x.GgufModelPath
+	}
+	return ""
 }
 
-func (*UpdateBaseModelLoadingStatusRequest_Failure) ProtoMessage() {}
+func (x *CreateBaseModelRequest) GetSourceRepository() SourceRepository {
+	if x != nil {
+		return x.SourceRepository
+	}
+	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
+}
 
-func (x *UpdateBaseModelLoadingStatusRequest_Failure) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[47]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateBaseModelRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateBaseModelLoadingStatusRequest_Failure.ProtoReflect.Descriptor instead.
-func (*UpdateBaseModelLoadingStatusRequest_Failure) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{36, 1}
+func (x *CreateBaseModelRequest) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
 }
 
-func (x *UpdateBaseModelLoadingStatusRequest_Failure) GetReason() string {
+func (x *CreateBaseModelRequest) GetResolvedRevision() string {
 	if x != nil {
-		return x.Reason
+		return x.ResolvedRevision
 	}
 	return ""
 }
 
-type UpdateModelLoadingStatusRequest_Success struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CreateBaseModelRequest) GetFilePatterns() *FilePatterns {
+	if x != nil {
+		return x.FilePatterns
+	}
+	return nil
 }
 
-func (x *UpdateModelLoadingStatusRequest_Success) Reset() {
-	*x = UpdateModelLoadingStatusRequest_Success{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[48]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateBaseModelRequest) GetFiles() []*ModelFile {
+	if x != nil {
+		return x.Files
 	}
+	return nil
 }
 
-func (x *UpdateModelLoadingStatusRequest_Success) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateBaseModelRequest) GetFilesBasePath() string {
+	if x != nil {
+		return x.FilesBasePath
+	}
+	return ""
 }
 
-func (*UpdateModelLoadingStatusRequest_Success) ProtoMessage() {}
+func (x *CreateBaseModelRequest) GetSize() *ModelSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
 
-func (x *UpdateModelLoadingStatusRequest_Success) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[48]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateBaseModelRequest) GetGgufMetadata() *GGUFMetadata {
+	if x != nil {
+		return x.GgufMetadata
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UpdateModelLoadingStatusRequest_Success.ProtoReflect.Descriptor instead.
-func (*UpdateModelLoadingStatusRequest_Success) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{40, 0}
+func (x *CreateBaseModelRequest) GetHuggingFaceMetadata() *HuggingFaceMetadata {
+	if x != nil {
+		return x.HuggingFaceMetadata
+	}
+	return nil
 }
 
-type UpdateModelLoadingStatusRequest_Failure struct {
+func (x *CreateBaseModelRequest) GetArtifacts() []*FormatArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *CreateBaseModelRequest) GetOnnxModelPath() string {
+	if x != nil {
+		return x.OnnxModelPath
+	}
+	return ""
+}
+
+func (x *CreateBaseModelRequest) GetMlxModelPath() string {
+	if x != nil {
+		return x.MlxModelPath
+	}
+	return ""
+}
+
+func (x *CreateBaseModelRequest) GetGptqModelPath() string {
+	if x != nil {
+		return x.GptqModelPath
+	}
+	return ""
+}
+
+func (x *CreateBaseModelRequest) GetAwqModelPath() string {
+	if x != nil {
+		return x.AwqModelPath
+	}
+	return ""
+}
+
+func (x *CreateBaseModelRequest) GetVllmModelPath() string {
+	if x != nil {
+		return x.VllmModelPath
+	}
+	return ""
+}
+
+func (x *CreateBaseModelRequest) GetLoaderId() string {
+	if x != nil {
+		return x.LoaderId
+	}
+	return ""
+}
+
+type BaseModel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Object  string `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
 }
 
-func (x *UpdateModelLoadingStatusRequest_Failure) Reset() {
-	*x = UpdateModelLoadingStatusRequest_Failure{}
+func (x *BaseModel) Reset() {
+	*x = BaseModel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_v1_model_manager_service_proto_msgTypes[49]
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateModelLoadingStatusRequest_Failure) String() string {
+func (x *BaseModel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateModelLoadingStatusRequest_Failure) ProtoMessage() {}
+func (*BaseModel) ProtoMessage() {}
 
-func (x *UpdateModelLoadingStatusRequest_Failure) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_model_manager_service_proto_msgTypes[49]
+func (x *BaseModel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3310,219 +3687,2718 @@ func (x *UpdateModelLoadingStatusRequest_Failure) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateModelLoadingStatusRequest_Failure.ProtoReflect.Descriptor instead.
-func (*UpdateModelLoadingStatusRequest_Failure) Descriptor() ([]byte, []int) {
-	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{40, 1}
+// Deprecated: Use BaseModel.ProtoReflect.Descriptor instead.
+func (*BaseModel) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UpdateModelLoadingStatusRequest_Failure) GetReason() string {
+func (x *BaseModel) GetId() string {
 	if x != nil {
-		return x.Reason
+		return x.Id
 	}
 	return ""
 }
 
-var File_api_v1_model_manager_service_proto protoreflect.FileDescriptor
+func (x *BaseModel) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
 
-var file_api_v1_model_manager_service_proto_rawDesc = []byte{
-	0x0a, 0x22, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d,
-	0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x0c, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x66, 0x6f, 0x72,
+func (x *BaseModel) GetObject() string {
+	if x != nil {
+		return x.Object
+	}
+	return ""
+}
+
+type GetBaseModelPathRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// project_id is set to a non-empty value if the project-scoped base model is requested. If empty,
+	// the RPC returns either the globally-scoped base model or a project-scoped base model.
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GetBaseModelPathRequest) Reset() {
+	*x = GetBaseModelPathRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBaseModelPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBaseModelPathRequest) ProtoMessage() {}
+
+func (x *GetBaseModelPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBaseModelPathRequest.ProtoReflect.Descriptor instead.
+func (*GetBaseModelPathRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetBaseModelPathRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetBaseModelPathRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type GetBaseModelPathResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Formats []ModelFormat `protobuf:"varint,3,rep,packed,name=formats,proto3,enum=llmariner.models.server.v1.ModelFormat" json:"formats,omitempty"`
+	Path    string        `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// gguf_model_path is the path of the GGUF model. Set if the model suppors the GGUF formta.
+	GgufModelPath string `protobuf:"bytes,2,opt,name=gguf_model_path,json=ggufModelPath,proto3" json:"gguf_model_path,omitempty"`
+	// size is the size of the model files. Unset if the model was loaded before the size was recorded.
+	Size *ModelSize `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	// hugging_face_metadata is the metadata of the model in the Hugging Face format. Unset if the model
+	// does not have the format or was loaded before the metadata was recorded.
+	HuggingFaceMetadata *HuggingFaceMetadata `protobuf:"bytes,5,opt,name=hugging_face_metadata,json=huggingFaceMetadata,proto3" json:"hugging_face_metadata,omitempty"`
+	// artifacts are the artifacts of the formats that the model supports.
+	Artifacts []*FormatArtifact `protobuf:"bytes,11,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	// onnx_model_path is the path of the main ONNX file.
+	OnnxModelPath string `protobuf:"bytes,6,opt,name=onnx_model_path,json=onnxModelPath,proto3" json:"onnx_model_path,omitempty"`
+	// mlx_model_path is the path of the directory of the MLX model.
+	MlxModelPath string `protobuf:"bytes,7,opt,name=mlx_model_path,json=mlxModelPath,proto3" json:"mlx_model_path,omitempty"`
+	// gptq_model_path is the path of the directory of the GPTQ model.
+	GptqModelPath string `protobuf:"bytes,8,opt,name=gptq_model_path,json=gptqModelPath,proto3" json:"gptq_model_path,omitempty"`
+	// awq_model_path is the path of the directory of the AWQ model.
+	AwqModelPath string `protobuf:"bytes,9,opt,name=awq_model_path,json=awqModelPath,proto3" json:"awq_model_path,omitempty"`
+	// vllm_model_path is the path of the directory that vLLM serves.
+	VllmModelPath string `protobuf:"bytes,10,opt,name=vllm_model_path,json=vllmModelPath,proto3" json:"vllm_model_path,omitempty"`
+}
+
+func (x *GetBaseModelPathResponse) Reset() {
+	*x = GetBaseModelPathResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBaseModelPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBaseModelPathResponse) ProtoMessage() {}
+
+func (x *GetBaseModelPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBaseModelPathResponse.ProtoReflect.Descriptor instead.
+func (*GetBaseModelPathResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetBaseModelPathResponse) GetFormats() []ModelFormat {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+func (x *GetBaseModelPathResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetBaseModelPathResponse) GetGgufModelPath() string {
+	if x != nil {
+		return x.GgufModelPath
+	}
+	return ""
+}
+
+func (x *GetBaseModelPathResponse) GetSize() *ModelSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *GetBaseModelPathResponse) GetHuggingFaceMetadata() *HuggingFaceMetadata {
+	if x != nil {
+		return x.HuggingFaceMetadata
+	}
+	return nil
+}
+
+func (x *GetBaseModelPathResponse) GetArtifacts() []*FormatArtifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *GetBaseModelPathResponse) GetOnnxModelPath() string {
+	if x != nil {
+		return x.OnnxModelPath
+	}
+	return ""
+}
+
+func (x *GetBaseModelPathResponse) GetMlxModelPath() string {
+	if x != nil {
+		return x.MlxModelPath
+	}
+	return ""
+}
+
+func (x *GetBaseModelPathResponse) GetGptqModelPath() string {
+	if x != nil {
+		return x.GptqModelPath
+	}
+	return ""
+}
+
+func (x *GetBaseModelPathResponse) GetAwqModelPath() string {
+	if x != nil {
+		return x.AwqModelPath
+	}
+	return ""
+}
+
+func (x *GetBaseModelPathResponse) GetVllmModelPath() string {
+	if x != nil {
+		return x.VllmModelPath
+	}
+	return ""
+}
+
+type CreateHFModelRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// revision is the commit SHA of the downloaded repository.
+	Revision string `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *CreateHFModelRepoRequest) Reset() {
+	*x = CreateHFModelRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateHFModelRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHFModelRepoRequest) ProtoMessage() {}
+
+func (x *CreateHFModelRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHFModelRepoRequest.ProtoReflect.Descriptor instead.
+func (*CreateHFModelRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateHFModelRepoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateHFModelRepoRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *CreateHFModelRepoRequest) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+type HFModelRepo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// revision is the commit SHA of the downloaded repository.
+	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *HFModelRepo) Reset() {
+	*x = HFModelRepo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HFModelRepo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HFModelRepo) ProtoMessage() {}
+
+func (x *HFModelRepo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HFModelRepo.ProtoReflect.Descriptor instead.
+func (*HFModelRepo) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *HFModelRepo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HFModelRepo) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+type GetHFModelRepoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *GetHFModelRepoRequest) Reset() {
+	*x = GetHFModelRepoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHFModelRepoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHFModelRepoRequest) ProtoMessage() {}
+
+func (x *GetHFModelRepoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHFModelRepoRequest.ProtoReflect.Descriptor instead.
+func (*GetHFModelRepoRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetHFModelRepoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetHFModelRepoRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+type AcquireUnloadedBaseModelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loader_id is the ID of the loader that acquires the model. The loader holds a lease of the model
+	// while loading it and needs to renew the lease with UpdateBaseModelLoadingStatus.
+	LoaderId string `protobuf:"bytes,1,opt,name=loader_id,json=loaderId,proto3" json:"loader_id,omitempty"`
+	// supported_source_repositories is the list of the source repositories that the loader can download
+	// models from. Only the models from these source repositories are acquired. Models from any source
+	// repository are acquired if empty.
+	SupportedSourceRepositories []SourceRepository `protobuf:"varint,2,rep,packed,name=supported_source_repositories,json=supportedSourceRepositories,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"supported_source_repositories,omitempty"`
+	// cluster_id is the ID of the cluster where the loader runs. Models whose cluster allocation policy
+	// does not allow the cluster are not acquired. The cluster of the authenticated worker takes precedence
+	// if authentication is enabled.
+	ClusterId string `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// free_disk_bytes is the free disk space of the loader. Models that are known to be larger than it are
+	// not acquired. The disk space is not checked if zero.
+	FreeDiskBytes int64 `protobuf:"varint,4,opt,name=free_disk_bytes,json=freeDiskBytes,proto3" json:"free_disk_bytes,omitempty"`
+}
+
+func (x *AcquireUnloadedBaseModelRequest) Reset() {
+	*x = AcquireUnloadedBaseModelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcquireUnloadedBaseModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireUnloadedBaseModelRequest) ProtoMessage() {}
+
+func (x *AcquireUnloadedBaseModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireUnloadedBaseModelRequest.ProtoReflect.Descriptor instead.
+func (*AcquireUnloadedBaseModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AcquireUnloadedBaseModelRequest) GetLoaderId() string {
+	if x != nil {
+		return x.LoaderId
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedBaseModelRequest) GetSupportedSourceRepositories() []SourceRepository {
+	if x != nil {
+		return x.SupportedSourceRepositories
+	}
+	return nil
+}
+
+func (x *AcquireUnloadedBaseModelRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedBaseModelRequest) GetFreeDiskBytes() int64 {
+	if x != nil {
+		return x.FreeDiskBytes
+	}
+	return 0
+}
+
+type AcquireUnloadedBaseModelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// base_model_id is ID of the acquired base model. Empty if there is no unloaded base model.
+	BaseModelId      string           `protobuf:"bytes,1,opt,name=base_model_id,json=baseModelId,proto3" json:"base_model_id,omitempty"`
+	SourceRepository SourceRepository `protobuf:"varint,2,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
+	ProjectId        string           `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// revision is the revision of the source repository to download. Empty if the default branch is used.
+	Revision string `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	// file_patterns selects the files to load. All files are loaded if not set.
+	FilePatterns *FilePatterns `protobuf:"bytes,5,opt,name=file_patterns,json=filePatterns,proto3" json:"file_patterns,omitempty"`
+	// model_file_location is the URL to download the model from. Only set if the source repository is
+	// SOURCE_REPOSITORY_HTTP.
+	ModelFileLocation string `protobuf:"bytes,6,opt,name=model_file_location,json=modelFileLocation,proto3" json:"model_file_location,omitempty"`
+	// checksum is the expected checksum of the downloaded file. Empty if the checksum is not verified.
+	Checksum string `protobuf:"bytes,7,opt,name=checksum,proto3" json:"checksum,omitempty"`
+}
+
+func (x *AcquireUnloadedBaseModelResponse) Reset() {
+	*x = AcquireUnloadedBaseModelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcquireUnloadedBaseModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireUnloadedBaseModelResponse) ProtoMessage() {}
+
+func (x *AcquireUnloadedBaseModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireUnloadedBaseModelResponse.ProtoReflect.Descriptor instead.
+func (*AcquireUnloadedBaseModelResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AcquireUnloadedBaseModelResponse) GetBaseModelId() string {
+	if x != nil {
+		return x.BaseModelId
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedBaseModelResponse) GetSourceRepository() SourceRepository {
+	if x != nil {
+		return x.SourceRepository
+	}
+	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
+}
+
+func (x *AcquireUnloadedBaseModelResponse) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedBaseModelResponse) GetRevision() string {
+	if x != nil {
+		return x.Revision
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedBaseModelResponse) GetFilePatterns() *FilePatterns {
+	if x != nil {
+		return x.FilePatterns
+	}
+	return nil
+}
+
+func (x *AcquireUnloadedBaseModelResponse) GetModelFileLocation() string {
+	if x != nil {
+		return x.ModelFileLocation
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedBaseModelResponse) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+type UpdateBaseModelLoadingStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId string `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// loading_result is the result of the model loading. Unset if the model is still loading.
+	//
+	// Types that are assignable to LoadingResult:
+	//
+	//	*UpdateBaseModelLoadingStatusRequest_Success_
+	//	*UpdateBaseModelLoadingStatusRequest_Failure_
+	//	*UpdateBaseModelLoadingStatusRequest_Cancelled_
+	LoadingResult isUpdateBaseModelLoadingStatusRequest_LoadingResult `protobuf_oneof:"loading_result"`
+	StatusMessage string                                              `protobuf:"bytes,5,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	// loader_id is the ID of the loader that holds the lease of the model. The lease is renewed
+	// when the status message is updated.
+	LoaderId string `protobuf:"bytes,6,opt,name=loader_id,json=loaderId,proto3" json:"loader_id,omitempty"`
+	// progress is the byte-level progress of the loading. Set together with status_message.
+	Progress *ModelLoadingProgress `protobuf:"bytes,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	// upload_path is the object store path that the model files are being uploaded to. Set together with
+	// status_message. The uploaded objects are deleted if the lease expires while the loading is being cancelled.
+	UploadPath string `protobuf:"bytes,9,opt,name=upload_path,json=uploadPath,proto3" json:"upload_path,omitempty"`
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) Reset() {
+	*x = UpdateBaseModelLoadingStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBaseModelLoadingStatusRequest) ProtoMessage() {}
+
+func (x *UpdateBaseModelLoadingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBaseModelLoadingStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBaseModelLoadingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (m *UpdateBaseModelLoadingStatusRequest) GetLoadingResult() isUpdateBaseModelLoadingStatusRequest_LoadingResult {
+	if m != nil {
+		return m.LoadingResult
+	}
+	return nil
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) GetSuccess() *UpdateBaseModelLoadingStatusRequest_Success {
+	if x, ok := x.GetLoadingResult().(*UpdateBaseModelLoadingStatusRequest_Success_); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) GetFailure() *UpdateBaseModelLoadingStatusRequest_Failure {
+	if x, ok := x.GetLoadingResult().(*UpdateBaseModelLoadingStatusRequest_Failure_); ok {
+		return x.Failure
+	}
+	return nil
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) GetCancelled() *UpdateBaseModelLoadingStatusRequest_Cancelled {
+	if x, ok := x.GetLoadingResult().(*UpdateBaseModelLoadingStatusRequest_Cancelled_); ok {
+		return x.Cancelled
+	}
+	return nil
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) GetLoaderId() string {
+	if x != nil {
+		return x.LoaderId
+	}
+	return ""
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) GetProgress() *ModelLoadingProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest) GetUploadPath() string {
+	if x != nil {
+		return x.UploadPath
+	}
+	return ""
+}
+
+type isUpdateBaseModelLoadingStatusRequest_LoadingResult interface {
+	isUpdateBaseModelLoadingStatusRequest_LoadingResult()
+}
+
+type UpdateBaseModelLoadingStatusRequest_Success_ struct {
+	Success *UpdateBaseModelLoadingStatusRequest_Success `protobuf:"bytes,2,opt,name=success,proto3,oneof"`
+}
+
+type UpdateBaseModelLoadingStatusRequest_Failure_ struct {
+	Failure *UpdateBaseModelLoadingStatusRequest_Failure `protobuf:"bytes,3,opt,name=failure,proto3,oneof"`
+}
+
+type UpdateBaseModelLoadingStatusRequest_Cancelled_ struct {
+	// cancelled is set when the loader stops loading the model after the cancellation is requested.
+	Cancelled *UpdateBaseModelLoadingStatusRequest_Cancelled `protobuf:"bytes,8,opt,name=cancelled,proto3,oneof"`
+}
+
+func (*UpdateBaseModelLoadingStatusRequest_Success_) isUpdateBaseModelLoadingStatusRequest_LoadingResult() {
+}
+
+func (*UpdateBaseModelLoadingStatusRequest_Failure_) isUpdateBaseModelLoadingStatusRequest_LoadingResult() {
+}
+
+func (*UpdateBaseModelLoadingStatusRequest_Cancelled_) isUpdateBaseModelLoadingStatusRequest_LoadingResult() {
+}
+
+type UpdateBaseModelLoadingStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cancelled is true if the cancellation of the loading has been requested with CancelModelLoad. The loader
+	// should stop loading the model, clean up the uploaded objects, and report the cancelled result.
+	Cancelled bool `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (x *UpdateBaseModelLoadingStatusResponse) Reset() {
+	*x = UpdateBaseModelLoadingStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBaseModelLoadingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBaseModelLoadingStatusResponse) ProtoMessage() {}
+
+func (x *UpdateBaseModelLoadingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBaseModelLoadingStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBaseModelLoadingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateBaseModelLoadingStatusResponse) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+type AcquireUnloadedModelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loader_id is the ID of the loader that acquires the model. The loader holds a lease of the model
+	// while loading it and needs to renew the lease with UpdateModelLoadingStatus.
+	LoaderId string `protobuf:"bytes,1,opt,name=loader_id,json=loaderId,proto3" json:"loader_id,omitempty"`
+	// supported_source_repositories is the list of the source repositories that the loader can download
+	// models from. Only the models from these source repositories are acquired. Models from any source
+	// repository are acquired if empty.
+	SupportedSourceRepositories []SourceRepository `protobuf:"varint,2,rep,packed,name=supported_source_repositories,json=supportedSourceRepositories,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"supported_source_repositories,omitempty"`
+	// cluster_id is the ID of the cluster where the loader runs. Models whose cluster allocation policy
+	// does not allow the cluster are not acquired. The cluster of the authenticated worker takes precedence
+	// if authentication is enabled.
+	ClusterId string `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// free_disk_bytes is the free disk space of the loader. Models that are known to be larger than it are
+	// not acquired. The disk space is not checked if zero.
+	FreeDiskBytes int64 `protobuf:"varint,4,opt,name=free_disk_bytes,json=freeDiskBytes,proto3" json:"free_disk_bytes,omitempty"`
+}
+
+func (x *AcquireUnloadedModelRequest) Reset() {
+	*x = AcquireUnloadedModelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcquireUnloadedModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireUnloadedModelRequest) ProtoMessage() {}
+
+func (x *AcquireUnloadedModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireUnloadedModelRequest.ProtoReflect.Descriptor instead.
+func (*AcquireUnloadedModelRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AcquireUnloadedModelRequest) GetLoaderId() string {
+	if x != nil {
+		return x.LoaderId
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedModelRequest) GetSupportedSourceRepositories() []SourceRepository {
+	if x != nil {
+		return x.SupportedSourceRepositories
+	}
+	return nil
+}
+
+func (x *AcquireUnloadedModelRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedModelRequest) GetFreeDiskBytes() int64 {
+	if x != nil {
+		return x.FreeDiskBytes
+	}
+	return 0
+}
+
+type AcquireUnloadedModelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModelId           string           `protobuf:"bytes,1,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	IsBaseModel       bool             `protobuf:"varint,2,opt,name=is_base_model,json=isBaseModel,proto3" json:"is_base_model,omitempty"`
+	SourceRepository  SourceRepository `protobuf:"varint,3,opt,name=source_repository,json=sourceRepository,proto3,enum=llmariner.models.server.v1.SourceRepository" json:"source_repository,omitempty"`
+	ModelFileLocation string           `protobuf:"bytes,4,opt,name=model_file_location,json=modelFileLocation,proto3" json:"model_file_location,omitempty"`
+	DestPath          string           `protobuf:"bytes,5,opt,name=dest_path,json=destPath,proto3" json:"dest_path,omitempty"`
+	// file_patterns selects the files to load. All files are loaded if not set.
+	FilePatterns *FilePatterns `protobuf:"bytes,6,opt,name=file_patterns,json=filePatterns,proto3" json:"file_patterns,omitempty"`
+}
+
+func (x *AcquireUnloadedModelResponse) Reset() {
+	*x = AcquireUnloadedModelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcquireUnloadedModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireUnloadedModelResponse) ProtoMessage() {}
+
+func (x *AcquireUnloadedModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireUnloadedModelResponse.ProtoReflect.Descriptor instead.
+func (*AcquireUnloadedModelResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AcquireUnloadedModelResponse) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedModelResponse) GetIsBaseModel() bool {
+	if x != nil {
+		return x.IsBaseModel
+	}
+	return false
+}
+
+func (x *AcquireUnloadedModelResponse) GetSourceRepository() SourceRepository {
+	if x != nil {
+		return x.SourceRepository
+	}
+	return SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
+}
+
+func (x *AcquireUnloadedModelResponse) GetModelFileLocation() string {
+	if x != nil {
+		return x.ModelFileLocation
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedModelResponse) GetDestPath() string {
+	if x != nil {
+		return x.DestPath
+	}
+	return ""
+}
+
+func (x *AcquireUnloadedModelResponse) GetFilePatterns() *FilePatterns {
+	if x != nil {
+		return x.FilePatterns
+	}
+	return nil
+}
+
+type UpdateModelLoadingStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// loading_result is the result of the model loading. Unset if the model is still loading.
+	//
+	// Types that are assignable to LoadingResult:
+	//
+	//	*UpdateModelLoadingStatusRequest_Success_
+	//	*UpdateModelLoadingStatusRequest_Failure_
+	//	*UpdateModelLoadingStatusRequest_Cancelled_
+	LoadingResult isUpdateModelLoadingStatusRequest_LoadingResult `protobuf_oneof:"loading_result"`
+	StatusMessage string                                          `protobuf:"bytes,5,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	// loader_id is the ID of the loader that holds the lease of the model. The lease is renewed
+	// when the status message is updated.
+	LoaderId string `protobuf:"bytes,6,opt,name=loader_id,json=loaderId,proto3" json:"loader_id,omitempty"`
+	// progress is the byte-level progress of the loading. Set together with status_message.
+	Progress *ModelLoadingProgress `protobuf:"bytes,7,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (x *UpdateModelLoadingStatusRequest) Reset() {
+	*x = UpdateModelLoadingStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateModelLoadingStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelLoadingStatusRequest) ProtoMessage() {}
+
+func (x *UpdateModelLoadingStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelLoadingStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateModelLoadingStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateModelLoadingStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (m *UpdateModelLoadingStatusRequest) GetLoadingResult() isUpdateModelLoadingStatusRequest_LoadingResult {
+	if m != nil {
+		return m.LoadingResult
+	}
+	return nil
+}
+
+func (x *UpdateModelLoadingStatusRequest) GetSuccess() *UpdateModelLoadingStatusRequest_Success {
+	if x, ok := x.GetLoadingResult().(*UpdateModelLoadingStatusRequest_Success_); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *UpdateModelLoadingStatusRequest) GetFailure() *UpdateModelLoadingStatusRequest_Failure {
+	if x, ok := x.GetLoadingResult().(*UpdateModelLoadingStatusRequest_Failure_); ok {
+		return x.Failure
+	}
+	return nil
+}
+
+func (x *UpdateModelLoadingStatusRequest) GetCancelled() *UpdateModelLoadingStatusRequest_Cancelled {
+	if x, ok := x.GetLoadingResult().(*UpdateModelLoadingStatusRequest_Cancelled_); ok {
+		return x.Cancelled
+	}
+	return nil
+}
+
+func (x *UpdateModelLoadingStatusRequest) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *UpdateModelLoadingStatusRequest) GetLoaderId() string {
+	if x != nil {
+		return x.LoaderId
+	}
+	return ""
+}
+
+func (x *UpdateModelLoadingStatusRequest) GetProgress() *ModelLoadingProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type isUpdateModelLoadingStatusRequest_LoadingResult interface {
+	isUpdateModelLoadingStatusRequest_LoadingResult()
+}
+
+type UpdateModelLoadingStatusRequest_Success_ struct {
+	Success *UpdateModelLoadingStatusRequest_Success `protobuf:"bytes,3,opt,name=success,proto3,oneof"`
+}
+
+type UpdateModelLoadingStatusRequest_Failure_ struct {
+	Failure *UpdateModelLoadingStatusRequest_Failure `protobuf:"bytes,4,opt,name=failure,proto3,oneof"`
+}
+
+type UpdateModelLoadingStatusRequest_Cancelled_ struct {
+	// cancelled is set when the loader stops loading the model after the cancellation is requested.
+	Cancelled *UpdateModelLoadingStatusRequest_Cancelled `protobuf:"bytes,8,opt,name=cancelled,proto3,oneof"`
+}
+
+func (*UpdateModelLoadingStatusRequest_Success_) isUpdateModelLoadingStatusRequest_LoadingResult() {}
+
+func (*UpdateModelLoadingStatusRequest_Failure_) isUpdateModelLoadingStatusRequest_LoadingResult() {}
+
+func (*UpdateModelLoadingStatusRequest_Cancelled_) isUpdateModelLoadingStatusRequest_LoadingResult() {
+}
+
+type UpdateModelLoadingStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cancelled is true if the cancellation of the loading has been requested with CancelModelLoad. The loader
+	// should stop loading the model, clean up the uploaded objects, and report the cancelled result.
+	Cancelled bool `protobuf:"varint,1,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (x *UpdateModelLoadingStatusResponse) Reset() {
+	*x = UpdateModelLoadingStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateModelLoadingStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelLoadingStatusResponse) ProtoMessage() {}
+
+func (x *UpdateModelLoadingStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelLoadingStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateModelLoadingStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateModelLoadingStatusResponse) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+type AcquireObjectDeletionTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// loader_id is the ID of the loader that acquires the task. The loader holds a lease of the task
+	// until it completes the task. The task is handed to another loader once the lease expires.
+	LoaderId string `protobuf:"bytes,1,opt,name=loader_id,json=loaderId,proto3" json:"loader_id,omitempty"`
+}
+
+func (x *AcquireObjectDeletionTaskRequest) Reset() {
+	*x = AcquireObjectDeletionTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcquireObjectDeletionTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireObjectDeletionTaskRequest) ProtoMessage() {}
+
+func (x *AcquireObjectDeletionTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireObjectDeletionTaskRequest.ProtoReflect.Descriptor instead.
+func (*AcquireObjectDeletionTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AcquireObjectDeletionTaskRequest) GetLoaderId() string {
+	if x != nil {
+		return x.LoaderId
+	}
+	return ""
+}
+
+type AcquireObjectDeletionTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// task_id is the ID of the acquired task. Zero if there is no pending task.
+	TaskId int64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// path is the object store path of the deleted model. The objects under the path need to be deleted.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// uploads_only is true if the task is to clean up a loading that was abandoned while being cancelled.
+	// Only the multipart uploads, the uploaded objects and the upload manifest at the path need to be deleted.
+	UploadsOnly bool `protobuf:"varint,3,opt,name=uploads_only,json=uploadsOnly,proto3" json:"uploads_only,omitempty"`
+}
+
+func (x *AcquireObjectDeletionTaskResponse) Reset() {
+	*x = AcquireObjectDeletionTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcquireObjectDeletionTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcquireObjectDeletionTaskResponse) ProtoMessage() {}
+
+func (x *AcquireObjectDeletionTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcquireObjectDeletionTaskResponse.ProtoReflect.Descriptor instead.
+func (*AcquireObjectDeletionTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AcquireObjectDeletionTaskResponse) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *AcquireObjectDeletionTaskResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AcquireObjectDeletionTaskResponse) GetUploadsOnly() bool {
+	if x != nil {
+		return x.UploadsOnly
+	}
+	return false
+}
+
+type CompleteObjectDeletionTaskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId   int64  `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	LoaderId string `protobuf:"bytes,2,opt,name=loader_id,json=loaderId,proto3" json:"loader_id,omitempty"`
+}
+
+func (x *CompleteObjectDeletionTaskRequest) Reset() {
+	*x = CompleteObjectDeletionTaskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteObjectDeletionTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteObjectDeletionTaskRequest) ProtoMessage() {}
+
+func (x *CompleteObjectDeletionTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteObjectDeletionTaskRequest.ProtoReflect.Descriptor instead.
+func (*CompleteObjectDeletionTaskRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CompleteObjectDeletionTaskRequest) GetTaskId() int64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *CompleteObjectDeletionTaskRequest) GetLoaderId() string {
+	if x != nil {
+		return x.LoaderId
+	}
+	return ""
+}
+
+type CompleteObjectDeletionTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CompleteObjectDeletionTaskResponse) Reset() {
+	*x = CompleteObjectDeletionTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteObjectDeletionTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteObjectDeletionTaskResponse) ProtoMessage() {}
+
+func (x *CompleteObjectDeletionTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteObjectDeletionTaskResponse.ProtoReflect.Descriptor instead.
+func (*CompleteObjectDeletionTaskResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{64}
+}
+
+type ListModelPathsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListModelPathsRequest) Reset() {
+	*x = ListModelPathsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModelPathsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModelPathsRequest) ProtoMessage() {}
+
+func (x *ListModelPathsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModelPathsRequest.ProtoReflect.Descriptor instead.
+func (*ListModelPathsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{65}
+}
+
+type ListModelPathsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// paths are the object store paths of the models of all tenants.
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+}
+
+func (x *ListModelPathsResponse) Reset() {
+	*x = ListModelPathsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListModelPathsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModelPathsResponse) ProtoMessage() {}
+
+func (x *ListModelPathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModelPathsResponse.ProtoReflect.Descriptor instead.
+func (*ListModelPathsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListModelPathsResponse) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type ListLoadedModelPathsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListLoadedModelPathsRequest) Reset() {
+	*x = ListLoadedModelPathsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoadedModelPathsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoadedModelPathsRequest) ProtoMessage() {}
+
+func (x *ListLoadedModelPathsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoadedModelPathsRequest.ProtoReflect.Descriptor instead.
+func (*ListLoadedModelPathsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{67}
+}
+
+type LoadedModelPath struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// project_id is set only for project-scoped base models.
+	ProjectId     string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	IsBaseModel   bool   `protobuf:"varint,3,opt,name=is_base_model,json=isBaseModel,proto3" json:"is_base_model,omitempty"`
+	Path          string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	GgufModelPath string `protobuf:"bytes,5,opt,name=gguf_model_path,json=ggufModelPath,proto3" json:"gguf_model_path,omitempty"`
+	// loading_status is either SUCCEEDED or DEGRADED.
+	LoadingStatus ModelLoadingStatus `protobuf:"varint,6,opt,name=loading_status,json=loadingStatus,proto3,enum=llmariner.models.server.v1.ModelLoadingStatus" json:"loading_status,omitempty"`
+	// degraded_reason is the reason why the model is degraded.
+	DegradedReason string `protobuf:"bytes,7,opt,name=degraded_reason,json=degradedReason,proto3" json:"degraded_reason,omitempty"`
+}
+
+func (x *LoadedModelPath) Reset() {
+	*x = LoadedModelPath{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadedModelPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadedModelPath) ProtoMessage() {}
+
+func (x *LoadedModelPath) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadedModelPath.ProtoReflect.Descriptor instead.
+func (*LoadedModelPath) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LoadedModelPath) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LoadedModelPath) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *LoadedModelPath) GetIsBaseModel() bool {
+	if x != nil {
+		return x.IsBaseModel
+	}
+	return false
+}
+
+func (x *LoadedModelPath) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *LoadedModelPath) GetGgufModelPath() string {
+	if x != nil {
+		return x.GgufModelPath
+	}
+	return ""
+}
+
+func (x *LoadedModelPath) GetLoadingStatus() ModelLoadingStatus {
+	if x != nil {
+		return x.LoadingStatus
+	}
+	return ModelLoadingStatus_MODEL_LOADING_STATUS_UNSPECIFIED
+}
+
+func (x *LoadedModelPath) GetDegradedReason() string {
+	if x != nil {
+		return x.DegradedReason
+	}
+	return ""
+}
+
+type ListLoadedModelPathsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Models []*LoadedModelPath `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+}
+
+func (x *ListLoadedModelPathsResponse) Reset() {
+	*x = ListLoadedModelPathsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLoadedModelPathsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoadedModelPathsResponse) ProtoMessage() {}
+
+func (x *ListLoadedModelPathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoadedModelPathsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoadedModelPathsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListLoadedModelPathsResponse) GetModels() []*LoadedModelPath {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+type UpdateModelDegradationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId   string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	IsBaseModel bool   `protobuf:"varint,3,opt,name=is_base_model,json=isBaseModel,proto3" json:"is_base_model,omitempty"`
+	// degraded is true if the files of the model are missing or partially present. The model is
+	// marked as DEGRADED. Otherwise a degraded model is marked as SUCCEEDED again.
+	Degraded bool   `protobuf:"varint,4,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	Reason   string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *UpdateModelDegradationRequest) Reset() {
+	*x = UpdateModelDegradationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateModelDegradationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelDegradationRequest) ProtoMessage() {}
+
+func (x *UpdateModelDegradationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelDegradationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateModelDegradationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateModelDegradationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateModelDegradationRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *UpdateModelDegradationRequest) GetIsBaseModel() bool {
+	if x != nil {
+		return x.IsBaseModel
+	}
+	return false
+}
+
+func (x *UpdateModelDegradationRequest) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *UpdateModelDegradationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateModelDegradationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateModelDegradationResponse) Reset() {
+	*x = UpdateModelDegradationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateModelDegradationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelDegradationResponse) ProtoMessage() {}
+
+func (x *UpdateModelDegradationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelDegradationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateModelDegradationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{71}
+}
+
+type ModelSize_FormatSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format ModelFormat `protobuf:"varint,1,opt,name=format,proto3,enum=llmariner.models.server.v1.ModelFormat" json:"format,omitempty"`
+	// size_bytes is the size of the files required to serve the model in the format. For example, it does not
+	// include the GGUF files for the Hugging Face format.
+	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *ModelSize_FormatSize) Reset() {
+	*x = ModelSize_FormatSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelSize_FormatSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelSize_FormatSize) ProtoMessage() {}
+
+func (x *ModelSize_FormatSize) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelSize_FormatSize.ProtoReflect.Descriptor instead.
+func (*ModelSize_FormatSize) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ModelSize_FormatSize) GetFormat() ModelFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ModelFormat_MODEL_FORMAT_UNSPECIFIED
+}
+
+func (x *ModelSize_FormatSize) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type ModelConfig_RuntimeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// resources is the resources required to run the model.
+	Resources *ModelConfig_RuntimeConfig_Resources `protobuf:"bytes,1,opt,name=resources,proto3" json:"resources,omitempty"`
+	// replicas is the number of replicas to run the model.
+	Replicas int32 `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	// extra_args is a list of extra arguments to pass to the model runtime.
+	ExtraArgs []string `protobuf:"bytes,3,rep,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
+}
+
+func (x *ModelConfig_RuntimeConfig) Reset() {
+	*x = ModelConfig_RuntimeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelConfig_RuntimeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelConfig_RuntimeConfig) ProtoMessage() {}
+
+func (x *ModelConfig_RuntimeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelConfig_RuntimeConfig.ProtoReflect.Descriptor instead.
+func (*ModelConfig_RuntimeConfig) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *ModelConfig_RuntimeConfig) GetResources() *ModelConfig_RuntimeConfig_Resources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ModelConfig_RuntimeConfig) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *ModelConfig_RuntimeConfig) GetExtraArgs() []string {
+	if x != nil {
+		return x.ExtraArgs
+	}
+	return nil
+}
+
+type ModelConfig_ClusterAllocationPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// allowed_cluster_ids is a list of cluster IDs where the model can be allocated.
+	// If this field is empty, the model can be allocated to any cluster.
+	AllowedClusterIds []string `protobuf:"bytes,1,rep,name=allowed_cluster_ids,json=allowedClusterIds,proto3" json:"allowed_cluster_ids,omitempty"`
+	// enable_on_demand_allocation is set to true if on-demand allocation of the model is enabled.
+	EnableOnDemandAllocation bool `protobuf:"varint,2,opt,name=enable_on_demand_allocation,json=enableOnDemandAllocation,proto3" json:"enable_on_demand_allocation,omitempty"`
+}
+
+func (x *ModelConfig_ClusterAllocationPolicy) Reset() {
+	*x = ModelConfig_ClusterAllocationPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelConfig_ClusterAllocationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelConfig_ClusterAllocationPolicy) ProtoMessage() {}
+
+func (x *ModelConfig_ClusterAllocationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelConfig_ClusterAllocationPolicy.ProtoReflect.Descriptor instead.
+func (*ModelConfig_ClusterAllocationPolicy) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *ModelConfig_ClusterAllocationPolicy) GetAllowedClusterIds() []string {
+	if x != nil {
+		return x.AllowedClusterIds
+	}
+	return nil
+}
+
+func (x *ModelConfig_ClusterAllocationPolicy) GetEnableOnDemandAllocation() bool {
+	if x != nil {
+		return x.EnableOnDemandAllocation
+	}
+	return false
+}
+
+type ModelConfig_RuntimeConfig_Resources struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gpu int32 `protobuf:"varint,1,opt,name=gpu,proto3" json:"gpu,omitempty"`
+}
+
+func (x *ModelConfig_RuntimeConfig_Resources) Reset() {
+	*x = ModelConfig_RuntimeConfig_Resources{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModelConfig_RuntimeConfig_Resources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelConfig_RuntimeConfig_Resources) ProtoMessage() {}
+
+func (x *ModelConfig_RuntimeConfig_Resources) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelConfig_RuntimeConfig_Resources.ProtoReflect.Descriptor instead.
+func (*ModelConfig_RuntimeConfig_Resources) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{7, 0, 0}
+}
+
+func (x *ModelConfig_RuntimeConfig_Resources) GetGpu() int32 {
+	if x != nil {
+		return x.Gpu
+	}
+	return 0
+}
+
+type ProjectAssignment_NodeSelector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ProjectAssignment_NodeSelector) Reset() {
+	*x = ProjectAssignment_NodeSelector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectAssignment_NodeSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectAssignment_NodeSelector) ProtoMessage() {}
+
+func (x *ProjectAssignment_NodeSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectAssignment_NodeSelector.ProtoReflect.Descriptor instead.
+func (*ProjectAssignment_NodeSelector) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *ProjectAssignment_NodeSelector) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ProjectAssignment_NodeSelector) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type UpdateBaseModelLoadingStatusRequest_Success struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Success) Reset() {
+	*x = UpdateBaseModelLoadingStatusRequest_Success{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Success) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBaseModelLoadingStatusRequest_Success) ProtoMessage() {}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Success) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBaseModelLoadingStatusRequest_Success.ProtoReflect.Descriptor instead.
+func (*UpdateBaseModelLoadingStatusRequest_Success) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{55, 0}
+}
+
+type UpdateBaseModelLoadingStatusRequest_Failure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// retryable is true if the failure is likely to be transient (e.g., a network error). Only the models
+	// that have failed with retryable failures are loaded again automatically.
+	Retryable bool `protobuf:"varint,2,opt,name=retryable,proto3" json:"retryable,omitempty"`
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Failure) Reset() {
+	*x = UpdateBaseModelLoadingStatusRequest_Failure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Failure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBaseModelLoadingStatusRequest_Failure) ProtoMessage() {}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBaseModelLoadingStatusRequest_Failure.ProtoReflect.Descriptor instead.
+func (*UpdateBaseModelLoadingStatusRequest_Failure) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{55, 1}
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Failure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Failure) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+type UpdateBaseModelLoadingStatusRequest_Cancelled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Cancelled) Reset() {
+	*x = UpdateBaseModelLoadingStatusRequest_Cancelled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Cancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBaseModelLoadingStatusRequest_Cancelled) ProtoMessage() {}
+
+func (x *UpdateBaseModelLoadingStatusRequest_Cancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBaseModelLoadingStatusRequest_Cancelled.ProtoReflect.Descriptor instead.
+func (*UpdateBaseModelLoadingStatusRequest_Cancelled) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{55, 2}
+}
+
+type UpdateModelLoadingStatusRequest_Success struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// files is the list of the files of the model. The paths are relative to files_base_path.
+	Files         []*ModelFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	FilesBasePath string       `protobuf:"bytes,2,opt,name=files_base_path,json=filesBasePath,proto3" json:"files_base_path,omitempty"`
+	Size          *ModelSize   `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *UpdateModelLoadingStatusRequest_Success) Reset() {
+	*x = UpdateModelLoadingStatusRequest_Success{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateModelLoadingStatusRequest_Success) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelLoadingStatusRequest_Success) ProtoMessage() {}
+
+func (x *UpdateModelLoadingStatusRequest_Success) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelLoadingStatusRequest_Success.ProtoReflect.Descriptor instead.
+func (*UpdateModelLoadingStatusRequest_Success) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{59, 0}
+}
+
+func (x *UpdateModelLoadingStatusRequest_Success) GetFiles() []*ModelFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *UpdateModelLoadingStatusRequest_Success) GetFilesBasePath() string {
+	if x != nil {
+		return x.FilesBasePath
+	}
+	return ""
+}
+
+func (x *UpdateModelLoadingStatusRequest_Success) GetSize() *ModelSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+type UpdateModelLoadingStatusRequest_Failure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// retryable is true if the failure is likely to be transient (e.g., a network error). Only the models
+	// that have failed with retryable failures are loaded again automatically.
+	Retryable bool `protobuf:"varint,2,opt,name=retryable,proto3" json:"retryable,omitempty"`
+}
+
+func (x *UpdateModelLoadingStatusRequest_Failure) Reset() {
+	*x = UpdateModelLoadingStatusRequest_Failure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateModelLoadingStatusRequest_Failure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelLoadingStatusRequest_Failure) ProtoMessage() {}
+
+func (x *UpdateModelLoadingStatusRequest_Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelLoadingStatusRequest_Failure.ProtoReflect.Descriptor instead.
+func (*UpdateModelLoadingStatusRequest_Failure) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{59, 1}
+}
+
+func (x *UpdateModelLoadingStatusRequest_Failure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateModelLoadingStatusRequest_Failure) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+type UpdateModelLoadingStatusRequest_Cancelled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateModelLoadingStatusRequest_Cancelled) Reset() {
+	*x = UpdateModelLoadingStatusRequest_Cancelled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v1_model_manager_service_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateModelLoadingStatusRequest_Cancelled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelLoadingStatusRequest_Cancelled) ProtoMessage() {}
+
+func (x *UpdateModelLoadingStatusRequest_Cancelled) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_model_manager_service_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelLoadingStatusRequest_Cancelled.ProtoReflect.Descriptor instead.
+func (*UpdateModelLoadingStatusRequest_Cancelled) Descriptor() ([]byte, []int) {
+	return file_api_v1_model_manager_service_proto_rawDescGZIP(), []int{59, 2}
+}
+
+var File_api_v1_model_manager_service_proto protoreflect.FileDescriptor
+
+var file_api_v1_model_manager_service_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x0c, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x66, 0x6f, 0x72,
 	0x6d, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d,
 	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x22, 0xbe, 0x04, 0x0a,
-	0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0e,
-	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x72, 0x75, 0x6e,
-	0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x7b, 0x0a, 0x19, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e,
-	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x17,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0xc8, 0x01, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x74,
-	0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5d, 0x0a, 0x09, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6c,
-	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x72,
-	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x41,
-	0x72, 0x67, 0x73, 0x1a, 0x1d, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x67, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x67,
-	0x70, 0x75, 0x1a, 0x88, 0x01, 0x0a, 0x17, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e,
-	0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x3d,
-	0x0a, 0x1b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6d, 0x61,
-	0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x18, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x44, 0x65, 0x6d,
-	0x61, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x02,
-	0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x28, 0x0a, 0x10, 0x6b, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6b, 0x75, 0x65, 0x75,
-	0x65, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5f, 0x0a, 0x0d, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x3a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6e,
-	0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x36, 0x0a, 0x0c, 0x4e,
-	0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x4f, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x31, 0x0a, 0x14, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
-	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x22, 0xe8, 0x05, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x12, 0x55, 0x0a, 0x0e, 0x6c, 0x6f,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x59, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c,
-	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x16,
-	0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6c, 0x6f,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x14, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
-	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69,
-	0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
-	0x22, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c,
-	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f,
-	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x6d, 0x61, 0x74, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x22, 0xef, 0x01, 0x0a,
+	0x09, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x53, 0x0a, 0x0c, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53,
+	0x69, 0x7a, 0x65, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x73,
+	0x1a, 0x6c, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
 	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
 	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x3d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x23, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x87,
-	0x03, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x59, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x6c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xf2,
+	0x01, 0x0a, 0x0c, 0x47, 0x47, 0x55, 0x46, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x5a, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x2e, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbf, 0x01, 0x0a, 0x18, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9a, 0x02, 0x0a, 0x13, 0x48, 0x75, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f,
+	0x63, 0x61, 0x62, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x76, 0x6f, 0x63, 0x61, 0x62, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x73,
+	0x5f, 0x63, 0x68, 0x61, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x73, 0x43, 0x68, 0x61, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x42, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0xbe, 0x04, 0x0a, 0x0b, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0e, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x7b, 0x0a, 0x19, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x17, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x1a, 0xc8, 0x01, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5d, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x41, 0x72, 0x67, 0x73,
+	0x1a, 0x1d, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x67, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x67, 0x70, 0x75, 0x1a,
+	0x88, 0x01, 0x0a, 0x17, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6d, 0x61, 0x6e, 0x64, 0x5f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x18, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x44, 0x65, 0x6d, 0x61, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x02, 0x0a, 0x11, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x28, 0x0a,
+	0x10, 0x6b, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6b, 0x75, 0x65, 0x75, 0x65, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5f, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x36, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x9d, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x0b,
+	0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0b, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a,
+	0x14, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x22, 0xd4, 0x01, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x74, 0x61, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x74, 0x61,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xf3, 0x09, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x12, 0x55,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x59, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
 	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f,
 	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x10,
 	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x2d, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x65,
-	0x64, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69,
-	0x73, 0x46, 0x69, 0x6e, 0x65, 0x54, 0x75, 0x6e, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
-	0x22, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x46,
-	0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6c,
-	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11,
-	0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x22, 0x75, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
-	0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67,
-	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69,
-	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
-	0x9f, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x35,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c,
+	0x12, 0x34, 0x0a, 0x16, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x14, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x41, 0x0a, 0x07,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x27, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x5b, 0x0a, 0x10, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c,
 	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x22, 0x55, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c,
+	0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f,
+	0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53,
+	0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x67, 0x67, 0x75,
+	0x66, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x47,
+	0x55, 0x46, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x67, 0x67, 0x75, 0x66,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x63, 0x0a, 0x15, 0x68, 0x75, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x13, 0x68, 0x75, 0x67, 0x67, 0x69, 0x6e,
+	0x67, 0x46, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaa, 0x04,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
-	0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x59, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x2d, 0x0a, 0x13, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x65, 0x64,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73,
+	0x46, 0x69, 0x6e, 0x65, 0x54, 0x75, 0x6e, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x22,
+	0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69,
+	0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x69,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x75, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x9f, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f,
+	0x72, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x57, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x37, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x26, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
 	0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57,
-	0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37,
-	0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x61, 0x73, 0x6b, 0x22, 0x26, 0x0a, 0x14, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x19, 0x0a, 0x17, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x0d, 0x53, 0x74,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17,
+	0x0a, 0x15, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x15,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x28, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x86, 0x02, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x42,
+	0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x09, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x26,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x71, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3b, 0x0a, 0x05,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x71, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4c, 0x6f, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22, 0x30, 0x0a, 0x0d, 0x53, 0x74,
 	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
 	0x61, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x3d, 0x0a, 0x1a,
@@ -3582,7 +6458,7 @@ var file_api_v1_model_manager_service_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x22, 0x2b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x74,
 	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0xa1, 0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f,
+	0xb8, 0x08, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f,
 	0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
 	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x41,
@@ -3600,132 +6476,373 @@ var file_api_v1_model_manager_service_proto_rawDesc = []byte{
 	0x72, 0x79, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
 	0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
 	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x09, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x22, 0x48, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x99, 0x01, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0d,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x0c, 0x66,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x6c, 0x6d,
 	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x12, 0x26, 0x0a, 0x0f, 0x67, 0x67, 0x75, 0x66, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x67, 0x75, 0x66, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0x4d, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x48, 0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x21, 0x0a, 0x0b, 0x48, 0x46, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x48, 0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x20, 0x41, 0x63,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x61, 0x73,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
-	0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x49, 0x64, 0x12, 0x59, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
-	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a,
-	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x85, 0x03, 0x0a,
-	0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x61, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x39, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x67,
+	0x67, 0x75, 0x66, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x47, 0x55, 0x46, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x67, 0x67,
+	0x75, 0x66, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x63, 0x0a, 0x15, 0x68, 0x75,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x46, 0x61,
+	0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x13, 0x68, 0x75, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x48, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e, 0x6e,
+	0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6f, 0x6e, 0x6e, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x6c, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6c, 0x78, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x70, 0x74, 0x71, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x67, 0x70, 0x74, 0x71, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x24, 0x0a, 0x0e, 0x61, 0x77, 0x71, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x77, 0x71, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x6c, 0x6c, 0x6d, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x76, 0x6c, 0x6c, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x09, 0x42, 0x61,
+	0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x48, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
-	0x74, 0x49, 0x64, 0x12, 0x63, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x22, 0xc7, 0x04, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x41, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x67, 0x75, 0x66, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x67, 0x67, 0x75, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x39, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x63, 0x0a, 0x15, 0x68, 0x75,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x46, 0x61,
+	0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x13, 0x68, 0x75, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x48, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09,
+	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e, 0x6e,
+	0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6f, 0x6e, 0x6e, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x6c, 0x78, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6c, 0x78, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x70, 0x74, 0x71, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x67, 0x70, 0x74, 0x71, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x24, 0x0a, 0x0e, 0x61, 0x77, 0x71, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x77, 0x71, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x76, 0x6c, 0x6c, 0x6d, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x76, 0x6c, 0x6c, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0x69, 0x0a,
+	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
+	0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x0b, 0x48, 0x46, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x48, 0x46,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x22, 0xf7, 0x01, 0x0a, 0x1f, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x70, 0x0a, 0x1d, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x1b, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x66, 0x72, 0x65, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xf7, 0x02,
+	0x0a, 0x20, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x59, 0x0a, 0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0d,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x0c, 0x66,
+	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x46,
+	0x69, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x22, 0xa7, 0x05, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x63,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x47, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x63, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
 	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
 	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65,
 	0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x63, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c,
-	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52,
+	0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x69, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
 	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75,
-	0x72, 0x65, 0x48, 0x00, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a,
-	0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x1a, 0x09, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x1a,
-	0x21, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x26, 0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61,
-	0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x0a, 0x1b,
-	0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x85, 0x02, 0x0a, 0x1c,
-	0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x69, 0x73, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x59, 0x0a, 0x11, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
-	0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73, 0x74, 0x50,
-	0x61, 0x74, 0x68, 0x22, 0xda, 0x02, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5f, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x09, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x1a, 0x3f, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62,
+	0x6c, 0x65, 0x1a, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x42,
+	0x10, 0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x44, 0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x22, 0xf3, 0x01, 0x0a, 0x1b, 0x41, 0x63, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x70, 0x0a, 0x1d, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x1b, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x66, 0x72, 0x65, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xd4, 0x02,
+	0x0a, 0x1c, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x59, 0x0a,
+	0x11, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
 	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5f, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c,
-	0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x10, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4d, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x22, 0xf8, 0x05, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5f, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
 	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64,
 	0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00,
-	0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x09, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x21, 0x0a, 0x07, 0x46,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x10,
-	0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x22, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c,
-	0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x9a, 0x01, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x46, 0x4f,
-	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
-	0x41, 0x54, 0x5f, 0x47, 0x47, 0x55, 0x46, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x4f, 0x44,
-	0x45, 0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x48, 0x55, 0x47, 0x47, 0x49, 0x4e,
-	0x47, 0x5f, 0x46, 0x41, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x4f, 0x44, 0x45,
-	0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x56, 0x49, 0x44, 0x49, 0x41, 0x5f,
-	0x54, 0x52, 0x49, 0x54, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x4f, 0x44, 0x45,
-	0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4f, 0x4c, 0x4c, 0x41, 0x4d, 0x41, 0x10,
-	0x04, 0x2a, 0xc5, 0x01, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5f, 0x0a, 0x07, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48,
+	0x00, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x65, 0x0a, 0x09, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x1a, 0xa9, 0x01, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x3b, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x42, 0x61, 0x73, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x1a,
+	0x3f, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65,
+	0x1a, 0x0b, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x10, 0x0a,
+	0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x40, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x22, 0x3f, 0x0a, 0x20, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x73, 0x0a, 0x21, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x59, 0x0a, 0x21, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x24, 0x0a, 0x22, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x2e, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xa0, 0x02, 0x0a, 0x0f, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x42, 0x61,
+	0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x67,
+	0x67, 0x75, 0x66, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x67, 0x75, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x55, 0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x6c, 0x6f, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f,
+	0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x20, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2a, 0x8b, 0x02, 0x0a, 0x0b, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x47, 0x47, 0x55, 0x46, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x4f, 0x44, 0x45,
+	0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x48, 0x55, 0x47, 0x47, 0x49, 0x4e, 0x47,
+	0x5f, 0x46, 0x41, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x4f, 0x44, 0x45, 0x4c,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x56, 0x49, 0x44, 0x49, 0x41, 0x5f, 0x54,
+	0x52, 0x49, 0x54, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x4d, 0x4f, 0x44, 0x45, 0x4c,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4f, 0x4c, 0x4c, 0x41, 0x4d, 0x41, 0x10, 0x04,
+	0x12, 0x15, 0x0a, 0x11, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x4f, 0x4e, 0x4e, 0x58, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x4c,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4d, 0x4c, 0x58, 0x10, 0x06, 0x12, 0x15, 0x0a,
+	0x11, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x47, 0x50,
+	0x54, 0x51, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x41, 0x57, 0x51, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x4f,
+	0x44, 0x45, 0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x56, 0x4c, 0x4c, 0x4d, 0x10,
+	0x09, 0x2a, 0xb1, 0x02, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69,
 	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x4f, 0x44, 0x45,
 	0x4c, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
 	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22,
@@ -3737,231 +6854,372 @@ var file_api_v1_model_manager_service_proto_rawDesc = []byte{
 	0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43,
 	0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x4f, 0x44, 0x45,
 	0x4c, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xbe, 0x01, 0x0a, 0x10, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21,
-	0x0a, 0x1d, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54,
-	0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f,
-	0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54,
-	0x4f, 0x52, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
-	0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x48, 0x55, 0x47, 0x47, 0x49,
-	0x4e, 0x47, 0x5f, 0x46, 0x41, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4f,
-	0x4c, 0x4c, 0x41, 0x4d, 0x41, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x4f, 0x55, 0x52, 0x43,
-	0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4e,
-	0x45, 0x5f, 0x54, 0x55, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x2a, 0x73, 0x0a, 0x10, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21,
-	0x0a, 0x1d, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12,
-	0x1e, 0x0a, 0x1a, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x2a,
-	0x5a, 0x0a, 0x0b, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c,
-	0x0a, 0x18, 0x41, 0x44, 0x41, 0x50, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
-	0x41, 0x44, 0x41, 0x50, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x52,
-	0x41, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x44, 0x41, 0x50, 0x54, 0x45, 0x52, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x51, 0x4c, 0x4f, 0x52, 0x41, 0x10, 0x02, 0x2a, 0x6c, 0x0a, 0x10, 0x51,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x21, 0x0a, 0x1d, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x5a, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x47, 0x55, 0x46, 0x10, 0x01, 0x12, 0x19,
-	0x0a, 0x15, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x41, 0x57, 0x51, 0x10, 0x02, 0x32, 0xcd, 0x07, 0x0a, 0x0d, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x76, 0x0a, 0x08, 0x47,
-	0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x4f, 0x44,
+	0x45, 0x4c, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x22,
+	0x0a, 0x1e, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44,
+	0x10, 0x06, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x4c, 0x4f, 0x41, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x47, 0x52, 0x41,
+	0x44, 0x45, 0x44, 0x10, 0x07, 0x2a, 0xf5, 0x01, 0x0a, 0x10, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x4f,
+	0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a,
+	0x1e, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f,
+	0x52, 0x59, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10,
+	0x01, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f,
+	0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x48, 0x55, 0x47, 0x47, 0x49, 0x4e, 0x47, 0x5f, 0x46,
+	0x41, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4f, 0x4c, 0x4c, 0x41, 0x4d,
+	0x41, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x4e, 0x45, 0x5f, 0x54, 0x55,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x52, 0x45, 0x50, 0x4f, 0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x4f, 0x43, 0x49, 0x10,
+	0x05, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f,
+	0x53, 0x49, 0x54, 0x4f, 0x52, 0x59, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x06, 0x2a, 0x73, 0x0a,
+	0x10, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x43, 0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45,
+	0x10, 0x02, 0x2a, 0xf5, 0x02, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x4f, 0x44, 0x45, 0x4c,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17,
+	0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x4f, 0x44,
+	0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x41, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x4f, 0x44, 0x45,
+	0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x07, 0x12, 0x1c, 0x0a,
+	0x18, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x1e, 0x0a, 0x1a, 0x4d,
+	0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x12, 0x1d, 0x0a, 0x19, 0x4d,
+	0x4f, 0x44, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x44, 0x45, 0x47, 0x52, 0x41, 0x44, 0x45, 0x44, 0x10, 0x0a, 0x2a, 0x5a, 0x0a, 0x0b, 0x41, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x44, 0x41,
+	0x50, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x44, 0x41, 0x50, 0x54,
+	0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x4f, 0x52, 0x41, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x41, 0x44, 0x41, 0x50, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x51,
+	0x4c, 0x4f, 0x52, 0x41, 0x10, 0x02, 0x2a, 0x6c, 0x0a, 0x10, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x51, 0x55,
+	0x41, 0x4e, 0x54, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a,
+	0x16, 0x51, 0x55, 0x41, 0x4e, 0x54, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x47, 0x47, 0x55, 0x46, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x51, 0x55, 0x41,
+	0x4e, 0x54, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41,
+	0x57, 0x51, 0x10, 0x02, 0x32, 0xc9, 0x0d, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x76, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x2a, 0x7d, 0x12, 0x7f,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x12, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12,
+	0x8a, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x2a, 0x7d, 0x12, 0x77, 0x0a, 0x0b,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2e, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x15,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
+	0x3a, 0x01, 0x2a, 0x32, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f,
+	0x7b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x96, 0x01, 0x0a, 0x0d, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x30, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x32, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
 	0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12,
-	0x12, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d,
-	0x2a, 0x2a, 0x7d, 0x12, 0x7f, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x12, 0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
-	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f,
-	0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x3d, 0x2a, 0x2a,
-	0x7d, 0x12, 0x77, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x12, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a,
-	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x82, 0x01, 0x0a, 0x0b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d,
-	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6c, 0x6c, 0x6d,
 	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x20, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x32, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x69, 0x64, 0x7d, 0x12,
-	0x96, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x18,
-	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x9e, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x32, 0x2e, 0x6c,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4c, 0x6f, 0x61,
+	0x64, 0x12, 0x9e, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x32, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4c, 0x6f,
+	0x61, 0x64, 0x12, 0x81, 0x01, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x12, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x3a, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x30, 0x01, 0x12, 0xa1, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x35, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x3a, 0x6c, 0x6f, 0x61, 0x64, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x6c,
 	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x1a, 0x2f,
-	0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x64,
-	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x32, 0xf1, 0x0f, 0x0a, 0x13, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x7a, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x32, 0xae, 0x17, 0x0a, 0x13, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7a, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x36, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
 	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x29, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x74, 0x0a,
-	0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12, 0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
 	0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
-	0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x73, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
 	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c,
-	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22,
-	0x00, 0x12, 0x6d, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x12,
-	0x2d, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x76, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x32, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
 	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x22, 0x00, 0x12, 0x7f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
 	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a,
-	0x0c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2f, 0x2e,
-	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
 	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x7a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x35, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x48, 0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x34, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
+	0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x48, 0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x31,
+	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x22, 0x00, 0x12, 0x97, 0x01, 0x0a,
+	0x18, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x3b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x64, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8b, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
 	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x41, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x00, 0x12, 0x6e,
-	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x12, 0x32, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0xa3, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
 	0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x22, 0x00, 0x12, 0x7f,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x33, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x97, 0x01, 0x0a, 0x18, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
 	0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x74, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
-	0x52, 0x65, 0x70, 0x6f, 0x12, 0x34, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72,
 	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x6c, 0x6d,
-	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x65, 0x70, 0x6f, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x46, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x31, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69,
-	0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x12, 0x2e, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x9a, 0x01, 0x0a, 0x19, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3c, 0x2e,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x9d, 0x01, 0x0a,
+	0x1a, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3d, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3e, 0x2e, 0x6c, 0x6c, 0x6d,
 	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x46, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
-	0x65, 0x70, 0x6f, 0x22, 0x00, 0x12, 0x97, 0x01, 0x0a, 0x18, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x12, 0x3b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42,
-	0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x3c, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71,
-	0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x61, 0x73, 0x65,
-	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x8b, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61,
-	0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72,
-	0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x38, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x55, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0xa3, 0x01,
-	0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-	0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3f,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x0e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x31,
 	0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69,
-	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x40, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x97, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f,
-	0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x3b, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8b, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73,
+	0x12, 0x37, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74,
+	0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6c, 0x6c, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x91, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x39, 0x2e, 0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64,
 	0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e,
-	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2b, 0x5a,
-	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x6c, 0x6d, 0x61,
-	0x72, 0x69, 0x6e, 0x65, 0x72, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2d, 0x6d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6c, 0x6c,
+	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x6c, 0x6c, 0x6d,
+	0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c,
+	0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6c, 0x6c, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x72, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2d,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3976,149 +7234,246 @@ func file_api_v1_model_manager_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_model_manager_service_proto_rawDescData
 }
 
-var file_api_v1_model_manager_service_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_api_v1_model_manager_service_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_api_v1_model_manager_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_api_v1_model_manager_service_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_api_v1_model_manager_service_proto_goTypes = []interface{}{
-	(ModelFormat)(0),                                    // 0: llmariner.models.server.v1.ModelFormat
-	(ModelLoadingStatus)(0),                             // 1: llmariner.models.server.v1.ModelLoadingStatus
-	(SourceRepository)(0),                               // 2: llmariner.models.server.v1.SourceRepository
-	(ActivationStatus)(0),                               // 3: llmariner.models.server.v1.ActivationStatus
-	(AdapterType)(0),                                    // 4: llmariner.models.server.v1.AdapterType
-	(QuantizationType)(0),                               // 5: llmariner.models.server.v1.QuantizationType
-	(*ModelFormats)(nil),                                // 6: llmariner.models.server.v1.ModelFormats
-	(*ModelConfig)(nil),                                 // 7: llmariner.models.server.v1.ModelConfig
-	(*ProjectAssignment)(nil),                           // 8: llmariner.models.server.v1.ProjectAssignment
-	(*Project)(nil),                                     // 9: llmariner.models.server.v1.Project
-	(*Model)(nil),                                       // 10: llmariner.models.server.v1.Model
-	(*CreateModelRequest)(nil),                          // 11: llmariner.models.server.v1.CreateModelRequest
-	(*ListModelsRequest)(nil),                           // 12: llmariner.models.server.v1.ListModelsRequest
-	(*ListModelsResponse)(nil),                          // 13: llmariner.models.server.v1.ListModelsResponse
-	(*GetModelRequest)(nil),                             // 14: llmariner.models.server.v1.GetModelRequest
-	(*DeleteModelRequest)(nil),                          // 15: llmariner.models.server.v1.DeleteModelRequest
-	(*DeleteModelResponse)(nil),                         // 16: llmariner.models.server.v1.DeleteModelResponse
-	(*UpdateModelRequest)(nil),                          // 17: llmariner.models.server.v1.UpdateModelRequest
-	(*ActivateModelRequest)(nil),                        // 18: llmariner.models.server.v1.ActivateModelRequest
-	(*ActivateModelResponse)(nil),                       // 19: llmariner.models.server.v1.ActivateModelResponse
-	(*DeactivateModelRequest)(nil),                      // 20: llmariner.models.server.v1.DeactivateModelRequest
-	(*DeactivateModelResponse)(nil),                     // 21: llmariner.models.server.v1.DeactivateModelResponse
-	(*StorageConfig)(nil),                               // 22: llmariner.models.server.v1.StorageConfig
-	(*CreateStorageConfigRequest)(nil),                  // 23: llmariner.models.server.v1.CreateStorageConfigRequest
-	(*GetStorageConfigRequest)(nil),                     // 24: llmariner.models.server.v1.GetStorageConfigRequest
-	(*RegisterModelRequest)(nil),                        // 25: llmariner.models.server.v1.RegisterModelRequest
-	(*RegisterModelResponse)(nil),                       // 26: llmariner.models.server.v1.RegisterModelResponse
-	(*PublishModelRequest)(nil),                         // 27: llmariner.models.server.v1.PublishModelRequest
-	(*PublishModelResponse)(nil),                        // 28: llmariner.models.server.v1.PublishModelResponse
-	(*GetModelPathRequest)(nil),                         // 29: llmariner.models.server.v1.GetModelPathRequest
-	(*GetModelPathResponse)(nil),                        // 30: llmariner.models.server.v1.GetModelPathResponse
-	(*ModelAttributes)(nil),                             // 31: llmariner.models.server.v1.ModelAttributes
-	(*GetModelAttributesRequest)(nil),                   // 32: llmariner.models.server.v1.GetModelAttributesRequest
-	(*CreateBaseModelRequest)(nil),                      // 33: llmariner.models.server.v1.CreateBaseModelRequest
-	(*BaseModel)(nil),                                   // 34: llmariner.models.server.v1.BaseModel
-	(*GetBaseModelPathRequest)(nil),                     // 35: llmariner.models.server.v1.GetBaseModelPathRequest
-	(*GetBaseModelPathResponse)(nil),                    // 36: llmariner.models.server.v1.GetBaseModelPathResponse
-	(*CreateHFModelRepoRequest)(nil),                    // 37: llmariner.models.server.v1.CreateHFModelRepoRequest
-	(*HFModelRepo)(nil),                                 // 38: llmariner.models.server.v1.HFModelRepo
-	(*GetHFModelRepoRequest)(nil),                       // 39: llmariner.models.server.v1.GetHFModelRepoRequest
-	(*AcquireUnloadedBaseModelRequest)(nil),             // 40: llmariner.models.server.v1.AcquireUnloadedBaseModelRequest
-	(*AcquireUnloadedBaseModelResponse)(nil),            // 41: llmariner.models.server.v1.AcquireUnloadedBaseModelResponse
-	(*UpdateBaseModelLoadingStatusRequest)(nil),         // 42: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest
-	(*UpdateBaseModelLoadingStatusResponse)(nil),        // 43: llmariner.models.server.v1.UpdateBaseModelLoadingStatusResponse
-	(*AcquireUnloadedModelRequest)(nil),                 // 44: llmariner.models.server.v1.AcquireUnloadedModelRequest
-	(*AcquireUnloadedModelResponse)(nil),                // 45: llmariner.models.server.v1.AcquireUnloadedModelResponse
-	(*UpdateModelLoadingStatusRequest)(nil),             // 46: llmariner.models.server.v1.UpdateModelLoadingStatusRequest
-	(*UpdateModelLoadingStatusResponse)(nil),            // 47: llmariner.models.server.v1.UpdateModelLoadingStatusResponse
-	(*ModelConfig_RuntimeConfig)(nil),                   // 48: llmariner.models.server.v1.ModelConfig.RuntimeConfig
-	(*ModelConfig_ClusterAllocationPolicy)(nil),         // 49: llmariner.models.server.v1.ModelConfig.ClusterAllocationPolicy
-	(*ModelConfig_RuntimeConfig_Resources)(nil),         // 50: llmariner.models.server.v1.ModelConfig.RuntimeConfig.Resources
-	(*ProjectAssignment_NodeSelector)(nil),              // 51: llmariner.models.server.v1.ProjectAssignment.NodeSelector
-	(*UpdateBaseModelLoadingStatusRequest_Success)(nil), // 52: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.Success
-	(*UpdateBaseModelLoadingStatusRequest_Failure)(nil), // 53: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.Failure
-	(*UpdateModelLoadingStatusRequest_Success)(nil),     // 54: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Success
-	(*UpdateModelLoadingStatusRequest_Failure)(nil),     // 55: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Failure
-	(*fieldmaskpb.FieldMask)(nil),                       // 56: google.protobuf.FieldMask
+	(ModelFormat)(0),                                      // 0: llmariner.models.server.v1.ModelFormat
+	(ModelLoadingStatus)(0),                               // 1: llmariner.models.server.v1.ModelLoadingStatus
+	(SourceRepository)(0),                                 // 2: llmariner.models.server.v1.SourceRepository
+	(ActivationStatus)(0),                                 // 3: llmariner.models.server.v1.ActivationStatus
+	(ModelEventType)(0),                                   // 4: llmariner.models.server.v1.ModelEventType
+	(AdapterType)(0),                                      // 5: llmariner.models.server.v1.AdapterType
+	(QuantizationType)(0),                                 // 6: llmariner.models.server.v1.QuantizationType
+	(*ModelFormats)(nil),                                  // 7: llmariner.models.server.v1.ModelFormats
+	(*ModelSize)(nil),                                     // 8: llmariner.models.server.v1.ModelSize
+	(*GGUFMetadata)(nil),                                  // 9: llmariner.models.server.v1.GGUFMetadata
+	(*FormatArtifact)(nil),                                // 10: llmariner.models.server.v1.FormatArtifact
+	(*FormatArtifactAttributes)(nil),                      // 11: llmariner.models.server.v1.FormatArtifactAttributes
+	(*HuggingFaceMetadata)(nil),                           // 12: llmariner.models.server.v1.HuggingFaceMetadata
+	(*FilePatterns)(nil),                                  // 13: llmariner.models.server.v1.FilePatterns
+	(*ModelConfig)(nil),                                   // 14: llmariner.models.server.v1.ModelConfig
+	(*ProjectAssignment)(nil),                             // 15: llmariner.models.server.v1.ProjectAssignment
+	(*Project)(nil),                                       // 16: llmariner.models.server.v1.Project
+	(*ModelLoadingProgress)(nil),                          // 17: llmariner.models.server.v1.ModelLoadingProgress
+	(*Model)(nil),                                         // 18: llmariner.models.server.v1.Model
+	(*CreateModelRequest)(nil),                            // 19: llmariner.models.server.v1.CreateModelRequest
+	(*ListModelsRequest)(nil),                             // 20: llmariner.models.server.v1.ListModelsRequest
+	(*ListModelsResponse)(nil),                            // 21: llmariner.models.server.v1.ListModelsResponse
+	(*GetModelRequest)(nil),                               // 22: llmariner.models.server.v1.GetModelRequest
+	(*DeleteModelRequest)(nil),                            // 23: llmariner.models.server.v1.DeleteModelRequest
+	(*DeleteModelResponse)(nil),                           // 24: llmariner.models.server.v1.DeleteModelResponse
+	(*UpdateModelRequest)(nil),                            // 25: llmariner.models.server.v1.UpdateModelRequest
+	(*ActivateModelRequest)(nil),                          // 26: llmariner.models.server.v1.ActivateModelRequest
+	(*ActivateModelResponse)(nil),                         // 27: llmariner.models.server.v1.ActivateModelResponse
+	(*DeactivateModelRequest)(nil),                        // 28: llmariner.models.server.v1.DeactivateModelRequest
+	(*DeactivateModelResponse)(nil),                       // 29: llmariner.models.server.v1.DeactivateModelResponse
+	(*RetryModelLoadRequest)(nil),                         // 30: llmariner.models.server.v1.RetryModelLoadRequest
+	(*RetryModelLoadResponse)(nil),                        // 31: llmariner.models.server.v1.RetryModelLoadResponse
+	(*CancelModelLoadRequest)(nil),                        // 32: llmariner.models.server.v1.CancelModelLoadRequest
+	(*CancelModelLoadResponse)(nil),                       // 33: llmariner.models.server.v1.CancelModelLoadResponse
+	(*ListModelLoadQueueRequest)(nil),                     // 34: llmariner.models.server.v1.ListModelLoadQueueRequest
+	(*ModelLoadQueueEntry)(nil),                           // 35: llmariner.models.server.v1.ModelLoadQueueEntry
+	(*ListModelLoadQueueResponse)(nil),                    // 36: llmariner.models.server.v1.ListModelLoadQueueResponse
+	(*ModelFile)(nil),                                     // 37: llmariner.models.server.v1.ModelFile
+	(*GetModelFilesRequest)(nil),                          // 38: llmariner.models.server.v1.GetModelFilesRequest
+	(*GetModelFilesResponse)(nil),                         // 39: llmariner.models.server.v1.GetModelFilesResponse
+	(*ModelEvent)(nil),                                    // 40: llmariner.models.server.v1.ModelEvent
+	(*WatchModelsRequest)(nil),                            // 41: llmariner.models.server.v1.WatchModelsRequest
+	(*StorageConfig)(nil),                                 // 42: llmariner.models.server.v1.StorageConfig
+	(*CreateStorageConfigRequest)(nil),                    // 43: llmariner.models.server.v1.CreateStorageConfigRequest
+	(*GetStorageConfigRequest)(nil),                       // 44: llmariner.models.server.v1.GetStorageConfigRequest
+	(*RegisterModelRequest)(nil),                          // 45: llmariner.models.server.v1.RegisterModelRequest
+	(*RegisterModelResponse)(nil),                         // 46: llmariner.models.server.v1.RegisterModelResponse
+	(*PublishModelRequest)(nil),                           // 47: llmariner.models.server.v1.PublishModelRequest
+	(*PublishModelResponse)(nil),                          // 48: llmariner.models.server.v1.PublishModelResponse
+	(*GetModelPathRequest)(nil),                           // 49: llmariner.models.server.v1.GetModelPathRequest
+	(*GetModelPathResponse)(nil),                          // 50: llmariner.models.server.v1.GetModelPathResponse
+	(*ModelAttributes)(nil),                               // 51: llmariner.models.server.v1.ModelAttributes
+	(*GetModelAttributesRequest)(nil),                     // 52: llmariner.models.server.v1.GetModelAttributesRequest
+	(*CreateBaseModelRequest)(nil),                        // 53: llmariner.models.server.v1.CreateBaseModelRequest
+	(*BaseModel)(nil),                                     // 54: llmariner.models.server.v1.BaseModel
+	(*GetBaseModelPathRequest)(nil),                       // 55: llmariner.models.server.v1.GetBaseModelPathRequest
+	(*GetBaseModelPathResponse)(nil),                      // 56: llmariner.models.server.v1.GetBaseModelPathResponse
+	(*CreateHFModelRepoRequest)(nil),                      // 57: llmariner.models.server.v1.CreateHFModelRepoRequest
+	(*HFModelRepo)(nil),                                   // 58: llmariner.models.server.v1.HFModelRepo
+	(*GetHFModelRepoRequest)(nil),                         // 59: llmariner.models.server.v1.GetHFModelRepoRequest
+	(*AcquireUnloadedBaseModelRequest)(nil),               // 60: llmariner.models.server.v1.AcquireUnloadedBaseModelRequest
+	(*AcquireUnloadedBaseModelResponse)(nil),              // 61: llmariner.models.server.v1.AcquireUnloadedBaseModelResponse
+	(*UpdateBaseModelLoadingStatusRequest)(nil),           // 62: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest
+	(*UpdateBaseModelLoadingStatusResponse)(nil),          // 63: llmariner.models.server.v1.UpdateBaseModelLoadingStatusResponse
+	(*AcquireUnloadedModelRequest)(nil),                   // 64: llmariner.models.server.v1.AcquireUnloadedModelRequest
+	(*AcquireUnloadedModelResponse)(nil),                  // 65: llmariner.models.server.v1.AcquireUnloadedModelResponse
+	(*UpdateModelLoadingStatusRequest)(nil),               // 66: llmariner.models.server.v1.UpdateModelLoadingStatusRequest
+	(*UpdateModelLoadingStatusResponse)(nil),              // 67: llmariner.models.server.v1.UpdateModelLoadingStatusResponse
+	(*AcquireObjectDeletionTaskRequest)(nil),              // 68: llmariner.models.server.v1.AcquireObjectDeletionTaskRequest
+	(*AcquireObjectDeletionTaskResponse)(nil),             // 69: llmariner.models.server.v1.AcquireObjectDeletionTaskResponse
+	(*CompleteObjectDeletionTaskRequest)(nil),             // 70: llmariner.models.server.v1.CompleteObjectDeletionTaskRequest
+	(*CompleteObjectDeletionTaskResponse)(nil),            // 71: llmariner.models.server.v1.CompleteObjectDeletionTaskResponse
+	(*ListModelPathsRequest)(nil),                         // 72: llmariner.models.server.v1.ListModelPathsRequest
+	(*ListModelPathsResponse)(nil),                        // 73: llmariner.models.server.v1.ListModelPathsResponse
+	(*ListLoadedModelPathsRequest)(nil),                   // 74: llmariner.models.server.v1.ListLoadedModelPathsRequest
+	(*LoadedModelPath)(nil),                               // 75: llmariner.models.server.v1.LoadedModelPath
+	(*ListLoadedModelPathsResponse)(nil),                  // 76: llmariner.models.server.v1.ListLoadedModelPathsResponse
+	(*UpdateModelDegradationRequest)(nil),                 // 77: llmariner.models.server.v1.UpdateModelDegradationRequest
+	(*UpdateModelDegradationResponse)(nil),                // 78: llmariner.models.server.v1.UpdateModelDegradationResponse
+	(*ModelSize_FormatSize)(nil),                          // 79: llmariner.models.server.v1.ModelSize.FormatSize
+	nil,                                                   // 80: llmariner.models.server.v1.FormatArtifact.AttributesEntry
+	nil,                                                   // 81: llmariner.models.server.v1.FormatArtifactAttributes.AttributesEntry
+	(*ModelConfig_RuntimeConfig)(nil),                     // 82: llmariner.models.server.v1.ModelConfig.RuntimeConfig
+	(*ModelConfig_ClusterAllocationPolicy)(nil),           // 83: llmariner.models.server.v1.ModelConfig.ClusterAllocationPolicy
+	(*ModelConfig_RuntimeConfig_Resources)(nil),           // 84: llmariner.models.server.v1.ModelConfig.RuntimeConfig.Resources
+	(*ProjectAssignment_NodeSelector)(nil),                // 85: llmariner.models.server.v1.ProjectAssignment.NodeSelector
+	(*UpdateBaseModelLoadingStatusRequest_Success)(nil),   // 86: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.Success
+	(*UpdateBaseModelLoadingStatusRequest_Failure)(nil),   // 87: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.Failure
+	(*UpdateBaseModelLoadingStatusRequest_Cancelled)(nil), // 88: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.Cancelled
+	(*UpdateModelLoadingStatusRequest_Success)(nil),       // 89: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Success
+	(*UpdateModelLoadingStatusRequest_Failure)(nil),       // 90: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Failure
+	(*UpdateModelLoadingStatusRequest_Cancelled)(nil),     // 91: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Cancelled
+	(*fieldmaskpb.FieldMask)(nil),                         // 92: google.protobuf.FieldMask
 }
 var file_api_v1_model_manager_service_proto_depIdxs = []int32{
-	0,  // 0: llmariner.models.server.v1.ModelFormats.formats:type_name -> llmariner.models.server.v1.ModelFormat
-	48, // 1: llmariner.models.server.v1.ModelConfig.runtime_config:type_name -> llmariner.models.server.v1.ModelConfig.RuntimeConfig
-	49, // 2: llmariner.models.server.v1.ModelConfig.cluster_allocation_policy:type_name -> llmariner.models.server.v1.ModelConfig.ClusterAllocationPolicy
-	51, // 3: llmariner.models.server.v1.ProjectAssignment.node_selector:type_name -> llmariner.models.server.v1.ProjectAssignment.NodeSelector
-	8,  // 4: llmariner.models.server.v1.Project.assignments:type_name -> llmariner.models.server.v1.ProjectAssignment
-	1,  // 5: llmariner.models.server.v1.Model.loading_status:type_name -> llmariner.models.server.v1.ModelLoadingStatus
-	2,  // 6: llmariner.models.server.v1.Model.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
-	0,  // 7: llmariner.models.server.v1.Model.formats:type_name -> llmariner.models.server.v1.ModelFormat
-	3,  // 8: llmariner.models.server.v1.Model.activation_status:type_name -> llmariner.models.server.v1.ActivationStatus
-	7,  // 9: llmariner.models.server.v1.Model.config:type_name -> llmariner.models.server.v1.ModelConfig
-	9,  // 10: llmariner.models.server.v1.Model.project:type_name -> llmariner.models.server.v1.Project
-	2,  // 11: llmariner.models.server.v1.CreateModelRequest.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
-	7,  // 12: llmariner.models.server.v1.CreateModelRequest.config:type_name -> llmariner.models.server.v1.ModelConfig
-	10, // 13: llmariner.models.server.v1.ListModelsResponse.data:type_name -> llmariner.models.server.v1.Model
-	10, // 14: llmariner.models.server.v1.UpdateModelRequest.model:type_name -> llmariner.models.server.v1.Model
-	56, // 15: llmariner.models.server.v1.UpdateModelRequest.update_mask:type_name -> google.protobuf.FieldMask
-	4,  // 16: llmariner.models.server.v1.RegisterModelRequest.adapter:type_name -> llmariner.models.server.v1.AdapterType
-	5,  // 17: llmariner.models.server.v1.RegisterModelRequest.quantization:type_name -> llmariner.models.server.v1.QuantizationType
-	4,  // 18: llmariner.models.server.v1.ModelAttributes.adapter:type_name -> llmariner.models.server.v1.AdapterType
-	5,  // 19: llmariner.models.server.v1.ModelAttributes.quantization:type_name -> llmariner.models.server.v1.QuantizationType
-	0,  // 20: llmariner.models.server.v1.CreateBaseModelRequest.formats:type_name -> llmariner.models.server.v1.ModelFormat
-	2,  // 21: llmariner.models.server.v1.CreateBaseModelRequest.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
-	0,  // 22: llmariner.models.server.v1.GetBaseModelPathResponse.formats:type_name -> llmariner.models.server.v1.ModelFormat
-	2,  // 23: llmariner.models.server.v1.AcquireUnloadedBaseModelResponse.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
-	52, // 24: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.success:type_name -> llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.Success
-	53, // 25: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.failure:type_name -> llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.Failure
-	2,  // 26: llmariner.models.server.v1.AcquireUnloadedModelResponse.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
-	54, // 27: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.success:type_name -> llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Success
-	55, // 28: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.failure:type_name -> llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Failure
-	50, // 29: llmariner.models.server.v1.ModelConfig.RuntimeConfig.resources:type_name -> llmariner.models.server.v1.ModelConfig.RuntimeConfig.Resources
-	14, // 30: llmariner.models.server.v1.ModelsService.GetModel:input_type -> llmariner.models.server.v1.GetModelRequest
-	12, // 31: llmariner.models.server.v1.ModelsService.ListModels:input_type -> llmariner.models.server.v1.ListModelsRequest
-	15, // 32: llmariner.models.server.v1.ModelsService.DeleteModel:input_type -> llmariner.models.server.v1.DeleteModelRequest
-	11, // 33: llmariner.models.server.v1.ModelsService.CreateModel:input_type -> llmariner.models.server.v1.CreateModelRequest
-	17, // 34: llmariner.models.server.v1.ModelsService.UpdateModel:input_type -> llmariner.models.server.v1.UpdateModelRequest
-	18, // 35: llmariner.models.server.v1.ModelsService.ActivateModel:input_type -> llmariner.models.server.v1.ActivateModelRequest
-	20, // 36: llmariner.models.server.v1.ModelsService.DeactivateModel:input_type -> llmariner.models.server.v1.DeactivateModelRequest
-	23, // 37: llmariner.models.server.v1.ModelsWorkerService.CreateStorageConfig:input_type -> llmariner.models.server.v1.CreateStorageConfigRequest
-	24, // 38: llmariner.models.server.v1.ModelsWorkerService.GetStorageConfig:input_type -> llmariner.models.server.v1.GetStorageConfigRequest
-	14, // 39: llmariner.models.server.v1.ModelsWorkerService.GetModel:input_type -> llmariner.models.server.v1.GetModelRequest
-	12, // 40: llmariner.models.server.v1.ModelsWorkerService.ListModels:input_type -> llmariner.models.server.v1.ListModelsRequest
-	25, // 41: llmariner.models.server.v1.ModelsWorkerService.RegisterModel:input_type -> llmariner.models.server.v1.RegisterModelRequest
-	27, // 42: llmariner.models.server.v1.ModelsWorkerService.PublishModel:input_type -> llmariner.models.server.v1.PublishModelRequest
-	29, // 43: llmariner.models.server.v1.ModelsWorkerService.GetModelPath:input_type -> llmariner.models.server.v1.GetModelPathRequest
-	32, // 44: llmariner.models.server.v1.ModelsWorkerService.GetModelAttributes:input_type -> llmariner.models.server.v1.GetModelAttributesRequest
-	33, // 45: llmariner.models.server.v1.ModelsWorkerService.CreateBaseModel:input_type -> llmariner.models.server.v1.CreateBaseModelRequest
-	35, // 46: llmariner.models.server.v1.ModelsWorkerService.GetBaseModelPath:input_type -> llmariner.models.server.v1.GetBaseModelPathRequest
-	37, // 47: llmariner.models.server.v1.ModelsWorkerService.CreateHFModelRepo:input_type -> llmariner.models.server.v1.CreateHFModelRepoRequest
-	39, // 48: llmariner.models.server.v1.ModelsWorkerService.GetHFModelRepo:input_type -> llmariner.models.server.v1.GetHFModelRepoRequest
-	40, // 49: llmariner.models.server.v1.ModelsWorkerService.AcquireUnloadedBaseModel:input_type -> llmariner.models.server.v1.AcquireUnloadedBaseModelRequest
-	44, // 50: llmariner.models.server.v1.ModelsWorkerService.AcquireUnloadedModel:input_type -> llmariner.models.server.v1.AcquireUnloadedModelRequest
-	42, // 51: llmariner.models.server.v1.ModelsWorkerService.UpdateBaseModelLoadingStatus:input_type -> llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest
-	46, // 52: llmariner.models.server.v1.ModelsWorkerService.UpdateModelLoadingStatus:input_type -> llmariner.models.server.v1.UpdateModelLoadingStatusRequest
-	10, // 53: llmariner.models.server.v1.ModelsService.GetModel:output_type -> llmariner.models.server.v1.Model
-	13, // 54: llmariner.models.server.v1.ModelsService.ListModels:output_type -> llmariner.models.server.v1.ListModelsResponse
-	16, // 55: llmariner.models.server.v1.ModelsService.DeleteModel:output_type -> llmariner.models.server.v1.DeleteModelResponse
-	10, // 56: llmariner.models.server.v1.ModelsService.CreateModel:output_type -> llmariner.models.server.v1.Model
-	10, // 57: llmariner.models.server.v1.ModelsService.UpdateModel:output_type -> llmariner.models.server.v1.Model
-	19, // 58: llmariner.models.server.v1.ModelsService.ActivateModel:output_type -> llmariner.models.server.v1.ActivateModelResponse
-	21, // 59: llmariner.models.server.v1.ModelsService.DeactivateModel:output_type -> llmariner.models.server.v1.DeactivateModelResponse
-	22, // 60: llmariner.models.server.v1.ModelsWorkerService.CreateStorageConfig:output_type -> llmariner.models.server.v1.StorageConfig
-	22, // 61: llmariner.models.server.v1.ModelsWorkerService.GetStorageConfig:output_type -> llmariner.models.server.v1.StorageConfig
-	10, // 62: llmariner.models.server.v1.ModelsWorkerService.GetModel:output_type -> llmariner.models.server.v1.Model
-	13, // 63: llmariner.models.server.v1.ModelsWorkerService.ListModels:output_type -> llmariner.models.server.v1.ListModelsResponse
-	26, // 64: llmariner.models.server.v1.ModelsWorkerService.RegisterModel:output_type -> llmariner.models.server.v1.RegisterModelResponse
-	28, // 65: llmariner.models.server.v1.ModelsWorkerService.PublishModel:output_type -> llmariner.models.server.v1.PublishModelResponse
-	30, // 66: llmariner.models.server.v1.ModelsWorkerService.GetModelPath:output_type -> llmariner.models.server.v1.GetModelPathResponse
-	31, // 67: llmariner.models.server.v1.ModelsWorkerService.GetModelAttributes:output_type -> llmariner.models.server.v1.ModelAttributes
-	34, // 68: llmariner.models.server.v1.ModelsWorkerService.CreateBaseModel:output_type -> llmariner.models.server.v1.BaseModel
-	36, // 69: llmariner.models.server.v1.ModelsWorkerService.GetBaseModelPath:output_type -> llmariner.models.server.v1.GetBaseModelPathResponse
-	38, // 70: llmariner.models.server.v1.ModelsWorkerService.CreateHFModelRepo:output_type -> llmariner.models.server.v1.HFModelRepo
-	38, // 71: llmariner.models.server.v1.ModelsWorkerService.GetHFModelRepo:output_type -> llmariner.models.server.v1.HFModelRepo
-	41, // 72: llmariner.models.server.v1.ModelsWorkerService.AcquireUnloadedBaseModel:output_type -> llmariner.models.server.v1.AcquireUnloadedBaseModelResponse
-	45, // 73: llmariner.models.server.v1.ModelsWorkerService.AcquireUnloadedModel:output_type -> llmariner.models.server.v1.AcquireUnloadedModelResponse
-	43, // 74: llmariner.models.server.v1.ModelsWorkerService.UpdateBaseModelLoadingStatus:output_type -> llmariner.models.server.v1.UpdateBaseModelLoadingStatusResponse
-	47, // 75: llmariner.models.server.v1.ModelsWorkerService.UpdateModelLoadingStatus:output_type -> llmariner.models.server.v1.UpdateModelLoadingStatusResponse
-	53, // [53:76] is the sub-list for method output_type
-	30, // [30:53] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	0,   // 0: llmariner.models.server.v1.ModelFormats.formats:type_name -> llmariner.models.server.v1.ModelFormat
+	79,  // 1: llmariner.models.server.v1.ModelSize.format_sizes:type_name -> llmariner.models.server.v1.ModelSize.FormatSize
+	0,   // 2: llmariner.models.server.v1.FormatArtifact.format:type_name -> llmariner.models.server.v1.ModelFormat
+	80,  // 3: llmariner.models.server.v1.FormatArtifact.attributes:type_name -> llmariner.models.server.v1.FormatArtifact.AttributesEntry
+	81,  // 4: llmariner.models.server.v1.FormatArtifactAttributes.attributes:type_name -> llmariner.models.server.v1.FormatArtifactAttributes.AttributesEntry
+	82,  // 5: llmariner.models.server.v1.ModelConfig.runtime_config:type_name -> llmariner.models.server.v1.ModelConfig.RuntimeConfig
+	83,  // 6: llmariner.models.server.v1.ModelConfig.cluster_allocation_policy:type_name -> llmariner.models.server.v1.ModelConfig.ClusterAllocationPolicy
+	85,  // 7: llmariner.models.server.v1.ProjectAssignment.node_selector:type_name -> llmariner.models.server.v1.ProjectAssignment.NodeSelector
+	15,  // 8: llmariner.models.server.v1.Project.assignments:type_name -> llmariner.models.server.v1.ProjectAssignment
+	1,   // 9: llmariner.models.server.v1.Model.loading_status:type_name -> llmariner.models.server.v1.ModelLoadingStatus
+	2,   // 10: llmariner.models.server.v1.Model.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
+	0,   // 11: llmariner.models.server.v1.Model.formats:type_name -> llmariner.models.server.v1.ModelFormat
+	3,   // 12: llmariner.models.server.v1.Model.activation_status:type_name -> llmariner.models.server.v1.ActivationStatus
+	14,  // 13: llmariner.models.server.v1.Model.config:type_name -> llmariner.models.server.v1.ModelConfig
+	16,  // 14: llmariner.models.server.v1.Model.project:type_name -> llmariner.models.server.v1.Project
+	17,  // 15: llmariner.models.server.v1.Model.loading_progress:type_name -> llmariner.models.server.v1.ModelLoadingProgress
+	13,  // 16: llmariner.models.server.v1.Model.file_patterns:type_name -> llmariner.models.server.v1.FilePatterns
+	8,   // 17: llmariner.models.server.v1.Model.size:type_name -> llmariner.models.server.v1.ModelSize
+	9,   // 18: llmariner.models.server.v1.Model.gguf_metadata:type_name -> llmariner.models.server.v1.GGUFMetadata
+	12,  // 19: llmariner.models.server.v1.Model.hugging_face_metadata:type_name -> llmariner.models.server.v1.HuggingFaceMetadata
+	2,   // 20: llmariner.models.server.v1.CreateModelRequest.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
+	14,  // 21: llmariner.models.server.v1.CreateModelRequest.config:type_name -> llmariner.models.server.v1.ModelConfig
+	13,  // 22: llmariner.models.server.v1.CreateModelRequest.file_patterns:type_name -> llmariner.models.server.v1.FilePatterns
+	18,  // 23: llmariner.models.server.v1.ListModelsResponse.data:type_name -> llmariner.models.server.v1.Model
+	18,  // 24: llmariner.models.server.v1.UpdateModelRequest.model:type_name -> llmariner.models.server.v1.Model
+	92,  // 25: llmariner.models.server.v1.UpdateModelRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,   // 26: llmariner.models.server.v1.ModelLoadQueueEntry.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
+	35,  // 27: llmariner.models.server.v1.ListModelLoadQueueResponse.entries:type_name -> llmariner.models.server.v1.ModelLoadQueueEntry
+	37,  // 28: llmariner.models.server.v1.GetModelFilesResponse.files:type_name -> llmariner.models.server.v1.ModelFile
+	4,   // 29: llmariner.models.server.v1.ModelEvent.type:type_name -> llmariner.models.server.v1.ModelEventType
+	18,  // 30: llmariner.models.server.v1.ModelEvent.model:type_name -> llmariner.models.server.v1.Model
+	5,   // 31: llmariner.models.server.v1.RegisterModelRequest.adapter:type_name -> llmariner.models.server.v1.AdapterType
+	6,   // 32: llmariner.models.server.v1.RegisterModelRequest.quantization:type_name -> llmariner.models.server.v1.QuantizationType
+	5,   // 33: llmariner.models.server.v1.ModelAttributes.adapter:type_name -> llmariner.models.server.v1.AdapterType
+	6,   // 34: llmariner.models.server.v1.ModelAttributes.quantization:type_name -> llmariner.models.server.v1.QuantizationType
+	0,   // 35: llmariner.models.server.v1.CreateBaseModelRequest.formats:type_name -> llmariner.models.server.v1.ModelFormat
+	2,   // 36: llmariner.models.server.v1.CreateBaseModelRequest.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
+	13,  // 37: llmariner.models.server.v1.CreateBaseModelRequest.file_patterns:type_name -> llmariner.models.server.v1.FilePatterns
+	37,  // 38: llmariner.models.server.v1.CreateBaseModelRequest.files:type_name -> llmariner.models.server.v1.ModelFile
+	8,   // 39: llmariner.models.server.v1.CreateBaseModelRequest.size:type_name -> llmariner.models.server.v1.ModelSize
+	9,   // 40: llmariner.models.server.v1.CreateBaseModelRequest.gguf_metadata:type_name -> llmariner.models.server.v1.GGUFMetadata
+	12,  // 41: llmariner.models.server.v1.CreateBaseModelRequest.hugging_face_metadata:type_name -> llmariner.models.server.v1.HuggingFaceMetadata
+	10,  // 42: llmariner.models.server.v1.CreateBaseModelRequest.artifacts:type_name -> llmariner.models.server.v1.FormatArtifact
+	0,   // 43: llmariner.models.server.v1.GetBaseModelPathResponse.formats:type_name -> llmariner.models.server.v1.ModelFormat
+	8,   // 44: llmariner.models.server.v1.GetBaseModelPathResponse.size:type_name -> llmariner.models.server.v1.ModelSize
+	12,  // 45: llmariner.models.server.v1.GetBaseModelPathResponse.hugging_face_metadata:type_name -> llmariner.models.server.v1.HuggingFaceMetadata
+	10,  // 46: llmariner.models.server.v1.GetBaseModelPathResponse.artifacts:type_name -> llmariner.models.server.v1.FormatArtifact
+	2,   // 47: llmariner.models.server.v1.AcquireUnloadedBaseModelRequest.supported_source_repositories:type_name -> llmariner.models.server.v1.SourceRepository
+	2,   // 48: llmariner.models.server.v1.AcquireUnloadedBaseModelResponse.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
+	13,  // 49: llmariner.models.server.v1.AcquireUnloadedBaseModelResponse.file_patterns:type_name -> llmariner.models.server.v1.FilePatterns
+	86,  // 50: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.success:type_name -> llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.Success
+	87,  // 51: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.failure:type_name -> llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.Failure
+	88,  // 52: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.cancelled:type_name -> llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.Cancelled
+	17,  // 53: llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest.progress:type_name -> llmariner.models.server.v1.ModelLoadingProgress
+	2,   // 54: llmariner.models.server.v1.AcquireUnloadedModelRequest.supported_source_repositories:type_name -> llmariner.models.server.v1.SourceRepository
+	2,   // 55: llmariner.models.server.v1.AcquireUnloadedModelResponse.source_repository:type_name -> llmariner.models.server.v1.SourceRepository
+	13,  // 56: llmariner.models.server.v1.AcquireUnloadedModelResponse.file_patterns:type_name -> llmariner.models.server.v1.FilePatterns
+	89,  // 57: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.success:type_name -> llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Success
+	90,  // 58: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.failure:type_name -> llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Failure
+	91,  // 59: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.cancelled:type_name -> llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Cancelled
+	17,  // 60: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.progress:type_name -> llmariner.models.server.v1.ModelLoadingProgress
+	1,   // 61: llmariner.models.server.v1.LoadedModelPath.loading_status:type_name -> llmariner.models.server.v1.ModelLoadingStatus
+	75,  // 62: llmariner.models.server.v1.ListLoadedModelPathsResponse.models:type_name -> llmariner.models.server.v1.LoadedModelPath
+	0,   // 63: llmariner.models.server.v1.ModelSize.FormatSize.format:type_name -> llmariner.models.server.v1.ModelFormat
+	84,  // 64: llmariner.models.server.v1.ModelConfig.RuntimeConfig.resources:type_name -> llmariner.models.server.v1.ModelConfig.RuntimeConfig.Resources
+	37,  // 65: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Success.files:type_name -> llmariner.models.server.v1.ModelFile
+	8,   // 66: llmariner.models.server.v1.UpdateModelLoadingStatusRequest.Success.size:type_name -> llmariner.models.server.v1.ModelSize
+	22,  // 67: llmariner.models.server.v1.ModelsService.GetModel:input_type -> llmariner.models.server.v1.GetModelRequest
+	20,  // 68: llmariner.models.server.v1.ModelsService.ListModels:input_type -> llmariner.models.server.v1.ListModelsRequest
+	23,  // 69: llmariner.models.server.v1.ModelsService.DeleteModel:input_type -> llmariner.models.server.v1.DeleteModelRequest
+	19,  // 70: llmariner.models.server.v1.ModelsService.CreateModel:input_type -> llmariner.models.server.v1.CreateModelRequest
+	25,  // 71: llmariner.models.server.v1.ModelsService.UpdateModel:input_type -> llmariner.models.server.v1.UpdateModelRequest
+	26,  // 72: llmariner.models.server.v1.ModelsService.ActivateModel:input_type -> llmariner.models.server.v1.ActivateModelRequest
+	28,  // 73: llmariner.models.server.v1.ModelsService.DeactivateModel:input_type -> llmariner.models.server.v1.DeactivateModelRequest
+	30,  // 74: llmariner.models.server.v1.ModelsService.RetryModelLoad:input_type -> llmariner.models.server.v1.RetryModelLoadRequest
+	32,  // 75: llmariner.models.server.v1.ModelsService.CancelModelLoad:input_type -> llmariner.models.server.v1.CancelModelLoadRequest
+	41,  // 76: llmariner.models.server.v1.ModelsService.WatchModels:input_type -> llmariner.models.server.v1.WatchModelsRequest
+	34,  // 77: llmariner.models.server.v1.ModelsService.ListModelLoadQueue:input_type -> llmariner.models.server.v1.ListModelLoadQueueRequest
+	38,  // 78: llmariner.models.server.v1.ModelsService.GetModelFiles:input_type -> llmariner.models.server.v1.GetModelFilesRequest
+	43,  // 79: llmariner.models.server.v1.ModelsWorkerService.CreateStorageConfig:input_type -> llmariner.models.server.v1.CreateStorageConfigRequest
+	44,  // 80: llmariner.models.server.v1.ModelsWorkerService.GetStorageConfig:input_type -> llmariner.models.server.v1.GetStorageConfigRequest
+	22,  // 81: llmariner.models.server.v1.ModelsWorkerService.GetModel:input_type -> llmariner.models.server.v1.GetModelRequest
+	20,  // 82: llmariner.models.server.v1.ModelsWorkerService.ListModels:input_type -> llmariner.models.server.v1.ListModelsRequest
+	45,  // 83: llmariner.models.server.v1.ModelsWorkerService.RegisterModel:input_type -> llmariner.models.server.v1.RegisterModelRequest
+	47,  // 84: llmariner.models.server.v1.ModelsWorkerService.PublishModel:input_type -> llmariner.models.server.v1.PublishModelRequest
+	49,  // 85: llmariner.models.server.v1.ModelsWorkerService.GetModelPath:input_type -> llmariner.models.server.v1.GetModelPathRequest
+	52,  // 86: llmariner.models.server.v1.ModelsWorkerService.GetModelAttributes:input_type -> llmariner.models.server.v1.GetModelAttributesRequest
+	53,  // 87: llmariner.models.server.v1.ModelsWorkerService.CreateBaseModel:input_type -> llmariner.models.server.v1.CreateBaseModelRequest
+	55,  // 88: llmariner.models.server.v1.ModelsWorkerService.GetBaseModelPath:input_type -> llmariner.models.server.v1.GetBaseModelPathRequest
+	57,  // 89: llmariner.models.server.v1.ModelsWorkerService.CreateHFModelRepo:input_type -> llmariner.models.server.v1.CreateHFModelRepoRequest
+	59,  // 90: llmariner.models.server.v1.ModelsWorkerService.GetHFModelRepo:input_type -> llmariner.models.server.v1.GetHFModelRepoRequest
+	60,  // 91: llmariner.models.server.v1.ModelsWorkerService.AcquireUnloadedBaseModel:input_type -> llmariner.models.server.v1.AcquireUnloadedBaseModelRequest
+	64,  // 92: llmariner.models.server.v1.ModelsWorkerService.AcquireUnloadedModel:input_type -> llmariner.models.server.v1.AcquireUnloadedModelRequest
+	62,  // 93: llmariner.models.server.v1.ModelsWorkerService.UpdateBaseModelLoadingStatus:input_type -> llmariner.models.server.v1.UpdateBaseModelLoadingStatusRequest
+	66,  // 94: llmariner.models.server.v1.ModelsWorkerService.UpdateModelLoadingStatus:input_type -> llmariner.models.server.v1.UpdateModelLoadingStatusRequest
+	41,  // 95: llmariner.models.server.v1.ModelsWorkerService.WatchModels:input_type -> llmariner.models.server.v1.WatchModelsRequest
+	68,  // 96: llmariner.models.server.v1.ModelsWorkerService.AcquireObjectDeletionTask:input_type -> llmariner.models.server.v1.AcquireObjectDeletionTaskRequest
+	70,  // 97: llmariner.models.server.v1.ModelsWorkerService.CompleteObjectDeletionTask:input_type -> llmariner.models.server.v1.CompleteObjectDeletionTaskRequest
+	72,  // 98: llmariner.models.server.v1.ModelsWorkerService.ListModelPaths:input_type -> llmariner.models.server.v1.ListModelPathsRequest
+	74,  // 99: llmariner.models.server.v1.ModelsWorkerService.ListLoadedModelPaths:input_type -> llmariner.models.server.v1.ListLoadedModelPathsRequest
+	77,  // 100: llmariner.models.server.v1.ModelsWorkerService.UpdateModelDegradation:input_type -> llmariner.models.server.v1.UpdateModelDegradationRequest
+	38,  // 101: llmariner.models.server.v1.ModelsWorkerService.GetModelFiles:input_type -> llmariner.models.server.v1.GetModelFilesRequest
+	18,  // 102: llmariner.models.server.v1.ModelsService.GetModel:output_type -> llmariner.models.server.v1.Model
+	21,  // 103: llmariner.models.server.v1.ModelsService.ListModels:output_type -> llmariner.models.server.v1.ListModelsResponse
+	24,  // 104: llmariner.models.server.v1.ModelsService.DeleteModel:output_type -> llmariner.models.server.v1.DeleteModelResponse
+	18,  // 105: llmariner.models.server.v1.ModelsService.CreateModel:output_type -> llmariner.models.server.v1.Model
+	18,  // 106: llmariner.models.server.v1.ModelsService.UpdateModel:output_type -> llmariner.models.server.v1.Model
+	27,  // 107: llmariner.models.server.v1.ModelsService.ActivateModel:output_type -> llmariner.models.server.v1.ActivateModelResponse
+	29,  // 108: llmariner.models.server.v1.ModelsService.DeactivateModel:output_type -> llmariner.models.server.v1.DeactivateModelResponse
+	31,  // 109: llmariner.models.server.v1.ModelsService.RetryModelLoad:output_type -> llmariner.models.server.v1.RetryModelLoadResponse
+	33,  // 110: llmariner.models.server.v1.ModelsService.CancelModelLoad:output_type -> llmariner.models.server.v1.CancelModelLoadResponse
+	40,  // 111: llmariner.models.server.v1.ModelsService.WatchModels:output_type -> llmariner.models.server.v1.ModelEvent
+	36,  // 112: llmariner.models.server.v1.ModelsService.ListModelLoadQueue:output_type -> llmariner.models.server.v1.ListModelLoadQueueResponse
+	39,  // 113: llmariner.models.server.v1.ModelsService.GetModelFiles:output_type -> llmariner.models.server.v1.GetModelFilesResponse
+	42,  // 114: llmariner.models.server.v1.ModelsWorkerService.CreateStorageConfig:output_type -> llmariner.models.server.v1.StorageConfig
+	42,  // 115: llmariner.models.server.v1.ModelsWorkerService.GetStorageConfig:output_type -> llmariner.models.server.v1.StorageConfig
+	18,  // 116: llmariner.models.server.v1.ModelsWorkerService.GetModel:output_type -> llmariner.models.server.v1.Model
+	21,  // 117: llmariner.models.server.v1.ModelsWorkerService.ListModels:output_type -> llmariner.models.server.v1.ListModelsResponse
+	46,  // 118: llmariner.models.server.v1.ModelsWorkerService.RegisterModel:output_type -> llmariner.models.server.v1.RegisterModelResponse
+	48,  // 119: llmariner.models.server.v1.ModelsWorkerService.PublishModel:output_type -> llmariner.models.server.v1.PublishModelResponse
+	50,  // 120: llmariner.models.server.v1.ModelsWorkerService.GetModelPath:output_type -> llmariner.models.server.v1.GetModelPathResponse
+	51,  // 121: llmariner.models.server.v1.ModelsWorkerService.GetModelAttributes:output_type -> llmariner.models.server.v1.ModelAttributes
+	54,  // 122: llmariner.models.server.v1.ModelsWorkerService.CreateBaseModel:output_type -> llmariner.models.server.v1.BaseModel
+	56,  // 123: llmariner.models.server.v1.ModelsWorkerService.GetBaseModelPath:output_type -> llmariner.models.server.v1.GetBaseModelPathResponse
+	58,  // 124: llmariner.models.server.v1.ModelsWorkerService.CreateHFModelRepo:output_type -> llmariner.models.server.v1.HFModelRepo
+	58,  // 125: llmariner.models.server.v1.ModelsWorkerService.GetHFModelRepo:output_type -> llmariner.models.server.v1.HFModelRepo
+	61,  // 126: llmariner.models.server.v1.ModelsWorkerService.AcquireUnloadedBaseModel:output_type -> llmariner.models.server.v1.AcquireUnloadedBaseModelResponse
+	65,  // 127: llmariner.models.server.v1.ModelsWorkerService.AcquireUnloadedModel:output_type -> llmariner.models.server.v1.AcquireUnloadedModelResponse
+	63,  // 128: llmariner.models.server.v1.ModelsWorkerService.UpdateBaseModelLoadingStatus:output_type -> llmariner.models.server.v1.UpdateBaseModelLoadingStatusResponse
+	67,  // 129: llmariner.models.server.v1.ModelsWorkerService.UpdateModelLoadingStatus:output_type -> llmariner.models.server.v1.UpdateModelLoadingStatusResponse
+	40,  // 130: llmariner.models.server.v1.ModelsWorkerService.WatchModels:output_type -> llmariner.models.server.v1.ModelEvent
+	69,  // 131: llmariner.models.server.v1.ModelsWorkerService.AcquireObjectDeletionTask:output_type -> llmariner.models.server.v1.AcquireObjectDeletionTaskResponse
+	71,  // 132: llmariner.models.server.v1.ModelsWorkerService.CompleteObjectDeletionTask:output_type -> llmariner.models.server.v1.CompleteObjectDeletionTaskResponse
+	73,  // 133: llmariner.models.server.v1.ModelsWorkerService.ListModelPaths:output_type -> llmariner.models.server.v1.ListModelPathsResponse
+	76,  // 134: llmariner.models.server.v1.ModelsWorkerService.ListLoadedModelPaths:output_type -> llmariner.models.server.v1.ListLoadedModelPathsResponse
+	78,  // 135: llmariner.models.server.v1.ModelsWorkerService.UpdateModelDegradation:output_type -> llmariner.models.server.v1.UpdateModelDegradationResponse
+	39,  // 136: llmariner.models.server.v1.ModelsWorkerService.GetModelFiles:output_type -> llmariner.models.server.v1.GetModelFilesResponse
+	102, // [102:137] is the sub-list for method output_type
+	67,  // [67:102] is the sub-list for method input_type
+	67,  // [67:67] is the sub-list for extension type_name
+	67,  // [67:67] is the sub-list for extension extendee
+	0,   // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_model_manager_service_proto_init() }
@@ -4139,8 +7494,224 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModelConfig); i {
+		file_api_v1_model_manager_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GGUFMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatArtifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatArtifactAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HuggingFaceMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilePatterns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectAssignment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelLoadingProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Model); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateModelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteModelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteModelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateModelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateModelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4151,8 +7722,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectAssignment); i {
+		file_api_v1_model_manager_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateModelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4163,8 +7734,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Project); i {
+		file_api_v1_model_manager_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeactivateModelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4175,8 +7746,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Model); i {
+		file_api_v1_model_manager_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeactivateModelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4187,8 +7758,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateModelRequest); i {
+		file_api_v1_model_manager_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryModelLoadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4199,8 +7770,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListModelsRequest); i {
+		file_api_v1_model_manager_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryModelLoadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4211,8 +7782,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListModelsResponse); i {
+		file_api_v1_model_manager_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelModelLoadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4223,8 +7794,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetModelRequest); i {
+		file_api_v1_model_manager_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelModelLoadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4235,8 +7806,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteModelRequest); i {
+		file_api_v1_model_manager_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModelLoadQueueRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4247,8 +7818,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteModelResponse); i {
+		file_api_v1_model_manager_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelLoadQueueEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4259,8 +7830,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateModelRequest); i {
+		file_api_v1_model_manager_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModelLoadQueueResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4271,8 +7842,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateModelRequest); i {
+		file_api_v1_model_manager_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4283,8 +7854,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateModelResponse); i {
+		file_api_v1_model_manager_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModelFilesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4295,8 +7866,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeactivateModelRequest); i {
+		file_api_v1_model_manager_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetModelFilesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4307,8 +7878,8 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeactivateModelResponse); i {
+		file_api_v1_model_manager_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4319,7 +7890,19 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchModelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StorageConfig); i {
 			case 0:
 				return &v.state
@@ -4331,7 +7914,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateStorageConfigRequest); i {
 			case 0:
 				return &v.state
@@ -4343,7 +7926,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStorageConfigRequest); i {
 			case 0:
 				return &v.state
@@ -4355,7 +7938,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterModelRequest); i {
 			case 0:
 				return &v.state
@@ -4367,7 +7950,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterModelResponse); i {
 			case 0:
 				return &v.state
@@ -4379,7 +7962,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PublishModelRequest); i {
 			case 0:
 				return &v.state
@@ -4391,7 +7974,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PublishModelResponse); i {
 			case 0:
 				return &v.state
@@ -4403,7 +7986,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetModelPathRequest); i {
 			case 0:
 				return &v.state
@@ -4415,7 +7998,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetModelPathResponse); i {
 			case 0:
 				return &v.state
@@ -4427,7 +8010,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ModelAttributes); i {
 			case 0:
 				return &v.state
@@ -4439,7 +8022,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetModelAttributesRequest); i {
 			case 0:
 				return &v.state
@@ -4451,7 +8034,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateBaseModelRequest); i {
 			case 0:
 				return &v.state
@@ -4463,7 +8046,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BaseModel); i {
 			case 0:
 				return &v.state
@@ -4475,7 +8058,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBaseModelPathRequest); i {
 			case 0:
 				return &v.state
@@ -4487,7 +8070,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBaseModelPathResponse); i {
 			case 0:
 				return &v.state
@@ -4499,7 +8082,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateHFModelRepoRequest); i {
 			case 0:
 				return &v.state
@@ -4511,7 +8094,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HFModelRepo); i {
 			case 0:
 				return &v.state
@@ -4523,7 +8106,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetHFModelRepoRequest); i {
 			case 0:
 				return &v.state
@@ -4535,7 +8118,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AcquireUnloadedBaseModelRequest); i {
 			case 0:
 				return &v.state
@@ -4547,7 +8130,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AcquireUnloadedBaseModelResponse); i {
 			case 0:
 				return &v.state
@@ -4559,7 +8142,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateBaseModelLoadingStatusRequest); i {
 			case 0:
 				return &v.state
@@ -4571,7 +8154,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateBaseModelLoadingStatusResponse); i {
 			case 0:
 				return &v.state
@@ -4583,7 +8166,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AcquireUnloadedModelRequest); i {
 			case 0:
 				return &v.state
@@ -4595,7 +8178,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AcquireUnloadedModelResponse); i {
 			case 0:
 				return &v.state
@@ -4607,7 +8190,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateModelLoadingStatusRequest); i {
 			case 0:
 				return &v.state
@@ -4619,7 +8202,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateModelLoadingStatusResponse); i {
 			case 0:
 				return &v.state
@@ -4631,7 +8214,151 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireObjectDeletionTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcquireObjectDeletionTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteObjectDeletionTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteObjectDeletionTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModelPathsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListModelPathsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoadedModelPathsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadedModelPath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLoadedModelPathsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateModelDegradationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateModelDegradationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModelSize_FormatSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ModelConfig_RuntimeConfig); i {
 			case 0:
 				return &v.state
@@ -4643,7 +8370,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ModelConfig_ClusterAllocationPolicy); i {
 			case 0:
 				return &v.state
@@ -4655,7 +8382,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ModelConfig_RuntimeConfig_Resources); i {
 			case 0:
 				return &v.state
@@ -4667,7 +8394,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProjectAssignment_NodeSelector); i {
 			case 0:
 				return &v.state
@@ -4679,7 +8406,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateBaseModelLoadingStatusRequest_Success); i {
 			case 0:
 				return &v.state
@@ -4691,7 +8418,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateBaseModelLoadingStatusRequest_Failure); i {
 			case 0:
 				return &v.state
@@ -4703,7 +8430,19 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBaseModelLoadingStatusRequest_Cancelled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_v1_model_manager_service_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateModelLoadingStatusRequest_Success); i {
 			case 0:
 				return &v.state
@@ -4715,7 +8454,7 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
-		file_api_v1_model_manager_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_api_v1_model_manager_service_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateModelLoadingStatusRequest_Failure); i {
 			case 0:
 				return &v.state
@@ -4727,22 +8466,36 @@ func file_api_v1_model_manager_service_proto_init() {
 				return nil
 			}
 		}
+		file_api_v1_model_manager_service_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateModelLoadingStatusRequest_Cancelled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_api_v1_model_manager_service_proto_msgTypes[36].OneofWrappers = []interface{}{
+	file_api_v1_model_manager_service_proto_msgTypes[55].OneofWrappers = []interface{}{
 		(*UpdateBaseModelLoadingStatusRequest_Success_)(nil),
 		(*UpdateBaseModelLoadingStatusRequest_Failure_)(nil),
+		(*UpdateBaseModelLoadingStatusRequest_Cancelled_)(nil),
 	}
-	file_api_v1_model_manager_service_proto_msgTypes[40].OneofWrappers = []interface{}{
+	file_api_v1_model_manager_service_proto_msgTypes[59].OneofWrappers = []interface{}{
 		(*UpdateModelLoadingStatusRequest_Success_)(nil),
 		(*UpdateModelLoadingStatusRequest_Failure_)(nil),
+		(*UpdateModelLoadingStatusRequest_Cancelled_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_v1_model_manager_service_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   50,
+			NumEnums:      7,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -31,6 +31,18 @@ type ModelsServiceClient interface {
 	UpdateModel(ctx context.Context, in *UpdateModelRequest, opts ...grpc.CallOption) (*Model, error)
 	ActivateModel(ctx context.Context, in *ActivateModelRequest, opts ...grpc.CallOption) (*ActivateModelResponse, error)
 	DeactivateModel(ctx context.Context, in *DeactivateModelRequest, opts ...grpc.CallOption) (*DeactivateModelResponse, error)
+	// RetryModelLoad immediately requeues a model whose loading has failed. The retry budget is reset.
+	RetryModelLoad(ctx context.Context, in *RetryModelLoadRequest, opts ...grpc.CallOption) (*RetryModelLoadResponse, error)
+	// CancelModelLoad cancels the loading of a model. A model that has not been acquired by a loader is cancelled
+	// immediately. Otherwise the model is marked as cancelling until the loader stops loading it. The model is still
+	// loaded if the loader completes the loading before it observes the cancellation.
+	CancelModelLoad(ctx context.Context, in *CancelModelLoadRequest, opts ...grpc.CallOption) (*CancelModelLoadResponse, error)
+	// WatchModels streams the events of the models that are visible to the caller.
+	WatchModels(ctx context.Context, in *WatchModelsRequest, opts ...grpc.CallOption) (ModelsService_WatchModelsClient, error)
+	// ListModelLoadQueue lists the models waiting to be loaded in the order that loaders acquire them.
+	ListModelLoadQueue(ctx context.Context, in *ListModelLoadQueueRequest, opts ...grpc.CallOption) (*ListModelLoadQueueResponse, error)
+	// GetModelFiles returns the files of a loaded model in the object store.
+	GetModelFiles(ctx context.Context, in *GetModelFilesRequest, opts ...grpc.CallOption) (*GetModelFilesResponse, error)
 }
 
 type modelsServiceClient struct {
@@ -104,6 +116,74 @@ func (c *modelsServiceClient) DeactivateModel(ctx context.Context, in *Deactivat
 	return out, nil
 }
 
+func (c *modelsServiceClient) RetryModelLoad(ctx context.Context, in *RetryModelLoadRequest, opts ...grpc.CallOption) (*RetryModelLoadResponse, error) {
+	out := new(RetryModelLoadResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.models.server.v1.ModelsService/RetryModelLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelsServiceClient) CancelModelLoad(ctx context.Context, in *CancelModelLoadRequest, opts ...grpc.CallOption) (*CancelModelLoadResponse, error) {
+	out := new(CancelModelLoadResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.models.server.v1.ModelsService/CancelModelLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelsServiceClient) WatchModels(ctx context.Context, in *WatchModelsRequest, opts ...grpc.CallOption) (ModelsService_WatchModelsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ModelsService_ServiceDesc.Streams[0], "/llmariner.models.server.v1.ModelsService/WatchModels", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &modelsServiceWatchModelsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ModelsService_WatchModelsClient interface {
+	Recv() (*ModelEvent, error)
+	grpc.ClientStream
+}
+
+type modelsServiceWatchModelsClient struct {
+	grpc.ClientStream
+}
+
+func (x *modelsServiceWatchModelsClient) Recv() (*ModelEvent, error) {
+	m := new(ModelEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *modelsServiceClient) ListModelLoadQueue(ctx context.Context, in *ListModelLoadQueueRequest, opts ...grpc.CallOption) (*ListModelLoadQueueResponse, error) {
+	out := new(ListModelLoadQueueResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.models.server.v1.ModelsService/ListModelLoadQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelsServiceClient) GetModelFiles(ctx context.Context, in *GetModelFilesRequest, opts ...grpc.CallOption) (*GetModelFilesResponse, error) {
+	out := new(GetModelFilesResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.models.server.v1.ModelsService/GetModelFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ModelsServiceServer is the server API for ModelsService service.
 // All implementations must embed UnimplementedModelsServiceServer
 // for forward compatibility
@@ -121,6 +201,18 @@ type ModelsServiceServer interface {
 	UpdateModel(context.Context, *UpdateModelRequest) (*Model, error)
 	ActivateModel(context.Context, *ActivateModelRequest) (*ActivateModelResponse, error)
 	DeactivateModel(context.Context, *DeactivateModelRequest) (*DeactivateModelResponse, error)
+	// RetryModelLoad immediately requeues a model whose loading has failed. The retry budget is reset.
+	RetryModelLoad(context.Context, *RetryModelLoadRequest) (*RetryModelLoadResponse, error)
+	// CancelModelLoad cancels the loading of a model. A model that has not been acquired by a loader is cancelled
+	// immediately. Otherwise the model is marked as cancelling until the loader stops loading it. The model is still
+	// loaded if the loader completes the loading before it observes the cancellation.
+	CancelModelLoad(context.Context, *CancelModelLoadRequest) (*CancelModelLoadResponse, error)
+	// WatchModels streams the events of the models that are visible to the caller.
+	WatchModels(*WatchModelsRequest, ModelsService_WatchModelsServer) error
+	// ListModelLoadQueue lists the models waiting to be loaded in the order that loaders acquire them.
+	ListModelLoadQueue(context.Context, *ListModelLoadQueueRequest) (*ListModelLoadQueueResponse, error)
+	// GetModelFiles returns the files of a loaded model in the object store.
+	GetModelFiles(context.Context, *GetModelFilesRequest) (*GetModelFilesResponse, error)
 	mustEmbedUnimplementedModelsServiceServer()
 }
 
@@ -149,6 +241,21 @@ func (UnimplementedModelsServiceServer) ActivateModel(context.Context, *Activate
 func (UnimplementedModelsServiceServer) DeactivateModel(context.Context, *DeactivateModelRequest) (*DeactivateModelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeactivateModel not implemented")
 }
+func (UnimplementedModelsServiceServer) RetryModelLoad(context.Context, *RetryModelLoadRequest) (*RetryModelLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryModelLoad not implemented")
+}
+func (UnimplementedModelsServiceServer) CancelModelLoad(context.Context, *CancelModelLoadRequest) (*CancelModelLoadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelModelLoad not implemented")
+}
+func (UnimplementedModelsServiceServer) WatchModels(*WatchModelsRequest, ModelsService_WatchModelsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchModels not implemented")
+}
+func (UnimplementedModelsServiceServer) ListModelLoadQueue(context.Context, *ListModelLoadQueueRequest) (*ListModelLoadQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModelLoadQueue not implemented")
+}
+func (UnimplementedModelsServiceServer) GetModelFiles(context.Context, *GetModelFilesRequest) (*GetModelFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModelFiles not implemented")
+}
 func (UnimplementedModelsServiceServer) mustEmbedUnimplementedModelsServiceServer() {}
 
 // UnsafeModelsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -288,6 +395,99 @@ func _ModelsService_DeactivateModel_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ModelsService_RetryModelLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryModelLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelsServiceServer).RetryModelLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.models.server.v1.ModelsService/RetryModelLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelsServiceServer).RetryModelLoad(ctx, req.(*RetryModelLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelsService_CancelModelLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelModelLoadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelsServiceServer).CancelModelLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.models.server.v1.ModelsService/CancelModelLoad",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelsServiceServer).CancelModelLoad(ctx, req.(*CancelModelLoadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelsService_WatchModels_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchModelsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ModelsServiceServer).WatchModels(m, &modelsServiceWatchModelsServer{stream})
+}
+
+type ModelsService_WatchModelsServer interface {
+	Send(*ModelEvent) error
+	grpc.ServerStream
+}
+
+type modelsServiceWatchModelsServer struct {
+	grpc.ServerStream
+}
+
+func (x *modelsServiceWatchModelsServer) Send(m *ModelEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ModelsService_ListModelLoadQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModelLoadQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelsServiceServer).ListModelLoadQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.models.server.v1.ModelsService/ListModelLoadQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelsServiceServer).ListModelLoadQueue(ctx, req.(*ListModelLoadQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelsService_GetModelFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelsServiceServer).GetModelFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.models.server.v1.ModelsService/GetModelFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelsServiceServer).GetModelFiles(ctx, req.(*GetModelFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ModelsService_ServiceDesc is the grpc.ServiceDesc for ModelsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -323,8 +523,30 @@ var ModelsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeactivateModel",
 			Handler:    _ModelsService_DeactivateModel_Handler,
 		},
+		{
+			MethodName: "RetryModelLoad",
+			Handler:    _ModelsService_RetryModelLoad_Handler,
+		},
+		{
+			MethodName: "CancelModelLoad",
+			Handler:    _ModelsService_CancelModelLoad_Handler,
+		},
+		{
+			MethodName: "ListModelLoadQueue",
+			Handler:    _ModelsService_ListModelLoadQueue_Handler,
+		},
+		{
+			MethodName: "GetModelFiles",
+			Handler:    _ModelsService_GetModelFiles_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchModels",
+			Handler:       _ModelsService_WatchModels_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/model_manager_service.proto",
 }
 
@@ -371,6 +593,27 @@ type ModelsWorkerServiceClient interface {
 	// UpdateModelLoadingStatus updates the loading status. When the loading succeeded, it also
 	// updates the model metadata. Used by model-manager-loader.
 	UpdateModelLoadingStatus(ctx context.Context, in *UpdateModelLoadingStatusRequest, opts ...grpc.CallOption) (*UpdateModelLoadingStatusResponse, error)
+	// WatchModels streams the events of the loaded models in the cluster's tenant. Used by inference-manager-engine.
+	WatchModels(ctx context.Context, in *WatchModelsRequest, opts ...grpc.CallOption) (ModelsWorkerService_WatchModelsClient, error)
+	// AcquireObjectDeletionTask acquires a task to delete the objects of a deleted model from the object store.
+	// Only the tasks for the models loaded by the cluster are acquired, as well as the tasks for the models
+	// whose loading cluster is unknown. Used by model-manager-loader.
+	AcquireObjectDeletionTask(ctx context.Context, in *AcquireObjectDeletionTaskRequest, opts ...grpc.CallOption) (*AcquireObjectDeletionTaskResponse, error)
+	// CompleteObjectDeletionTask marks the object deletion task as completed. Used by model-manager-loader.
+	CompleteObjectDeletionTask(ctx context.Context, in *CompleteObjectDeletionTaskRequest, opts ...grpc.CallOption) (*CompleteObjectDeletionTaskResponse, error)
+	// ListModelPaths lists the object store paths of the models of all tenants. The object store is shared by
+	// tenants. Used by model-manager-loader to find orphan objects.
+	ListModelPaths(ctx context.Context, in *ListModelPathsRequest, opts ...grpc.CallOption) (*ListModelPathsResponse, error)
+	// ListLoadedModelPaths lists the object store paths of the loaded and degraded models in the tenant.
+	// Only the models loaded by the cluster are listed as other clusters might use different object stores.
+	// Used by model-manager-loader to check that the model files exist.
+	ListLoadedModelPaths(ctx context.Context, in *ListLoadedModelPathsRequest, opts ...grpc.CallOption) (*ListLoadedModelPathsResponse, error)
+	// UpdateModelDegradation marks a loaded model as degraded or a degraded model as loaded
+	// depending on whether its files exist in the object store. Used by model-manager-loader.
+	UpdateModelDegradation(ctx context.Context, in *UpdateModelDegradationRequest, opts ...grpc.CallOption) (*UpdateModelDegradationResponse, error)
+	// GetModelFiles returns the files of a loaded model in the object store. Used by inference-manager-engine
+	// to download the model files and verify their integrity without listing the objects.
+	GetModelFiles(ctx context.Context, in *GetModelFilesRequest, opts ...grpc.CallOption) (*GetModelFilesResponse, error)
 }
 
 type modelsWorkerServiceClient struct {
@@ -525,6 +768,92 @@ func (c *modelsWorkerServiceClient) UpdateModelLoadingStatus(ctx context.Context
 	return out, nil
 }
 
+func (c *modelsWorkerServiceClient) WatchModels(ctx context.Context, in *WatchModelsRequest, opts ...grpc.CallOption) (ModelsWorkerService_WatchModelsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ModelsWorkerService_ServiceDesc.Streams[0], "/llmariner.models.server.v1.ModelsWorkerService/WatchModels", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &modelsWorkerServiceWatchModelsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ModelsWorkerService_WatchModelsClient interface {
+	Recv() (*ModelEvent, error)
+	grpc.ClientStream
+}
+
+type modelsWorkerServiceWatchModelsClient struct {
+	grpc.ClientStream
+}
+
+func (x *modelsWorkerServiceWatchModelsClient) Recv() (*ModelEvent, error) {
+	m := new(ModelEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *modelsWorkerServiceClient) AcquireObjectDeletionTask(ctx context.Context, in *AcquireObjectDeletionTaskRequest, opts ...grpc.CallOption) (*AcquireObjectDeletionTaskResponse, error) {
+	out := new(AcquireObjectDeletionTaskResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.models.server.v1.ModelsWorkerService/AcquireObjectDeletionTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelsWorkerServiceClient) CompleteObjectDeletionTask(ctx context.Context, in *CompleteObjectDeletionTaskRequest, opts ...grpc.CallOption) (*CompleteObjectDeletionTaskResponse, error) {
+	out := new(CompleteObjectDeletionTaskResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.models.server.v1.ModelsWorkerService/CompleteObjectDeletionTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelsWorkerServiceClient) ListModelPaths(ctx context.Context, in *ListModelPathsRequest, opts ...grpc.CallOption) (*ListModelPathsResponse, error) {
+	out := new(ListModelPathsResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.models.server.v1.ModelsWorkerService/ListModelPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelsWorkerServiceClient) ListLoadedModelPaths(ctx context.Context, in *ListLoadedModelPathsRequest, opts ...grpc.CallOption) (*ListLoadedModelPathsResponse, error) {
+	out := new(ListLoadedModelPathsResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.models.server.v1.ModelsWorkerService/ListLoadedModelPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelsWorkerServiceClient) UpdateModelDegradation(ctx context.Context, in *UpdateModelDegradationRequest, opts ...grpc.CallOption) (*UpdateModelDegradationResponse, error) {
+	out := new(UpdateModelDegradationResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.models.server.v1.ModelsWorkerService/UpdateModelDegradation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *modelsWorkerServiceClient) GetModelFiles(ctx context.Context, in *GetModelFilesRequest, opts ...grpc.CallOption) (*GetModelFilesResponse, error) {
+	out := new(GetModelFilesResponse)
+	err := c.cc.Invoke(ctx, "/llmariner.models.server.v1.ModelsWorkerService/GetModelFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ModelsWorkerServiceServer is the server API for ModelsWorkerService service.
 // All implementations must embed UnimplementedModelsWorkerServiceServer
 // for forward compatibility
@@ -568,6 +897,27 @@ type ModelsWorkerServiceServer interface {
 	// UpdateModelLoadingStatus updates the loading status. When the loading succeeded, it also
 	// updates the model metadata. Used by model-manager-loader.
 	UpdateModelLoadingStatus(context.Context, *UpdateModelLoadingStatusRequest) (*UpdateModelLoadingStatusResponse, error)
+	// WatchModels streams the events of the loaded models in the cluster's tenant. Used by inference-manager-engine.
+	WatchModels(*WatchModelsRequest, ModelsWorkerService_WatchModelsServer) error
+	// AcquireObjectDeletionTask acquires a task to delete the objects of a deleted model from the object store.
+	// Only the tasks for the models loaded by the cluster are acquired, as well as the tasks for the models
+	// whose loading cluster is unknown. Used by model-manager-loader.
+	AcquireObjectDeletionTask(context.Context, *AcquireObjectDeletionTaskRequest) (*AcquireObjectDeletionTaskResponse, error)
+	// CompleteObjectDeletionTask marks the object deletion task as completed. Used by model-manager-loader.
+	CompleteObjectDeletionTask(context.Context, *CompleteObjectDeletionTaskRequest) (*CompleteObjectDeletionTaskResponse, error)
+	// ListModelPaths lists the object store paths of the models of all tenants. The object store is shared by
+	// tenants. Used by model-manager-loader to find orphan objects.
+	ListModelPaths(context.Context, *ListModelPathsRequest) (*ListModelPathsResponse, error)
+	// ListLoadedModelPaths lists the object store paths of the loaded and degraded models in the tenant.
+	// Only the models loaded by the cluster are listed as other clusters might use different object stores.
+	// Used by model-manager-loader to check that the model files exist.
+	ListLoadedModelPaths(context.Context, *ListLoadedModelPathsRequest) (*ListLoadedModelPathsResponse, error)
+	// UpdateModelDegradation marks a loaded model as degraded or a degraded model as loaded
+	// depending on whether its files exist in the object store. Used by model-manager-loader.
+	UpdateModelDegradation(context.Context, *UpdateModelDegradationRequest) (*UpdateModelDegradationResponse, error)
+	// GetModelFiles returns the files of a loaded model in the object store. Used by inference-manager-engine
+	// to download the model files and verify their integrity without listing the objects.
+	GetModelFiles(context.Context, *GetModelFilesRequest) (*GetModelFilesResponse, error)
 	mustEmbedUnimplementedModelsWorkerServiceServer()
 }
 
@@ -623,6 +973,27 @@ func (UnimplementedModelsWorkerServiceServer) UpdateBaseModelLoadingStatus(conte
 func (UnimplementedModelsWorkerServiceServer) UpdateModelLoadingStatus(context.Context, *UpdateModelLoadingStatusRequest) (*UpdateModelLoadingStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateModelLoadingStatus not implemented")
 }
+func (UnimplementedModelsWorkerServiceServer) WatchModels(*WatchModelsRequest, ModelsWorkerService_WatchModelsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchModels not implemented")
+}
+func (UnimplementedModelsWorkerServiceServer) AcquireObjectDeletionTask(context.Context, *AcquireObjectDeletionTaskRequest) (*AcquireObjectDeletionTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireObjectDeletionTask not implemented")
+}
+func (UnimplementedModelsWorkerServiceServer) CompleteObjectDeletionTask(context.Context, *CompleteObjectDeletionTaskRequest) (*CompleteObjectDeletionTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteObjectDeletionTask not implemented")
+}
+func (UnimplementedModelsWorkerServiceServer) ListModelPaths(context.Context, *ListModelPathsRequest) (*ListModelPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModelPaths not implemented")
+}
+func (UnimplementedModelsWorkerServiceServer) ListLoadedModelPaths(context.Context, *ListLoadedModelPathsRequest) (*ListLoadedModelPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoadedModelPaths not implemented")
+}
+func (UnimplementedModelsWorkerServiceServer) UpdateModelDegradation(context.Context, *UpdateModelDegradationRequest) (*UpdateModelDegradationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateModelDegradation not implemented")
+}
+func (UnimplementedModelsWorkerServiceServer) GetModelFiles(context.Context, *GetModelFilesRequest) (*GetModelFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModelFiles not implemented")
+}
 func (UnimplementedModelsWorkerServiceServer) mustEmbedUnimplementedModelsWorkerServiceServer() {}
 
 // UnsafeModelsWorkerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -924,6 +1295,135 @@ func _ModelsWorkerService_UpdateModelLoadingStatus_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ModelsWorkerService_WatchModels_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchModelsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ModelsWorkerServiceServer).WatchModels(m, &modelsWorkerServiceWatchModelsServer{stream})
+}
+
+type ModelsWorkerService_WatchModelsServer interface {
+	Send(*ModelEvent) error
+	grpc.ServerStream
+}
+
+type modelsWorkerServiceWatchModelsServer struct {
+	grpc.ServerStream
+}
+
+func (x *modelsWorkerServiceWatchModelsServer) Send(m *ModelEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ModelsWorkerService_AcquireObjectDeletionTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireObjectDeletionTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelsWorkerServiceServer).AcquireObjectDeletionTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.models.server.v1.ModelsWorkerService/AcquireObjectDeletionTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelsWorkerServiceServer).AcquireObjectDeletionTask(ctx, req.(*AcquireObjectDeletionTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelsWorkerService_CompleteObjectDeletionTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteObjectDeletionTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelsWorkerServiceServer).CompleteObjectDeletionTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.models.server.v1.ModelsWorkerService/CompleteObjectDeletionTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelsWorkerServiceServer).CompleteObjectDeletionTask(ctx, req.(*CompleteObjectDeletionTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelsWorkerService_ListModelPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModelPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelsWorkerServiceServer).ListModelPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.models.server.v1.ModelsWorkerService/ListModelPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelsWorkerServiceServer).ListModelPaths(ctx, req.(*ListModelPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelsWorkerService_ListLoadedModelPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoadedModelPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelsWorkerServiceServer).ListLoadedModelPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.models.server.v1.ModelsWorkerService/ListLoadedModelPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelsWorkerServiceServer).ListLoadedModelPaths(ctx, req.(*ListLoadedModelPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelsWorkerService_UpdateModelDegradation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateModelDegradationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelsWorkerServiceServer).UpdateModelDegradation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.models.server.v1.ModelsWorkerService/UpdateModelDegradation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelsWorkerServiceServer).UpdateModelDegradation(ctx, req.(*UpdateModelDegradationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModelsWorkerService_GetModelFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModelFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelsWorkerServiceServer).GetModelFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/llmariner.models.server.v1.ModelsWorkerService/GetModelFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelsWorkerServiceServer).GetModelFiles(ctx, req.(*GetModelFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ModelsWorkerService_ServiceDesc is the grpc.ServiceDesc for ModelsWorkerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -995,7 +1495,37 @@ var ModelsWorkerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateModelLoadingStatus",
 			Handler:    _ModelsWorkerService_UpdateModelLoadingStatus_Handler,
 		},
+		{
+			MethodName: "AcquireObjectDeletionTask",
+			Handler:    _ModelsWorkerService_AcquireObjectDeletionTask_Handler,
+		},
+		{
+			MethodName: "CompleteObjectDeletionTask",
+			Handler:    _ModelsWorkerService_CompleteObjectDeletionTask_Handler,
+		},
+		{
+			MethodName: "ListModelPaths",
+			Handler:    _ModelsWorkerService_ListModelPaths_Handler,
+		},
+		{
+			MethodName: "ListLoadedModelPaths",
+			Handler:    _ModelsWorkerService_ListLoadedModelPaths_Handler,
+		},
+		{
+			MethodName: "UpdateModelDegradation",
+			Handler:    _ModelsWorkerService_UpdateModelDegradation_Handler,
+		},
+		{
+			MethodName: "GetModelFiles",
+			Handler:    _ModelsWorkerService_GetModelFiles_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchModels",
+			Handler:       _ModelsWorkerService_WatchModels_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/model_manager_service.proto",
 }
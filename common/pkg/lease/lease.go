@@ -0,0 +1,51 @@
+package lease
+
+import (
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	errorDomain = "model-manager.llmariner.ai"
+	// errorReasonLost is the reason of the error returned when a loader no longer holds the lease of a model.
+	errorReasonLost = "LOADING_LEASE_LOST"
+)
+
+// NewLostError returns a FailedPrecondition error indicating that the loader no longer holds the lease of
+// the model. Other FailedPrecondition errors are distinguished from it by the error details.
+func NewLostError(modelID, loaderID string) error {
+	st := status.Newf(codes.FailedPrecondition, "lease of model %q is not held by loader %q", modelID, loaderID)
+	st, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: errorReasonLost,
+		Domain: errorDomain,
+	})
+	if err != nil {
+		// This happens only if the details cannot be marshaled.
+		return status.Errorf(codes.Internal, "add error details: %s", err)
+	}
+	return st.Err()
+}
+
+// IsLostError returns true if the error is returned by NewLostError.
+func IsLostError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var gerr interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &gerr) {
+		return false
+	}
+	st := gerr.GRPCStatus()
+	if st.Code() != codes.FailedPrecondition {
+		return false
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.Domain == errorDomain && info.Reason == errorReasonLost {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package lease
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsLostError(t *testing.T) {
+	tcs := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "lost",
+			err:  NewLostError("m0", "loader0"),
+			want: true,
+		},
+		{
+			name: "wrapped",
+			err:  fmt.Errorf("update status: %w", NewLostError("m0", "loader0")),
+			want: true,
+		},
+		{
+			name: "other failed precondition",
+			err:  status.Errorf(codes.FailedPrecondition, "concurrent update to model status"),
+			want: false,
+		},
+		{
+			name: "other code",
+			err:  status.Errorf(codes.Internal, "error"),
+			want: false,
+		},
+		{
+			name: "nil",
+			err:  nil,
+			want: false,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsLostError(tc.err))
+		})
+	}
+	assert.Equal(t, codes.FailedPrecondition, status.Code(NewLostError("m0", "loader0")))
+}
@@ -0,0 +1,24 @@
+package netaddr
+
+import "net/netip"
+
+// sharedAddressSpace is the address space shared by carrier-grade NATs (RFC 6598).
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublic returns true if the address is a global unicast address that is not in a private network.
+// Loopback, private, link-local, unspecified, multicast and carrier-grade NAT addresses are not public.
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		!addr.IsGlobalUnicast() ||
+		addr.IsPrivate() ||
+		addr.IsLoopback() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() {
+		return false
+	}
+	return !sharedAddressSpace.Contains(addr)
+}
@@ -0,0 +1,36 @@
+package netaddr
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublic(t *testing.T) {
+	tcs := []struct {
+		addr string
+		want bool
+	}{
+		{addr: "8.8.8.8", want: true},
+		{addr: "2001:4860:4860::8888", want: true},
+		{addr: "127.0.0.1", want: false},
+		{addr: "::1", want: false},
+		{addr: "10.0.0.1", want: false},
+		{addr: "172.16.0.1", want: false},
+		{addr: "192.168.1.1", want: false},
+		{addr: "fd00::1", want: false},
+		{addr: "169.254.169.254", want: false},
+		{addr: "fe80::1", want: false},
+		{addr: "0.0.0.0", want: false},
+		{addr: "224.0.0.1", want: false},
+		{addr: "100.64.0.1", want: false},
+		{addr: "::ffff:127.0.0.1", want: false},
+		{addr: "::ffff:8.8.8.8", want: true},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.addr, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsPublic(netip.MustParseAddr(tc.addr)))
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package pathfilter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// New returns a filter that matches the paths that match any of the include patterns and none of
+// the exclude patterns. All paths are included if no include pattern is given.
+//
+// The patterns follow the semantics of allow_patterns and ignore_patterns of the huggingface_hub library:
+// they are matched against the entire slash-separated path with fnmatch, where '*' also matches '/', and
+// a pattern that ends with '/' matches all the files under the directory.
+func New(include, exclude []string) (*Filter, error) {
+	in, err := compilePatterns(include)
+	if err != nil {
+		return nil, fmt.Errorf("include patterns: %s", err)
+	}
+	ex, err := compilePatterns(exclude)
+	if err != nil {
+		return nil, fmt.Errorf("exclude patterns: %s", err)
+	}
+	return &Filter{
+		include: in,
+		exclude: ex,
+	}, nil
+}
+
+// Filter selects paths with include and exclude patterns.
+type Filter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// Match returns true if the path is selected by the filter. A nil filter matches all paths.
+func (f *Filter) Match(path string) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.include) > 0 && !matchAny(f.include, path) {
+		return false
+	}
+	return !matchAny(f.exclude, path)
+}
+
+// IsEmpty returns true if the filter matches all paths.
+func (f *Filter) IsEmpty() bool {
+	return f == nil || (len(f.include) == 0 && len(f.exclude) == 0)
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var res []*regexp.Regexp
+	for _, p := range patterns {
+		if p == "" {
+			return nil, fmt.Errorf("empty pattern")
+		}
+		if strings.HasSuffix(p, "/") {
+			p += "*"
+		}
+		r, err := translatePattern(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %s", p, err)
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+func matchAny(rs []*regexp.Regexp, path string) bool {
+	for _, r := range rs {
+		if r.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// translatePattern converts a shell-style pattern to a regular expression in the same way as Python's fnmatch.
+func translatePattern(pattern string) (*regexp.Regexp, error) {
+	p := []rune(pattern)
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(p); i++ {
+		switch c := p[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '[':
+			j := i + 1
+			if j < len(p) && p[j] == '!' {
+				j++
+			}
+			if j < len(p) && p[j] == ']' {
+				j++
+			}
+			for j < len(p) && p[j] != ']' {
+				j++
+			}
+			if j >= len(p) {
+				// No closing bracket. Match '[' literally.
+				b.WriteString(`\[`)
+				continue
+			}
+			set := strings.ReplaceAll(string(p[i+1:j]), `\`, `\\`)
+			if strings.HasPrefix(set, "!") {
+				set = "^" + set[1:]
+			} else if strings.HasPrefix(set, "^") {
+				set = `\` + set
+			}
+			b.WriteString("[" + set + "]")
+			i = j
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
@@ -0,0 +1,88 @@
+package pathfilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter(t *testing.T) {
+	paths := []string{
+		"config.json",
+		"model-00001-of-00002.safetensors",
+		"model-00002-of-00002.safetensors",
+		"pytorch_model.bin",
+		"original/consolidated.00.pth",
+		"onnx/model.onnx",
+	}
+
+	tcs := []struct {
+		name    string
+		include []string
+		exclude []string
+		want    []string
+	}{
+		{
+			name: "no patterns",
+			want: paths,
+		},
+		{
+			name:    "include",
+			include: []string{"*.json", "*.safetensors"},
+			want: []string{
+				"config.json",
+				"model-00001-of-00002.safetensors",
+				"model-00002-of-00002.safetensors",
+			},
+		},
+		{
+			name:    "exclude directories",
+			exclude: []string{"original/", "onnx/*", "*.bin"},
+			want: []string{
+				"config.json",
+				"model-00001-of-00002.safetensors",
+				"model-00002-of-00002.safetensors",
+			},
+		},
+		{
+			name:    "wildcard matches slash",
+			include: []string{"*.pth", "*.onnx"},
+			want: []string{
+				"original/consolidated.00.pth",
+				"onnx/model.onnx",
+			},
+		},
+		{
+			name:    "include and exclude",
+			include: []string{"model-*"},
+			exclude: []string{"model-0000[!1]-*"},
+			want: []string{
+				"model-00001-of-00002.safetensors",
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := New(tc.include, tc.exclude)
+			assert.NoError(t, err)
+			var got []string
+			for _, p := range paths {
+				if f.Match(p) {
+					got = append(got, p)
+				}
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFilter_Nil(t *testing.T) {
+	var f *Filter
+	assert.True(t, f.Match("config.json"))
+	assert.True(t, f.IsEmpty())
+}
+
+func TestNew_Invalid(t *testing.T) {
+	_, err := New([]string{""}, nil)
+	assert.Error(t, err)
+}
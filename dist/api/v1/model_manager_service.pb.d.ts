@@ -13,27 +13,50 @@ export declare enum ModelFormat {
     MODEL_FORMAT_GGUF = "MODEL_FORMAT_GGUF",
     MODEL_FORMAT_HUGGING_FACE = "MODEL_FORMAT_HUGGING_FACE",
     MODEL_FORMAT_NVIDIA_TRITON = "MODEL_FORMAT_NVIDIA_TRITON",
-    MODEL_FORMAT_OLLAMA = "MODEL_FORMAT_OLLAMA"
+    MODEL_FORMAT_OLLAMA = "MODEL_FORMAT_OLLAMA",
+    MODEL_FORMAT_ONNX = "MODEL_FORMAT_ONNX",
+    MODEL_FORMAT_MLX = "MODEL_FORMAT_MLX",
+    MODEL_FORMAT_GPTQ = "MODEL_FORMAT_GPTQ",
+    MODEL_FORMAT_AWQ = "MODEL_FORMAT_AWQ",
+    MODEL_FORMAT_VLLM = "MODEL_FORMAT_VLLM"
 }
 export declare enum ModelLoadingStatus {
     MODEL_LOADING_STATUS_UNSPECIFIED = "MODEL_LOADING_STATUS_UNSPECIFIED",
     MODEL_LOADING_STATUS_REQUESTED = "MODEL_LOADING_STATUS_REQUESTED",
     MODEL_LOADING_STATUS_LOADING = "MODEL_LOADING_STATUS_LOADING",
     MODEL_LOADING_STATUS_SUCCEEDED = "MODEL_LOADING_STATUS_SUCCEEDED",
-    MODEL_LOADING_STATUS_FAILED = "MODEL_LOADING_STATUS_FAILED"
+    MODEL_LOADING_STATUS_FAILED = "MODEL_LOADING_STATUS_FAILED",
+    MODEL_LOADING_STATUS_CANCELLING = "MODEL_LOADING_STATUS_CANCELLING",
+    MODEL_LOADING_STATUS_CANCELLED = "MODEL_LOADING_STATUS_CANCELLED",
+    MODEL_LOADING_STATUS_DEGRADED = "MODEL_LOADING_STATUS_DEGRADED"
 }
 export declare enum SourceRepository {
     SOURCE_REPOSITORY_UNSPECIFIED = "SOURCE_REPOSITORY_UNSPECIFIED",
     SOURCE_REPOSITORY_OBJECT_STORE = "SOURCE_REPOSITORY_OBJECT_STORE",
     SOURCE_REPOSITORY_HUGGING_FACE = "SOURCE_REPOSITORY_HUGGING_FACE",
     SOURCE_REPOSITORY_OLLAMA = "SOURCE_REPOSITORY_OLLAMA",
-    SOURCE_REPOSITORY_FINE_TUNING = "SOURCE_REPOSITORY_FINE_TUNING"
+    SOURCE_REPOSITORY_FINE_TUNING = "SOURCE_REPOSITORY_FINE_TUNING",
+    SOURCE_REPOSITORY_OCI = "SOURCE_REPOSITORY_OCI",
+    SOURCE_REPOSITORY_HTTP = "SOURCE_REPOSITORY_HTTP"
 }
 export declare enum ActivationStatus {
     ACTIVATION_STATUS_UNSPECIFIED = "ACTIVATION_STATUS_UNSPECIFIED",
     ACTIVATION_STATUS_ACTIVE = "ACTIVATION_STATUS_ACTIVE",
     ACTIVATION_STATUS_INACTIVE = "ACTIVATION_STATUS_INACTIVE"
 }
+export declare enum ModelEventType {
+    MODEL_EVENT_TYPE_UNSPECIFIED = "MODEL_EVENT_TYPE_UNSPECIFIED",
+    MODEL_EVENT_TYPE_CREATED = "MODEL_EVENT_TYPE_CREATED",
+    MODEL_EVENT_TYPE_LOADING_PROGRESS = "MODEL_EVENT_TYPE_LOADING_PROGRESS",
+    MODEL_EVENT_TYPE_LOADED = "MODEL_EVENT_TYPE_LOADED",
+    MODEL_EVENT_TYPE_FAILED = "MODEL_EVENT_TYPE_FAILED",
+    MODEL_EVENT_TYPE_ACTIVATED = "MODEL_EVENT_TYPE_ACTIVATED",
+    MODEL_EVENT_TYPE_DEACTIVATED = "MODEL_EVENT_TYPE_DEACTIVATED",
+    MODEL_EVENT_TYPE_CONFIG_UPDATED = "MODEL_EVENT_TYPE_CONFIG_UPDATED",
+    MODEL_EVENT_TYPE_DELETED = "MODEL_EVENT_TYPE_DELETED",
+    MODEL_EVENT_TYPE_CANCELLED = "MODEL_EVENT_TYPE_CANCELLED",
+    MODEL_EVENT_TYPE_DEGRADED = "MODEL_EVENT_TYPE_DEGRADED"
+}
 export declare enum AdapterType {
     ADAPTER_TYPE_UNSPECIFIED = "ADAPTER_TYPE_UNSPECIFIED",
     ADAPTER_TYPE_LORA = "ADAPTER_TYPE_LORA",
@@ -47,6 +70,43 @@ export declare enum QuantizationType {
 export type ModelFormats = {
     formats?: ModelFormat[];
 };
+export type ModelSizeFormatSize = {
+    format?: ModelFormat;
+    size_bytes?: string;
+};
+export type ModelSize = {
+    total_bytes?: string;
+    format_sizes?: ModelSizeFormatSize[];
+};
+export type GGUFMetadata = {
+    architecture?: string;
+    parameter_count?: string;
+    context_length?: string;
+    quantization_type?: string;
+    tokenizer?: string;
+    chat_template?: string;
+};
+export type FormatArtifact = {
+    format?: ModelFormat;
+    path?: string;
+    attributes?: {[key: string]: string};
+};
+export type FormatArtifactAttributes = {
+    attributes?: {[key: string]: string};
+};
+export type HuggingFaceMetadata = {
+    architecture?: string;
+    model_type?: string;
+    dtype?: string;
+    parameter_count?: string;
+    max_position_embeddings?: string;
+    vocab_size?: string;
+    has_chat_template?: boolean;
+};
+export type FilePatterns = {
+    include?: string[];
+    exclude?: string[];
+};
 export type ModelConfigRuntimeConfigResources = {
     gpu?: number;
 };
@@ -78,6 +138,13 @@ export type Project = {
     assignments?: ProjectAssignment[];
     kubernetes_namespace?: string;
 };
+export type ModelLoadingProgress = {
+    total_bytes?: string;
+    downloaded_bytes?: string;
+    uploaded_bytes?: string;
+    bytes_per_second?: string;
+    eta_seconds?: string;
+};
 export type Model = {
     id?: string;
     created?: string;
@@ -93,6 +160,14 @@ export type Model = {
     activation_status?: ActivationStatus;
     config?: ModelConfig;
     project?: Project;
+    loading_progress?: ModelLoadingProgress;
+    revision?: string;
+    resolved_revision?: string;
+    file_patterns?: FilePatterns;
+    queue_position?: number;
+    size?: ModelSize;
+    gguf_metadata?: GGUFMetadata;
+    hugging_face_metadata?: HuggingFaceMetadata;
 };
 export type CreateModelRequest = {
     id?: string;
@@ -103,6 +178,10 @@ export type CreateModelRequest = {
     model_file_location?: string;
     config?: ModelConfig;
     is_project_scoped?: boolean;
+    revision?: string;
+    file_patterns?: FilePatterns;
+    checksum?: string;
+    priority?: number;
 };
 export type ListModelsRequest = {
     include_loading_models?: boolean;
@@ -139,6 +218,49 @@ export type DeactivateModelRequest = {
     id?: string;
 };
 export type DeactivateModelResponse = {};
+export type RetryModelLoadRequest = {
+    id?: string;
+};
+export type RetryModelLoadResponse = {};
+export type CancelModelLoadRequest = {
+    id?: string;
+};
+export type CancelModelLoadResponse = {};
+export type ListModelLoadQueueRequest = {};
+export type ModelLoadQueueEntry = {
+    model_id?: string;
+    is_base_model?: boolean;
+    project_id?: string;
+    source_repository?: SourceRepository;
+    priority?: number;
+    position?: number;
+};
+export type ListModelLoadQueueResponse = {
+    entries?: ModelLoadQueueEntry[];
+};
+export type ModelFile = {
+    path?: string;
+    size_bytes?: string;
+    sha256?: string;
+    content_type?: string;
+};
+export type GetModelFilesRequest = {
+    id?: string;
+};
+export type GetModelFilesResponse = {
+    base_path?: string;
+    files?: ModelFile[];
+};
+export type ModelEvent = {
+    revision?: string;
+    type?: ModelEventType;
+    model?: Model;
+    created?: string;
+};
+export type WatchModelsRequest = {
+    after_revision?: string;
+    include_loading_models?: boolean;
+};
 export type StorageConfig = {
     path_prefix?: string;
 };
@@ -186,6 +308,21 @@ export type CreateBaseModelRequest = {
     gguf_model_path?: string;
     source_repository?: SourceRepository;
     project_id?: string;
+    revision?: string;
+    resolved_revision?: string;
+    file_patterns?: FilePatterns;
+    files?: ModelFile[];
+    files_base_path?: string;
+    size?: ModelSize;
+    gguf_metadata?: GGUFMetadata;
+    hugging_face_metadata?: HuggingFaceMetadata;
+    artifacts?: FormatArtifact[];
+    onnx_model_path?: string;
+    mlx_model_path?: string;
+    gptq_model_path?: string;
+    awq_model_path?: string;
+    vllm_model_path?: string;
+    loader_id?: string;
 };
 export type BaseModel = {
     id?: string;
@@ -200,59 +337,141 @@ export type GetBaseModelPathResponse = {
     formats?: ModelFormat[];
     path?: string;
     gguf_model_path?: string;
+    size?: ModelSize;
+    hugging_face_metadata?: HuggingFaceMetadata;
+    artifacts?: FormatArtifact[];
+    onnx_model_path?: string;
+    mlx_model_path?: string;
+    gptq_model_path?: string;
+    awq_model_path?: string;
+    vllm_model_path?: string;
 };
 export type CreateHFModelRepoRequest = {
     name?: string;
     project_id?: string;
+    revision?: string;
 };
 export type HFModelRepo = {
     name?: string;
+    revision?: string;
 };
 export type GetHFModelRepoRequest = {
     name?: string;
     project_id?: string;
 };
-export type AcquireUnloadedBaseModelRequest = {};
+export type AcquireUnloadedBaseModelRequest = {
+    loader_id?: string;
+    supported_source_repositories?: SourceRepository[];
+    cluster_id?: string;
+    free_disk_bytes?: string;
+};
 export type AcquireUnloadedBaseModelResponse = {
     base_model_id?: string;
     source_repository?: SourceRepository;
     project_id?: string;
+    revision?: string;
+    file_patterns?: FilePatterns;
+    model_file_location?: string;
+    checksum?: string;
 };
 export type UpdateBaseModelLoadingStatusRequestSuccess = {};
 export type UpdateBaseModelLoadingStatusRequestFailure = {
     reason?: string;
+    retryable?: boolean;
 };
+export type UpdateBaseModelLoadingStatusRequestCancelled = {};
 type BaseUpdateBaseModelLoadingStatusRequest = {
     id?: string;
     project_id?: string;
     status_message?: string;
+    loader_id?: string;
+    progress?: ModelLoadingProgress;
+    upload_path?: string;
 };
 export type UpdateBaseModelLoadingStatusRequest = BaseUpdateBaseModelLoadingStatusRequest & OneOf<{
     success: UpdateBaseModelLoadingStatusRequestSuccess;
     failure: UpdateBaseModelLoadingStatusRequestFailure;
+    cancelled: UpdateBaseModelLoadingStatusRequestCancelled;
 }>;
-export type UpdateBaseModelLoadingStatusResponse = {};
-export type AcquireUnloadedModelRequest = {};
+export type UpdateBaseModelLoadingStatusResponse = {
+    cancelled?: boolean;
+};
+export type AcquireUnloadedModelRequest = {
+    loader_id?: string;
+    supported_source_repositories?: SourceRepository[];
+    cluster_id?: string;
+    free_disk_bytes?: string;
+};
 export type AcquireUnloadedModelResponse = {
     model_id?: string;
     is_base_model?: boolean;
     source_repository?: SourceRepository;
     model_file_location?: string;
     dest_path?: string;
+    file_patterns?: FilePatterns;
+};
+export type UpdateModelLoadingStatusRequestSuccess = {
+    files?: ModelFile[];
+    files_base_path?: string;
+    size?: ModelSize;
 };
-export type UpdateModelLoadingStatusRequestSuccess = {};
 export type UpdateModelLoadingStatusRequestFailure = {
     reason?: string;
+    retryable?: boolean;
 };
+export type UpdateModelLoadingStatusRequestCancelled = {};
 type BaseUpdateModelLoadingStatusRequest = {
     id?: string;
     status_message?: string;
+    loader_id?: string;
+    progress?: ModelLoadingProgress;
 };
 export type UpdateModelLoadingStatusRequest = BaseUpdateModelLoadingStatusRequest & OneOf<{
     success: UpdateModelLoadingStatusRequestSuccess;
     failure: UpdateModelLoadingStatusRequestFailure;
+    cancelled: UpdateModelLoadingStatusRequestCancelled;
 }>;
-export type UpdateModelLoadingStatusResponse = {};
+export type UpdateModelLoadingStatusResponse = {
+    cancelled?: boolean;
+};
+export type AcquireObjectDeletionTaskRequest = {
+    loader_id?: string;
+};
+export type AcquireObjectDeletionTaskResponse = {
+    task_id?: string;
+    path?: string;
+    uploads_only?: boolean;
+};
+export type CompleteObjectDeletionTaskRequest = {
+    task_id?: string;
+    loader_id?: string;
+};
+export type CompleteObjectDeletionTaskResponse = {};
+export type ListModelPathsRequest = {};
+export type ListModelPathsResponse = {
+    paths?: string[];
+};
+export type ListLoadedModelPathsRequest = {};
+export type LoadedModelPath = {
+    id?: string;
+    project_id?: string;
+    is_base_model?: boolean;
+    path?: string;
+    gguf_model_path?: string;
+    loading_status?: ModelLoadingStatus;
+    degraded_reason?: string;
+};
+export type ListLoadedModelPathsResponse = {
+    models?: LoadedModelPath[];
+};
+export type UpdateModelDegradationRequest = {
+    id?: string;
+    project_id?: string;
+    is_base_model?: boolean;
+    degraded?: boolean;
+    reason?: string;
+};
+export type UpdateModelDegradationResponse = {};
 export declare class ModelsService {
     static GetModel(req: GetModelRequest, initReq?: fm.InitReq): Promise<Model>;
     static ListModels(req: ListModelsRequest, initReq?: fm.InitReq): Promise<ListModelsResponse>;
@@ -261,6 +480,11 @@ export declare class ModelsService {
     static UpdateModel(req: UpdateModelRequest, initReq?: fm.InitReq): Promise<Model>;
     static ActivateModel(req: ActivateModelRequest, initReq?: fm.InitReq): Promise<ActivateModelResponse>;
     static DeactivateModel(req: DeactivateModelRequest, initReq?: fm.InitReq): Promise<DeactivateModelResponse>;
+    static RetryModelLoad(req: RetryModelLoadRequest, initReq?: fm.InitReq): Promise<RetryModelLoadResponse>;
+    static CancelModelLoad(req: CancelModelLoadRequest, initReq?: fm.InitReq): Promise<CancelModelLoadResponse>;
+    static WatchModels(req: WatchModelsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ModelEvent>, initReq?: fm.InitReq): Promise<void>;
+    static ListModelLoadQueue(req: ListModelLoadQueueRequest, initReq?: fm.InitReq): Promise<ListModelLoadQueueResponse>;
+    static GetModelFiles(req: GetModelFilesRequest, initReq?: fm.InitReq): Promise<GetModelFilesResponse>;
 }
 export declare class ModelsWorkerService {
     static CreateStorageConfig(req: CreateStorageConfigRequest, initReq?: fm.InitReq): Promise<StorageConfig>;
@@ -279,5 +503,12 @@ export declare class ModelsWorkerService {
     static AcquireUnloadedModel(req: AcquireUnloadedModelRequest, initReq?: fm.InitReq): Promise<AcquireUnloadedModelResponse>;
     static UpdateBaseModelLoadingStatus(req: UpdateBaseModelLoadingStatusRequest, initReq?: fm.InitReq): Promise<UpdateBaseModelLoadingStatusResponse>;
     static UpdateModelLoadingStatus(req: UpdateModelLoadingStatusRequest, initReq?: fm.InitReq): Promise<UpdateModelLoadingStatusResponse>;
+    static WatchModels(req: WatchModelsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ModelEvent>, initReq?: fm.InitReq): Promise<void>;
+    static AcquireObjectDeletionTask(req: AcquireObjectDeletionTaskRequest, initReq?: fm.InitReq): Promise<AcquireObjectDeletionTaskResponse>;
+    static CompleteObjectDeletionTask(req: CompleteObjectDeletionTaskRequest, initReq?: fm.InitReq): Promise<CompleteObjectDeletionTaskResponse>;
+    static ListModelPaths(req: ListModelPathsRequest, initReq?: fm.InitReq): Promise<ListModelPathsResponse>;
+    static ListLoadedModelPaths(req: ListLoadedModelPathsRequest, initReq?: fm.InitReq): Promise<ListLoadedModelPathsResponse>;
+    static UpdateModelDegradation(req: UpdateModelDegradationRequest, initReq?: fm.InitReq): Promise<UpdateModelDegradationResponse>;
+    static GetModelFiles(req: GetModelFilesRequest, initReq?: fm.InitReq): Promise<GetModelFilesResponse>;
 }
 export {};
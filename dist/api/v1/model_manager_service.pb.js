@@ -11,6 +11,11 @@ export var ModelFormat;
     ModelFormat["MODEL_FORMAT_HUGGING_FACE"] = "MODEL_FORMAT_HUGGING_FACE";
     ModelFormat["MODEL_FORMAT_NVIDIA_TRITON"] = "MODEL_FORMAT_NVIDIA_TRITON";
     ModelFormat["MODEL_FORMAT_OLLAMA"] = "MODEL_FORMAT_OLLAMA";
+    ModelFormat["MODEL_FORMAT_ONNX"] = "MODEL_FORMAT_ONNX";
+    ModelFormat["MODEL_FORMAT_MLX"] = "MODEL_FORMAT_MLX";
+    ModelFormat["MODEL_FORMAT_GPTQ"] = "MODEL_FORMAT_GPTQ";
+    ModelFormat["MODEL_FORMAT_AWQ"] = "MODEL_FORMAT_AWQ";
+    ModelFormat["MODEL_FORMAT_VLLM"] = "MODEL_FORMAT_VLLM";
 })(ModelFormat || (ModelFormat = {}));
 export var ModelLoadingStatus;
 (function (ModelLoadingStatus) {
@@ -19,6 +24,9 @@ export var ModelLoadingStatus;
     ModelLoadingStatus["MODEL_LOADING_STATUS_LOADING"] = "MODEL_LOADING_STATUS_LOADING";
     ModelLoadingStatus["MODEL_LOADING_STATUS_SUCCEEDED"] = "MODEL_LOADING_STATUS_SUCCEEDED";
     ModelLoadingStatus["MODEL_LOADING_STATUS_FAILED"] = "MODEL_LOADING_STATUS_FAILED";
+    ModelLoadingStatus["MODEL_LOADING_STATUS_CANCELLING"] = "MODEL_LOADING_STATUS_CANCELLING";
+    ModelLoadingStatus["MODEL_LOADING_STATUS_CANCELLED"] = "MODEL_LOADING_STATUS_CANCELLED";
+    ModelLoadingStatus["MODEL_LOADING_STATUS_DEGRADED"] = "MODEL_LOADING_STATUS_DEGRADED";
 })(ModelLoadingStatus || (ModelLoadingStatus = {}));
 export var SourceRepository;
 (function (SourceRepository) {
@@ -27,6 +35,8 @@ export var SourceRepository;
     SourceRepository["SOURCE_REPOSITORY_HUGGING_FACE"] = "SOURCE_REPOSITORY_HUGGING_FACE";
     SourceRepository["SOURCE_REPOSITORY_OLLAMA"] = "SOURCE_REPOSITORY_OLLAMA";
     SourceRepository["SOURCE_REPOSITORY_FINE_TUNING"] = "SOURCE_REPOSITORY_FINE_TUNING";
+    SourceRepository["SOURCE_REPOSITORY_OCI"] = "SOURCE_REPOSITORY_OCI";
+    SourceRepository["SOURCE_REPOSITORY_HTTP"] = "SOURCE_REPOSITORY_HTTP";
 })(SourceRepository || (SourceRepository = {}));
 export var ActivationStatus;
 (function (ActivationStatus) {
@@ -34,6 +44,20 @@ export var ActivationStatus;
     ActivationStatus["ACTIVATION_STATUS_ACTIVE"] = "ACTIVATION_STATUS_ACTIVE";
     ActivationStatus["ACTIVATION_STATUS_INACTIVE"] = "ACTIVATION_STATUS_INACTIVE";
 })(ActivationStatus || (ActivationStatus = {}));
+export var ModelEventType;
+(function (ModelEventType) {
+    ModelEventType["MODEL_EVENT_TYPE_UNSPECIFIED"] = "MODEL_EVENT_TYPE_UNSPECIFIED";
+    ModelEventType["MODEL_EVENT_TYPE_CREATED"] = "MODEL_EVENT_TYPE_CREATED";
+    ModelEventType["MODEL_EVENT_TYPE_LOADING_PROGRESS"] = "MODEL_EVENT_TYPE_LOADING_PROGRESS";
+    ModelEventType["MODEL_EVENT_TYPE_LOADED"] = "MODEL_EVENT_TYPE_LOADED";
+    ModelEventType["MODEL_EVENT_TYPE_FAILED"] = "MODEL_EVENT_TYPE_FAILED";
+    ModelEventType["MODEL_EVENT_TYPE_ACTIVATED"] = "MODEL_EVENT_TYPE_ACTIVATED";
+    ModelEventType["MODEL_EVENT_TYPE_DEACTIVATED"] = "MODEL_EVENT_TYPE_DEACTIVATED";
+    ModelEventType["MODEL_EVENT_TYPE_CONFIG_UPDATED"] = "MODEL_EVENT_TYPE_CONFIG_UPDATED";
+    ModelEventType["MODEL_EVENT_TYPE_DELETED"] = "MODEL_EVENT_TYPE_DELETED";
+    ModelEventType["MODEL_EVENT_TYPE_CANCELLED"] = "MODEL_EVENT_TYPE_CANCELLED";
+    ModelEventType["MODEL_EVENT_TYPE_DEGRADED"] = "MODEL_EVENT_TYPE_DEGRADED";
+})(ModelEventType || (ModelEventType = {}));
 export var AdapterType;
 (function (AdapterType) {
     AdapterType["ADAPTER_TYPE_UNSPECIFIED"] = "ADAPTER_TYPE_UNSPECIFIED";
@@ -68,6 +92,21 @@ export class ModelsService {
     static DeactivateModel(req, initReq) {
         return fm.fetchReq(`/v1/models/${req["id"]}:deactivate`, Object.assign(Object.assign({}, initReq), { method: "POST" }));
     }
+    static RetryModelLoad(req, initReq) {
+        return fm.fetchReq(`/v1/models/${req["id"]}:retryLoad`, Object.assign(Object.assign({}, initReq), { method: "POST" }));
+    }
+    static CancelModelLoad(req, initReq) {
+        return fm.fetchReq(`/v1/models/${req["id"]}:cancelLoad`, Object.assign(Object.assign({}, initReq), { method: "POST" }));
+    }
+    static WatchModels(req, entityNotifier, initReq) {
+        return fm.fetchStreamingRequest(`/v1/models:watch?${fm.renderURLSearchParams(req, [])}`, entityNotifier, Object.assign(Object.assign({}, initReq), { method: "GET" }));
+    }
+    static ListModelLoadQueue(req, initReq) {
+        return fm.fetchReq(`/v1/models:loadQueue?${fm.renderURLSearchParams(req, [])}`, Object.assign(Object.assign({}, initReq), { method: "GET" }));
+    }
+    static GetModelFiles(req, initReq) {
+        return fm.fetchReq(`/v1/models/${req["id"]}:files?${fm.renderURLSearchParams(req, ["id"])}`, Object.assign(Object.assign({}, initReq), { method: "GET" }));
+    }
 }
 export class ModelsWorkerService {
     static CreateStorageConfig(req, initReq) {
@@ -118,4 +157,25 @@ export class ModelsWorkerService {
     static UpdateModelLoadingStatus(req, initReq) {
         return fm.fetchReq(`/llmariner.models.server.v1.ModelsWorkerService/UpdateModelLoadingStatus`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
     }
+    static WatchModels(req, entityNotifier, initReq) {
+        return fm.fetchStreamingRequest(`/llmariner.models.server.v1.ModelsWorkerService/WatchModels`, entityNotifier, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
+    static AcquireObjectDeletionTask(req, initReq) {
+        return fm.fetchReq(`/llmariner.models.server.v1.ModelsWorkerService/AcquireObjectDeletionTask`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
+    static CompleteObjectDeletionTask(req, initReq) {
+        return fm.fetchReq(`/llmariner.models.server.v1.ModelsWorkerService/CompleteObjectDeletionTask`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
+    static ListModelPaths(req, initReq) {
+        return fm.fetchReq(`/llmariner.models.server.v1.ModelsWorkerService/ListModelPaths`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
+    static ListLoadedModelPaths(req, initReq) {
+        return fm.fetchReq(`/llmariner.models.server.v1.ModelsWorkerService/ListLoadedModelPaths`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
+    static UpdateModelDegradation(req, initReq) {
+        return fm.fetchReq(`/llmariner.models.server.v1.ModelsWorkerService/UpdateModelDegradation`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
+    static GetModelFiles(req, initReq) {
+        return fm.fetchReq(`/llmariner.models.server.v1.ModelsWorkerService/GetModelFiles`, Object.assign(Object.assign({}, initReq), { method: "POST", body: JSON.stringify(req) }));
+    }
 }
@@ -10,5 +10,6 @@ var rootCmd = &cobra.Command{
 
 func init() {
 	rootCmd.AddCommand(runCmd())
+	rootCmd.AddCommand(scanOrphansCmd())
 	rootCmd.SilenceUsage = true
 }
@@ -5,14 +5,18 @@ import (
 	"crypto/tls"
 	"fmt"
 	"log"
+	"os"
+	"slices"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/stdr"
 	cmstatus "github.com/llmariner/cluster-manager/pkg/status"
 	laws "github.com/llmariner/common/pkg/aws"
+	"github.com/llmariner/common/pkg/id"
 	v1 "github.com/llmariner/model-manager/api/v1"
 	"github.com/llmariner/model-manager/loader/internal/config"
 	"github.com/llmariner/model-manager/loader/internal/loader"
+	"github.com/llmariner/model-manager/loader/internal/oci"
 	"github.com/llmariner/model-manager/loader/internal/s3"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	"github.com/spf13/cobra"
@@ -80,11 +84,16 @@ func run(ctx context.Context, c *config.Config) error {
 	if err != nil {
 		return err
 	}
-	sourceRepository := kindToSourceRepository(c.Downloader.Kind)
+	sourceRepository := c.Downloader.Kind.SourceRepository()
 	if sourceRepository == v1.SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED {
 		return fmt.Errorf("invalid kind: %s", c.Downloader.Kind)
 	}
 
+	loaderID, err := newLoaderID()
+	if err != nil {
+		return err
+	}
+
 	s := loader.New(
 		s3c.Bucket,
 		s3c.PathPrefix,
@@ -92,6 +101,9 @@ func run(ctx context.Context, c *config.Config) error {
 		&mdFactory{c: c},
 		s3client,
 		mclient,
+		loaderID,
+		c.ClusterID,
+		c.Concurrency,
 		logger,
 	)
 
@@ -105,7 +117,9 @@ func run(ctx context.Context, c *config.Config) error {
 	}
 
 	eg, ctx := errgroup.WithContext(ctx)
-	eg.Go(func() error { return s.Run(ctx, c.BaseModels, c.Models, sourceRepository, c.ModelLoadInterval) })
+	eg.Go(func() error {
+		return s.Run(ctx, c.BaseModels, c.Models, sourceRepository, c.ModelLoadInterval, c.ReconcileInterval)
+	})
 	if c.ComponentStatusSender.Enable {
 		eg.Go(func() error {
 			ss.Run(ctx)
@@ -115,6 +129,20 @@ func run(ctx context.Context, c *config.Config) error {
 	return eg.Wait()
 }
 
+// newLoaderID returns a unique ID of the loader process. The hostname (i.e., the pod name) is followed by
+// a random suffix so that a restarted loader does not take over the leases of the previous process.
+func newLoaderID() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", fmt.Errorf("get hostname: %s", err)
+	}
+	suffix, err := id.GenerateID("", 8)
+	if err != nil {
+		return "", fmt.Errorf("generate ID: %s", err)
+	}
+	return hostname + "-" + suffix, nil
+}
+
 func createStorageClass(ctx context.Context, mclient v1.ModelsWorkerServiceClient, pathPrefix string) error {
 	ctx = auth.AppendWorkerAuthorization(ctx)
 
@@ -134,25 +162,25 @@ func createStorageClass(ctx context.Context, mclient v1.ModelsWorkerServiceClien
 	return err
 }
 
-func kindToSourceRepository(kind config.DownloaderKind) v1.SourceRepository {
-	switch kind {
-	case config.DownloaderKindS3:
-		return v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE
-	case config.DownloaderKindHuggingFace:
-		return v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE
-	case config.DownloaderKindOllama:
-		return v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA
-	default:
-		return v1.SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
-	}
-}
-
 type mdFactory struct {
 	c *config.Config
 }
 
+// SupportedSourceRepositories returns the source repositories of the enabled downloader kinds.
+func (f *mdFactory) SupportedSourceRepositories() []v1.SourceRepository {
+	var srs []v1.SourceRepository
+	for _, k := range f.c.Downloader.EnabledKinds() {
+		srs = append(srs, k.SourceRepository())
+	}
+	return srs
+}
+
 // Create creates a ModelDownloader.
 func (f *mdFactory) Create(ctx context.Context, sourceRepository v1.SourceRepository) (loader.ModelDownloader, error) {
+	if !slices.Contains(f.SupportedSourceRepositories(), sourceRepository) {
+		return nil, fmt.Errorf("downloader for source repository %s is not enabled", sourceRepository)
+	}
+
 	logger := logr.FromContextOrDiscard(ctx)
 	switch sourceRepository {
 	case v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE:
@@ -175,11 +203,23 @@ func (f *mdFactory) Create(ctx context.Context, sourceRepository v1.SourceReposi
 		if err != nil {
 			return nil, err
 		}
-		return loader.NewS3Downloader(s3Client, s3c.Bucket, s3c.PathPrefix, logger), nil
+		return loader.NewS3Downloader(s3Client, s3c.Bucket, s3c.PathPrefix, f.c.Concurrency, logger), nil
 	case v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE:
-		return loader.NewHuggingFaceDownloader(f.c.Downloader.HuggingFace.CacheDir, logger), nil
+		return loader.NewHuggingFaceDownloader(f.c.Concurrency, logger), nil
 	case v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA:
-		return loader.NewOllamaDownloader(f.c.Downloader.Ollama.Port, logger), nil
+		return loader.NewOllamaDownloader(f.c.Downloader.Ollama.Insecure, f.c.Concurrency, logger), nil
+	case v1.SourceRepository_SOURCE_REPOSITORY_OCI:
+		ocic := f.c.Downloader.OCI
+		var creds *oci.Credentials
+		if a := ocic.Auth; a != nil {
+			creds = &oci.Credentials{
+				Username: a.Username,
+				Password: os.Getenv(a.PasswordEnvName),
+			}
+		}
+		return loader.NewOCIDownloader(ocic.Insecure, creds, f.c.Concurrency, logger), nil
+	case v1.SourceRepository_SOURCE_REPOSITORY_HTTP:
+		return loader.NewHTTPDownloader(f.c.Downloader.HTTP, logger), nil
 	default:
 		return nil, fmt.Errorf("unknown downloader source repository: %s", sourceRepository)
 	}
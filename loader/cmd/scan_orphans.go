@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/stdr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/loader/internal/config"
+	"github.com/llmariner/model-manager/loader/internal/loader"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+func scanOrphansCmd() *cobra.Command {
+	var path string
+	var logLevel int
+	cmd := &cobra.Command{
+		Use:   "scan-orphans",
+		Short: "List the objects in the object store that are not owned by any model without deleting them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := config.Parse(path)
+			if err != nil {
+				return err
+			}
+			if err := c.Validate(); err != nil {
+				return err
+			}
+			stdr.SetVerbosity(logLevel)
+			if err := scanOrphans(cmd.Context(), &c); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&path, "config", "", "Path to the config file")
+	cmd.Flags().IntVar(&logLevel, "v", 0, "Log level")
+	_ = cmd.MarkFlagRequired("config")
+	return cmd
+}
+
+func scanOrphans(ctx context.Context, c *config.Config) error {
+	logger := stdr.New(log.Default())
+	ctx = logr.NewContext(ctx, logger)
+
+	if c.Debug.Standalone {
+		return fmt.Errorf("scan-orphans requires the model manager server")
+	}
+
+	if err := auth.ValidateClusterRegistrationKey(); err != nil {
+		return err
+	}
+
+	conn, err := grpc.NewClient(c.ModelManagerServerWorkerServiceAddr, grpcOption(c))
+	if err != nil {
+		return err
+	}
+	s3client, err := newS3Client(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	s3c := c.ObjectStore.S3
+	s := loader.New(
+		s3c.Bucket,
+		s3c.PathPrefix,
+		s3c.BaseModelPathPrefix,
+		&mdFactory{c: c},
+		s3client,
+		v1.NewModelsWorkerServiceClient(conn),
+		"",
+		c.ClusterID,
+		c.Concurrency,
+		logger,
+	)
+	orphans, err := s.ScanOrphanObjects(ctx)
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	for _, o := range orphans {
+		fmt.Printf("%s\t%d\n", o.Key, o.Size)
+		total += o.Size
+	}
+	fmt.Printf("Found %d orphan objects (%d bytes)\n", len(orphans), total)
+	return nil
+}
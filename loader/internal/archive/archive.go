@@ -0,0 +1,181 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IsArchive returns true if the file name has the extension of a supported archive format.
+func IsArchive(name string) bool {
+	return format(name) != ""
+}
+
+func format(name string) string {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, ".tar"):
+		return "tar"
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return "tar.gz"
+	case strings.HasSuffix(name, ".zip"):
+		return "zip"
+	default:
+		return ""
+	}
+}
+
+// Extract extracts the archive at archivePath into destDir. The format is determined by the extension.
+//
+// Only regular files and directories are extracted. Entries whose paths do not satisfy match are skipped.
+// An error is returned if an entry has a path that escapes destDir.
+func Extract(archivePath, destDir string, match func(path string) bool) error {
+	switch format(archivePath) {
+	case "tar":
+		f, err := os.Open(archivePath)
+		if err != nil {
+			return fmt.Errorf("open archive: %s", err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		return extractTar(f, destDir, match)
+	case "tar.gz":
+		f, err := os.Open(archivePath)
+		if err != nil {
+			return fmt.Errorf("open archive: %s", err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
+		gr, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("open gzip: %s", err)
+		}
+		defer func() {
+			_ = gr.Close()
+		}()
+		return extractTar(gr, destDir, match)
+	case "zip":
+		return extractZip(archivePath, destDir, match)
+	default:
+		return fmt.Errorf("unsupported archive: %s", archivePath)
+	}
+}
+
+func extractTar(r io.Reader, destDir string, match func(path string) bool) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read tar: %s", err)
+		}
+
+		var isDir bool
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			isDir = true
+		case tar.TypeReg:
+		default:
+			// Skip symlinks, hard links, devices, etc.
+			continue
+		}
+		if err := extractEntry(hdr.Name, isDir, tr, destDir, match); err != nil {
+			return err
+		}
+	}
+}
+
+func extractZip(archivePath, destDir string, match func(path string) bool) error {
+	zr, err := zip.OpenReader(archivePath)
+	if err != nil {
+		return fmt.Errorf("open zip: %s", err)
+	}
+	defer func() {
+		_ = zr.Close()
+	}()
+
+	for _, f := range zr.File {
+		mode := f.Mode()
+		if !mode.IsDir() && !mode.IsRegular() {
+			continue
+		}
+		if err := func() error {
+			r, err := f.Open()
+			if err != nil {
+				return fmt.Errorf("open %s: %s", f.Name, err)
+			}
+			defer func() {
+				_ = r.Close()
+			}()
+			return extractEntry(f.Name, mode.IsDir(), r, destDir, match)
+		}(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractEntry writes a single archive entry under destDir.
+func extractEntry(name string, isDir bool, r io.Reader, destDir string, match func(path string) bool) error {
+	p, err := cleanPath(name)
+	if err != nil {
+		return err
+	}
+	if p == "" {
+		// The root directory.
+		return nil
+	}
+	destPath := filepath.Join(destDir, filepath.FromSlash(p))
+	if isDir {
+		if err := os.MkdirAll(destPath, 0755); err != nil {
+			return fmt.Errorf("create directory: %s", err)
+		}
+		return nil
+	}
+	if !match(p) {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(destPath), 0755); err != nil {
+		return fmt.Errorf("create directory: %s", err)
+	}
+	f, err := os.OpenFile(destPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("create file: %s", err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("extract %s: %s", p, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close file: %s", err)
+	}
+	return nil
+}
+
+// cleanPath returns the slash-separated relative path of an archive entry. An error is returned if the path
+// is absolute or escapes the root of the archive.
+func cleanPath(name string) (string, error) {
+	name = strings.ReplaceAll(name, "\\", "/")
+	if path.IsAbs(name) {
+		return "", fmt.Errorf("invalid path %q in the archive", name)
+	}
+	p := path.Clean(name)
+	if p == ".." || strings.HasPrefix(p, "../") {
+		return "", fmt.Errorf("invalid path %q in the archive", name)
+	}
+	if p == "." {
+		return "", nil
+	}
+	return p, nil
+}
@@ -0,0 +1,197 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type entry struct {
+	name    string
+	content string
+	symlink bool
+}
+
+func writeTar(t *testing.T, path string, gz bool, entries []entry) {
+	var buf bytes.Buffer
+	var w *tar.Writer
+	var gw *gzip.Writer
+	if gz {
+		gw = gzip.NewWriter(&buf)
+		w = tar.NewWriter(gw)
+	} else {
+		w = tar.NewWriter(&buf)
+	}
+	for _, e := range entries {
+		hdr := &tar.Header{
+			Name: e.name,
+			Mode: 0644,
+			Size: int64(len(e.content)),
+		}
+		switch {
+		case e.symlink:
+			hdr.Typeflag = tar.TypeSymlink
+			hdr.Linkname = e.content
+			hdr.Size = 0
+		case strings.HasSuffix(e.name, "/"):
+			hdr.Typeflag = tar.TypeDir
+			hdr.Mode = 0755
+		default:
+			hdr.Typeflag = tar.TypeReg
+		}
+		assert.NoError(t, w.WriteHeader(hdr))
+		if hdr.Typeflag == tar.TypeReg {
+			_, err := w.Write([]byte(e.content))
+			assert.NoError(t, err)
+		}
+	}
+	assert.NoError(t, w.Close())
+	if gw != nil {
+		assert.NoError(t, gw.Close())
+	}
+	assert.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+}
+
+func writeZip(t *testing.T, path string, entries []entry) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, e := range entries {
+		fw, err := w.Create(e.name)
+		assert.NoError(t, err)
+		_, err = fw.Write([]byte(e.content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, w.Close())
+	assert.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+}
+
+func listFiles(t *testing.T, dir string) map[string]string {
+	got := map[string]string{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		got[filepath.ToSlash(rel)] = string(b)
+		return nil
+	})
+	assert.NoError(t, err)
+	return got
+}
+
+func TestIsArchive(t *testing.T) {
+	tcs := []struct {
+		name string
+		want bool
+	}{
+		{name: "model.tar", want: true},
+		{name: "model.tar.gz", want: true},
+		{name: "model.TGZ", want: true},
+		{name: "model.zip", want: true},
+		{name: "model.gguf", want: false},
+		{name: "model.gz", want: false},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsArchive(tc.name))
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	entries := []entry{
+		{name: "model/"},
+		{name: "model/config.json", content: "{}"},
+		{name: "model/model.safetensors", content: "weights"},
+		{name: "./README.md", content: "readme"},
+		{name: "model/link", content: "/etc/passwd", symlink: true},
+	}
+	want := map[string]string{
+		"model/config.json":       "{}",
+		"model/model.safetensors": "weights",
+		"README.md":               "readme",
+	}
+
+	tcs := []struct {
+		name  string
+		write func(t *testing.T, path string)
+	}{
+		{
+			name: "model.tar",
+			write: func(t *testing.T, path string) {
+				writeTar(t, path, false, entries)
+			},
+		},
+		{
+			name: "model.tar.gz",
+			write: func(t *testing.T, path string) {
+				writeTar(t, path, true, entries)
+			},
+		},
+		{
+			name: "model.zip",
+			write: func(t *testing.T, path string) {
+				var es []entry
+				for _, e := range entries {
+					if !e.symlink {
+						es = append(es, e)
+					}
+				}
+				writeZip(t, path, es)
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			archivePath := filepath.Join(t.TempDir(), tc.name)
+			tc.write(t, archivePath)
+
+			destDir := t.TempDir()
+			err := Extract(archivePath, destDir, func(string) bool { return true })
+			assert.NoError(t, err)
+			assert.Equal(t, want, listFiles(t, destDir))
+
+			// Only extract the files that match.
+			destDir = t.TempDir()
+			err = Extract(archivePath, destDir, func(p string) bool { return p != "README.md" })
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{
+				"model/config.json":       "{}",
+				"model/model.safetensors": "weights",
+			}, listFiles(t, destDir))
+		})
+	}
+}
+
+func TestExtract_InvalidPath(t *testing.T) {
+	for _, name := range []string{"../evil", "/etc/evil", "model/../../evil"} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			archivePath := filepath.Join(dir, "model.tar")
+			writeTar(t, archivePath, false, []entry{{name: name, content: "evil"}})
+
+			destDir := filepath.Join(dir, "dest")
+			err := Extract(archivePath, destDir, func(string) bool { return true })
+			assert.Error(t, err)
+			_, err = os.Stat(filepath.Join(dir, "evil"))
+			assert.True(t, os.IsNotExist(err))
+		})
+	}
+}
@@ -2,15 +2,21 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/llmariner/cluster-manager/pkg/status"
 	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
 	"gopkg.in/yaml.v3"
 )
 
+// checksumRE matches a SHA-256 checksum of a model file.
+var checksumRE = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
 // AssumeRoleConfig is the assume role configuration.
 type AssumeRoleConfig struct {
 	RoleARN    string `yaml:"roleArn"`
@@ -69,8 +75,10 @@ func (c *ObjectStoreConfig) validate() error {
 }
 
 // HuggingFaceDownloaderConfig is the Hugging Face downloader configuration.
+//
+// The Hub endpoint and the access token are taken from the HF_ENDPOINT and HUGGING_FACE_HUB_TOKEN
+// environment variables.
 type HuggingFaceDownloaderConfig struct {
-	CacheDir string `yaml:"cacheDir"`
 }
 
 // S3DownloaderConfig is the S3 downloader configuration.
@@ -87,9 +95,72 @@ type S3DownloaderConfig struct {
 
 // OllamaDownloaderConfig is the Ollama downloader configuration.
 type OllamaDownloaderConfig struct {
+	// Insecure is true if the registry is accessed over HTTP instead of HTTPS.
+	Insecure bool `yaml:"insecure"`
+
+	// Port is no longer used as models are pulled without running an Ollama server.
+	//
+	// Deprecated: Remove the field.
 	Port int `yaml:"port"`
 }
 
+// OCIAuthConfig is the configuration of the credentials used to log in to OCI registries.
+type OCIAuthConfig struct {
+	Username string `yaml:"username"`
+	// PasswordEnvName is the name of the environment variable that holds the password or the access token.
+	PasswordEnvName string `yaml:"passwordEnvName"`
+}
+
+func (c *OCIAuthConfig) validate() error {
+	if c.Username == "" {
+		return fmt.Errorf("username must be set")
+	}
+	if c.PasswordEnvName == "" {
+		return fmt.Errorf("passwordEnvName must be set")
+	}
+	return nil
+}
+
+// OCIDownloaderConfig is the OCI registry downloader configuration.
+type OCIDownloaderConfig struct {
+	// Insecure is true if registries are accessed over HTTP instead of HTTPS.
+	Insecure bool `yaml:"insecure"`
+
+	// Auth is the credentials for registries. Registries are accessed anonymously if not set.
+	Auth *OCIAuthConfig `yaml:"auth"`
+}
+
+// HTTPDownloaderConfig is the HTTP(S) URL downloader configuration.
+type HTTPDownloaderConfig struct {
+	// AllowedHosts is the list of the hosts that models can be downloaded from. A host starting with "*."
+	// matches its subdomains. It must be set when the HTTP downloader is enabled.
+	AllowedHosts []string `yaml:"allowedHosts"`
+	// Insecure is true if models can also be downloaded over HTTP. Only HTTPS is allowed by default.
+	Insecure bool `yaml:"insecure"`
+	// AllowPrivateNetworks is true if models can be downloaded from loopback, private and link-local
+	// addresses. The addresses are checked after the host names are resolved. The proxy configured
+	// with the HTTP_PROXY and HTTPS_PROXY environment variables is used only if this is true.
+	AllowPrivateNetworks bool `yaml:"allowPrivateNetworks"`
+}
+
+// IsAllowedHost returns true if the host matches one of the allowed hosts.
+func (c *HTTPDownloaderConfig) IsAllowedHost(host string) bool {
+	host = strings.ToLower(host)
+	for _, h := range c.AllowedHosts {
+		h = strings.ToLower(h)
+		if suffix, ok := strings.CutPrefix(h, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+			continue
+		}
+		if host == h {
+			return true
+		}
+	}
+	return false
+}
+
 // DownloaderKind is the downloader kind.
 type DownloaderKind string
 
@@ -100,56 +171,211 @@ const (
 	DownloaderKindHuggingFace DownloaderKind = "huggingFace"
 	// DownloaderKindOllama is the Ollama downloader kind.
 	DownloaderKindOllama DownloaderKind = "ollama"
+	// DownloaderKindOCI is the OCI registry downloader kind.
+	DownloaderKindOCI DownloaderKind = "oci"
+	// DownloaderKindHTTP is the HTTP(S) URL downloader kind.
+	DownloaderKindHTTP DownloaderKind = "http"
 )
 
+// SourceRepository returns the source repository that the downloader kind downloads models from.
+// SOURCE_REPOSITORY_UNSPECIFIED is returned for an unknown kind.
+func (k DownloaderKind) SourceRepository() v1.SourceRepository {
+	switch k {
+	case DownloaderKindS3:
+		return v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE
+	case DownloaderKindHuggingFace:
+		return v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE
+	case DownloaderKindOllama:
+		return v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA
+	case DownloaderKindOCI:
+		return v1.SourceRepository_SOURCE_REPOSITORY_OCI
+	case DownloaderKindHTTP:
+		return v1.SourceRepository_SOURCE_REPOSITORY_HTTP
+	default:
+		return v1.SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
+	}
+}
+
 // DownloaderConfig is the downloader configuration.
 type DownloaderConfig struct {
+	// Kind is the default kind. Models listed in the configuration are downloaded with this kind
+	// unless they specify their source.
 	Kind DownloaderKind `yaml:"kind"`
+	// Kinds is the list of additional kinds that the loader supports. The loader only acquires the models
+	// from the source repositories of Kind and Kinds.
+	Kinds []DownloaderKind `yaml:"kinds"`
 
 	HuggingFace HuggingFaceDownloaderConfig `yaml:"huggingFace"`
 	S3          S3DownloaderConfig          `yaml:"s3"`
 	Ollama      OllamaDownloaderConfig      `yaml:"ollama"`
+	OCI         OCIDownloaderConfig         `yaml:"oci"`
+	HTTP        HTTPDownloaderConfig        `yaml:"http"`
 }
 
-// validate validates the downloader configuration.
-func (c *DownloaderConfig) validate() error {
-	switch c.Kind {
-	case DownloaderKindS3:
-		if c.S3.Region == "" {
-			return fmt.Errorf("region must be set")
+// EnabledKinds returns the deduplicated list of Kind and Kinds.
+func (c *DownloaderConfig) EnabledKinds() []DownloaderKind {
+	var kinds []DownloaderKind
+	seen := map[DownloaderKind]bool{}
+	for _, k := range append([]DownloaderKind{c.Kind}, c.Kinds...) {
+		if seen[k] {
+			continue
 		}
-		if c.S3.Bucket == "" {
-			return fmt.Errorf("bucket must be set")
-		}
-		if c.S3.PathPrefix == "" {
-			return fmt.Errorf("pathPrefix must be set")
+		seen[k] = true
+		kinds = append(kinds, k)
+	}
+	return kinds
+}
+
+// IsEnabled returns true if the kind is in Kind or Kinds.
+func (c *DownloaderConfig) IsEnabled(kind DownloaderKind) bool {
+	for _, k := range c.EnabledKinds() {
+		if k == kind {
+			return true
 		}
-		if ar := c.S3.AssumeRole; ar != nil {
-			if err := ar.validate(); err != nil {
-				return fmt.Errorf("assumeRole: %s", err)
+	}
+	return false
+}
+
+// validate validates the downloader configuration.
+func (c *DownloaderConfig) validate() error {
+	if c.Kind == "" {
+		return fmt.Errorf("kind must be set")
+	}
+	for _, k := range c.EnabledKinds() {
+		switch k {
+		case DownloaderKindS3:
+			if c.S3.Region == "" {
+				return fmt.Errorf("region must be set")
 			}
+			if c.S3.Bucket == "" {
+				return fmt.Errorf("bucket must be set")
+			}
+			if c.S3.PathPrefix == "" {
+				return fmt.Errorf("pathPrefix must be set")
+			}
+			if ar := c.S3.AssumeRole; ar != nil {
+				if err := ar.validate(); err != nil {
+					return fmt.Errorf("assumeRole: %s", err)
+				}
+			}
+		case DownloaderKindHuggingFace:
+		case DownloaderKindOllama:
+		case DownloaderKindOCI:
+		case DownloaderKindHTTP:
+			if len(c.HTTP.AllowedHosts) == 0 {
+				return fmt.Errorf("http: allowedHosts must be set")
+			}
+		default:
+			return fmt.Errorf("unknown kind: %s", k)
 		}
-	case DownloaderKindHuggingFace:
-		if c.HuggingFace.CacheDir == "" {
-			return fmt.Errorf("cacheDir must be set")
-		}
-	case DownloaderKindOllama:
-		if c.Ollama.Port == 0 {
-			return fmt.Errorf("port must be set")
+	}
+
+	if a := c.OCI.Auth; a != nil {
+		if err := a.validate(); err != nil {
+			return fmt.Errorf("oci auth: %s", err)
 		}
-	default:
-		return fmt.Errorf("unknown kind: %s", c.Kind)
 	}
 
 	return nil
 }
 
+// FilePatternsConfig is the configuration of the patterns that select the model files to load.
+// See v1.FilePatterns for the syntax of the patterns.
+type FilePatternsConfig struct {
+	// IncludeFilePatterns is the list of patterns of the files to load. All files are loaded if empty.
+	IncludeFilePatterns []string `yaml:"includeFilePatterns"`
+	// ExcludeFilePatterns is the list of patterns of the files not to load.
+	ExcludeFilePatterns []string `yaml:"excludeFilePatterns"`
+}
+
+// FilePatterns returns the file patterns. It returns nil if no pattern is specified.
+func (c *FilePatternsConfig) FilePatterns() *v1.FilePatterns {
+	if len(c.IncludeFilePatterns) == 0 && len(c.ExcludeFilePatterns) == 0 {
+		return nil
+	}
+	return &v1.FilePatterns{
+		Include: c.IncludeFilePatterns,
+		Exclude: c.ExcludeFilePatterns,
+	}
+}
+
+func (c *FilePatternsConfig) validate() error {
+	if _, err := pathfilter.New(c.IncludeFilePatterns, c.ExcludeFilePatterns); err != nil {
+		return err
+	}
+	return nil
+}
+
+// BaseModelConfig is the configuration of a base model to load. It can be specified either
+// with the model ID (e.g., "google/gemma-2b") or with a mapping that has the ID and other fields.
+type BaseModelConfig struct {
+	ID string `yaml:"id"`
+	// Revision is the revision (a branch, a tag, or a commit SHA) of the Hugging Face repository.
+	// The default branch is used if not set.
+	Revision string `yaml:"revision"`
+	// URL is the HTTP(S) URL of the model file. It must be set when the HTTP downloader is used.
+	// .tar, .tar.gz, .tgz and .zip archives are unpacked.
+	URL string `yaml:"url"`
+	// Checksum is the expected checksum of the file at the URL in the form of "sha256:<hex>" with
+	// lowercase hex digits, which is the same form as the server accepts. The checksum is not verified
+	// if not set.
+	Checksum string `yaml:"checksum"`
+	// Source is the kind of the downloader that downloads the model. It must be one of the enabled kinds.
+	// The default kind of the downloader is used if not set.
+	Source DownloaderKind `yaml:"source"`
+
+	FilePatternsConfig `yaml:",inline"`
+}
+
+// SourceKind returns the kind of the downloader that downloads the model.
+func (c *BaseModelConfig) SourceKind(d DownloaderConfig) DownloaderKind {
+	if c.Source != "" {
+		return c.Source
+	}
+	return d.Kind
+}
+
+// UnmarshalYAML unmarshals the model ID or the mapping.
+func (c *BaseModelConfig) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.ScalarNode {
+		c.ID = n.Value
+		return nil
+	}
+	type raw BaseModelConfig
+	return n.Decode((*raw)(c))
+}
+
+// validate validates the base model configuration.
+func (c *BaseModelConfig) validate() error {
+	if c.ID == "" {
+		return fmt.Errorf("id must be set")
+	}
+	if c.URL != "" {
+		u, err := url.Parse(c.URL)
+		if err != nil {
+			return fmt.Errorf("invalid url: %s", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("url must be an http or https URL")
+		}
+	}
+	if c.Checksum != "" && !checksumRE.MatchString(c.Checksum) {
+		return fmt.Errorf("checksum must be in the form of \"sha256:<hex>\" with lowercase hex digits")
+	}
+	if err := c.FilePatternsConfig.validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ModelConfig is the configuration for non-base-models.
 type ModelConfig struct {
 	Model            string `yaml:"model"`
 	BaseModel        string `yaml:"baseModel"`
 	AdapterType      string `yaml:"adapterType"`
 	QuantizationType string `yaml:"quantizationType"`
+
+	FilePatternsConfig `yaml:",inline"`
 }
 
 // validate validates the model configuration.
@@ -174,6 +400,9 @@ func (c *ModelConfig) validate() error {
 			return fmt.Errorf("unsupported quantization type: %s", c.QuantizationType)
 		}
 	}
+	if err := c.FilePatternsConfig.validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -197,20 +426,33 @@ type Config struct {
 	ObjectStore ObjectStoreConfig `yaml:"objectStore"`
 
 	// BaseModels is the list of base models to load. Currently each model follows Hugging Face's model format.
-	BaseModels []string `yaml:"baseModels"`
+	BaseModels []BaseModelConfig `yaml:"baseModels"`
 
 	// Models is the list of fine-tuned or quantized models to load. Currently each model follows Hugging Face's model format.
 	Models []ModelConfig `yaml:"models"`
 
 	ModelLoadInterval time.Duration `yaml:"modelLoadInterval"`
 
+	// ReconcileInterval is the interval of checking that the files of the loaded models exist in the object store.
+	// The check is disabled if not set.
+	ReconcileInterval time.Duration `yaml:"reconcileInterval"`
+
 	// RunOnce is set to true when models are loaded only once.
 	RunOnce bool `yaml:"runOnce"`
 
 	Downloader DownloaderConfig `yaml:"downloader"`
 
+	// Concurrency is the maximum number of files that are downloaded or uploaded in parallel.
+	// Files are transferred one at a time if not set.
+	Concurrency int `yaml:"concurrency"`
+
 	ModelManagerServerWorkerServiceAddr string `yaml:"modelManagerServerWorkerServiceAddr"`
 
+	// ClusterID is the ID of the cluster where the loader runs. The server only acquires the models that can
+	// be allocated to the cluster. It is used only if the server does not authenticate workers, as the server
+	// otherwise identifies the cluster from the cluster registration key.
+	ClusterID string `yaml:"clusterId"`
+
 	ComponentStatusSender status.Config `yaml:"componentStatusSender"`
 
 	Worker WorkerConfig `yaml:"worker"`
@@ -220,12 +462,33 @@ type Config struct {
 
 // Validate validates the configuration.
 func (c *Config) Validate() error {
+	for _, m := range c.BaseModels {
+		if err := m.validate(); err != nil {
+			return fmt.Errorf("baseModel: %s", err)
+		}
+	}
 	for _, m := range c.Models {
 		if err := m.validate(); err != nil {
 			return fmt.Errorf("model: %s", err)
 		}
 	}
 
+	for _, m := range c.BaseModels {
+		kind := m.SourceKind(c.Downloader)
+		if !c.Downloader.IsEnabled(kind) {
+			return fmt.Errorf("baseModel: source %q of %s is not enabled in the downloader", kind, m.ID)
+		}
+		if kind != DownloaderKindHTTP {
+			continue
+		}
+		if m.URL == "" {
+			return fmt.Errorf("baseModel: url must be set for %s", m.ID)
+		}
+		if u, err := url.Parse(m.URL); err == nil && !c.Downloader.HTTP.IsAllowedHost(u.Hostname()) {
+			return fmt.Errorf("baseModel: host of %s is not in the allowed hosts of the http downloader", m.ID)
+		}
+	}
+
 	if !c.RunOnce && c.ModelLoadInterval == 0 {
 		return fmt.Errorf("modelloadInterval must be set")
 	}
@@ -238,6 +501,14 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("downloader: %s", err)
 	}
 
+	if c.ReconcileInterval < 0 {
+		return fmt.Errorf("reconcileInterval must be non-negative")
+	}
+
+	if c.Concurrency < 0 {
+		return fmt.Errorf("concurrency must be non-negative")
+	}
+
 	if err := c.ComponentStatusSender.Validate(); err != nil {
 		return fmt.Errorf("componentStatusSender: %s", err)
 	}
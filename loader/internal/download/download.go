@@ -0,0 +1,256 @@
+package download
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/go-logr/logr"
+)
+
+const defaultAttempts = 5
+
+// File is a file to download.
+type File struct {
+	URL string
+	// Header is the header added to the requests.
+	Header http.Header
+	// Size is the expected size of the file. It is not verified if zero.
+	Size int64
+	// SHA256 is the expected hex-encoded SHA-256 of the file. It is not verified if empty.
+	SHA256 string
+}
+
+// New returns a new Downloader.
+func New(httpClient *http.Client, log logr.Logger) *Downloader {
+	return &Downloader{
+		HTTPClient: httpClient,
+		Attempts:   defaultAttempts,
+		RetryDelay: time.Second,
+		log:        log,
+	}
+}
+
+// Downloader downloads files over HTTP. Interrupted downloads are resumed with range requests.
+type Downloader struct {
+	HTTPClient *http.Client
+	// Attempts is the maximum number of attempts to download a file.
+	Attempts uint
+	// RetryDelay is the initial delay before retrying a failed download.
+	RetryDelay time.Duration
+	// CheckResponse returns an error if the response is not successful. Errors wrapped with
+	// retry.Unrecoverable are not retried. A default check is used if nil.
+	CheckResponse func(resp *http.Response) error
+
+	log logr.Logger
+}
+
+// Download downloads the file to destPath.
+//
+// The content is first written to incompletePath so that a partially downloaded file is never seen at destPath.
+// If incompletePath already has content (e.g., from a previous failed attempt), the download resumes from there.
+// The size and the SHA-256 of the file are verified before the file is moved to destPath.
+func (d *Downloader) Download(ctx context.Context, f File, destPath, incompletePath string) error {
+	for _, p := range []string{destPath, incompletePath} {
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			return fmt.Errorf("create directory: %s", err)
+		}
+	}
+
+	if err := retry.Do(
+		func() error {
+			return d.downloadToFile(ctx, f, incompletePath)
+		},
+		retry.Context(ctx),
+		retry.Attempts(d.Attempts),
+		retry.Delay(d.RetryDelay),
+		retry.DelayType(retry.BackOffDelay),
+		retry.MaxDelay(30*time.Second),
+		retry.LastErrorOnly(true),
+		retry.OnRetry(func(n uint, err error) {
+			d.log.Error(err, "Failed to download the file. Retrying", "url", f.URL, "attempt", n+1)
+		}),
+	); err != nil {
+		return err
+	}
+
+	if err := os.Rename(incompletePath, destPath); err != nil {
+		return fmt.Errorf("rename: %s", err)
+	}
+	return nil
+}
+
+// downloadToFile downloads the content from the URL and appends it to the existing content of the file.
+func (d *Downloader) downloadToFile(ctx context.Context, f File, path string) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return retry.Unrecoverable(fmt.Errorf("open file: %s", err))
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	var h hash.Hash
+	if f.SHA256 != "" {
+		h = sha256.New()
+	}
+
+	// Resume from the existing content. The existing content is hashed so that the whole file can be verified.
+	offset, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return retry.Unrecoverable(fmt.Errorf("seek: %s", err))
+	}
+	if f.Size > 0 && offset > f.Size {
+		// The content is not from this file. Start over.
+		offset = 0
+	}
+	if offset > 0 && offset == f.Size {
+		// The previous attempt completed the download, but failed before the file was moved.
+		if h != nil {
+			if _, err := io.Copy(h, io.NewSectionReader(file, 0, offset)); err != nil {
+				return retry.Unrecoverable(fmt.Errorf("hash content: %s", err))
+			}
+		}
+		return d.verify(file, h, f, offset)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.URL, nil)
+	if err != nil {
+		return retry.Unrecoverable(fmt.Errorf("create request: %s", err))
+	}
+	for k, vs := range f.Header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+	resp, err := d.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		if offset == 0 {
+			return retry.Unrecoverable(fmt.Errorf("unexpected partial content"))
+		}
+		d.log.V(1).Info("Resuming the download", "url", f.URL, "offset", offset)
+	case http.StatusOK:
+		// The server ignored the range request.
+		offset = 0
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The existing content is not a prefix of the file. Start over in the next attempt.
+		if err := file.Truncate(0); err != nil {
+			return retry.Unrecoverable(fmt.Errorf("truncate: %s", err))
+		}
+		return fmt.Errorf("unexpected status %q", resp.Status)
+	default:
+		check := CheckResponse
+		if d.CheckResponse != nil {
+			check = d.CheckResponse
+		}
+		if err := check(resp); err != nil {
+			return err
+		}
+		return retry.Unrecoverable(fmt.Errorf("unexpected status %q", resp.Status))
+	}
+
+	if h != nil {
+		if _, err := io.Copy(h, io.NewSectionReader(file, 0, offset)); err != nil {
+			return retry.Unrecoverable(fmt.Errorf("hash existing content: %s", err))
+		}
+	}
+	if err := file.Truncate(offset); err != nil {
+		return retry.Unrecoverable(fmt.Errorf("truncate: %s", err))
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return retry.Unrecoverable(fmt.Errorf("seek: %s", err))
+	}
+
+	w := io.Writer(file)
+	if h != nil {
+		w = io.MultiWriter(file, h)
+	}
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		// Keep the content so that the next attempt resumes from there.
+		return fmt.Errorf("read body: %w", err)
+	}
+	return d.verify(file, h, f, offset+n)
+}
+
+// verify verifies the size and the SHA-256 of the downloaded file. h holds the hash of the entire content
+// and is nil if the SHA-256 is not verified.
+func (d *Downloader) verify(file *os.File, h hash.Hash, f File, size int64) error {
+	// Remove the content on verification failure so that the next download starts over.
+	fail := func(err error) error {
+		if terr := file.Truncate(0); terr != nil {
+			d.log.Error(terr, "Failed to truncate the file", "path", file.Name())
+		}
+		return retry.Unrecoverable(err)
+	}
+
+	if f.Size > 0 && size < f.Size {
+		// The connection was closed before all the content was sent. Resume in the next attempt.
+		return fmt.Errorf("incomplete content: got %d bytes, want %d bytes", size, f.Size)
+	}
+	if f.Size > 0 && size > f.Size {
+		return fail(fmt.Errorf("size mismatch: got %d bytes, want %d bytes", size, f.Size))
+	}
+	if h == nil {
+		return nil
+	}
+	if got := hex.EncodeToString(h.Sum(nil)); got != f.SHA256 {
+		return fail(fmt.Errorf("sha256 mismatch: got %s, want %s", got, f.SHA256))
+	}
+	return nil
+}
+
+// CheckResponse returns an error if the response is not successful. Client errors other than
+// 429 (Too Many Requests) are not retried.
+func CheckResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err := &StatusError{
+		StatusCode: resp.StatusCode,
+		Err:        fmt.Errorf("unexpected status %q", resp.Status),
+	}
+	if !err.IsTransient() {
+		return retry.Unrecoverable(err)
+	}
+	return err
+}
+
+// StatusError is an error caused by an unsuccessful response.
+type StatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *StatusError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+// IsTransient returns true if the request might succeed later, i.e., the status is a server error or
+// 429 (Too Many Requests).
+func (e *StatusError) IsTransient() bool {
+	return e.StatusCode >= 500 || e.StatusCode == http.StatusTooManyRequests
+}
@@ -0,0 +1,139 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownload(t *testing.T) {
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	sum := sha256.Sum256(content)
+	digest := hex.EncodeToString(sum[:])
+
+	tcs := []struct {
+		name string
+		// ignoreRange is true if the server ignores range requests.
+		ignoreRange bool
+		notFound    bool
+		// existing is the content of the incomplete file before the download.
+		existing     []byte
+		sha256       string
+		wantRange    []string
+		wantRequests int
+		wantErr      bool
+	}{
+		{
+			name:         "verified",
+			sha256:       digest,
+			wantRequests: 1,
+		},
+		{
+			name:         "not verified",
+			wantRequests: 1,
+		},
+		{
+			name:         "resume",
+			existing:     content[:20],
+			sha256:       digest,
+			wantRange:    []string{"bytes=20-"},
+			wantRequests: 1,
+		},
+		{
+			name:         "range ignored",
+			ignoreRange:  true,
+			existing:     content[:20],
+			sha256:       digest,
+			wantRange:    []string{"bytes=20-"},
+			wantRequests: 1,
+		},
+		{
+			name:         "complete file",
+			existing:     content,
+			sha256:       digest,
+			wantRequests: 0,
+		},
+		{
+			name:         "sha256 mismatch",
+			sha256:       "0000",
+			wantRequests: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "not found",
+			notFound:     true,
+			wantRequests: 1,
+			wantErr:      true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				mu        sync.Mutex
+				ranges    []string
+				nRequests int
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				nRequests++
+				if rg := r.Header.Get("Range"); rg != "" {
+					ranges = append(ranges, rg)
+				}
+				mu.Unlock()
+
+				if tc.notFound {
+					http.NotFound(w, r)
+					return
+				}
+				if tc.ignoreRange {
+					_, _ = w.Write(content)
+					return
+				}
+				http.ServeContent(w, r, "file", time.Time{}, bytes.NewReader(content))
+			}))
+			defer srv.Close()
+
+			dir := t.TempDir()
+			destPath := filepath.Join(dir, "file")
+			incompletePath := filepath.Join(dir, "cache", "file.incomplete")
+			if tc.existing != nil {
+				err := os.MkdirAll(filepath.Dir(incompletePath), 0755)
+				assert.NoError(t, err)
+				err = os.WriteFile(incompletePath, tc.existing, 0644)
+				assert.NoError(t, err)
+			}
+
+			d := New(http.DefaultClient, testr.New(t))
+			d.RetryDelay = time.Millisecond
+			err := d.Download(context.Background(), File{
+				URL:    srv.URL,
+				Size:   int64(len(content)),
+				SHA256: tc.sha256,
+			}, destPath, incompletePath)
+			assert.Equal(t, tc.wantRequests, nRequests)
+			if tc.wantErr {
+				assert.Error(t, err)
+				_, err := os.Stat(destPath)
+				assert.True(t, os.IsNotExist(err))
+				return
+			}
+			assert.NoError(t, err)
+
+			got, err := os.ReadFile(destPath)
+			assert.NoError(t, err)
+			assert.Equal(t, content, got)
+			assert.Equal(t, tc.wantRange, ranges)
+		})
+	}
+}
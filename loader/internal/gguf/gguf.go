@@ -0,0 +1,384 @@
+package gguf
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+)
+
+const (
+	magic = "GGUF"
+
+	// maxStringLength is the maximum length of a string in the header. Chat templates are the longest strings
+	// in practice, and they are much shorter than this.
+	maxStringLength = 16 << 20
+	// maxArrayLength is the maximum number of elements of an array in the header. Vocabularies are the longest
+	// arrays in practice.
+	maxArrayLength = 16 << 20
+	// maxDimensions is the maximum number of dimensions of a tensor.
+	maxDimensions = 8
+	// maxCount is the maximum number of metadata key-value pairs or tensors.
+	maxCount = 1 << 20
+)
+
+type valueType uint32
+
+const (
+	typeUint8 valueType = iota
+	typeInt8
+	typeUint16
+	typeInt16
+	typeUint32
+	typeInt32
+	typeFloat32
+	typeBool
+	typeString
+	typeArray
+	typeUint64
+	typeInt64
+	typeFloat64
+)
+
+// fileTypes maps the values of "general.file_type" to the names of the quantization types.
+var fileTypes = map[uint64]string{
+	0:  "F32",
+	1:  "F16",
+	2:  "Q4_0",
+	3:  "Q4_1",
+	7:  "Q8_0",
+	8:  "Q5_0",
+	9:  "Q5_1",
+	10: "Q2_K",
+	11: "Q3_K_S",
+	12: "Q3_K_M",
+	13: "Q3_K_L",
+	14: "Q4_K_S",
+	15: "Q4_K_M",
+	16: "Q5_K_S",
+	17: "Q5_K_M",
+	18: "Q6_K",
+	19: "IQ2_XXS",
+	20: "IQ2_XS",
+	21: "Q2_K_S",
+	22: "IQ3_XS",
+	23: "IQ3_XXS",
+	24: "IQ1_S",
+	25: "IQ4_NL",
+	26: "IQ3_S",
+	27: "IQ3_M",
+	28: "IQ2_S",
+	29: "IQ2_M",
+	30: "IQ4_XS",
+	31: "IQ1_M",
+	32: "BF16",
+	36: "TQ1_0",
+	37: "TQ2_0",
+}
+
+// Metadata is the metadata of a model extracted from the header of a GGUF file.
+type Metadata struct {
+	// Architecture is the architecture of the model (e.g., "llama").
+	Architecture string
+	// ParameterCount is the number of the parameters. It is zero if the model is split into multiple files
+	// as the other files are not parsed.
+	ParameterCount int64
+	// ContextLength is the context length that the model was trained with.
+	ContextLength int64
+	// QuantizationType is the type of the most of the tensors (e.g., "Q4_K_M").
+	QuantizationType string
+	// Tokenizer is the model of the tokenizer (e.g., "gpt2").
+	Tokenizer string
+	// ChatTemplate is the Jinja template of the chat messages.
+	ChatTemplate string
+}
+
+// ParseFile parses the header of the GGUF file at the path.
+func ParseFile(path string) (*Metadata, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return Parse(f)
+}
+
+// Parse parses the header of a GGUF file. Only the header is read, not the tensor data.
+func Parse(r io.Reader) (*Metadata, error) {
+	p := &parser{r: bufio.NewReader(r)}
+
+	var m [4]byte
+	if _, err := io.ReadFull(p.r, m[:]); err != nil {
+		return nil, fmt.Errorf("read magic: %s", err)
+	}
+	if string(m[:]) != magic {
+		return nil, fmt.Errorf("not a GGUF file")
+	}
+
+	version, err := p.readUint32()
+	if err != nil {
+		return nil, fmt.Errorf("read version: %s", err)
+	}
+	switch version {
+	case 1:
+		// Counts and lengths are 32-bit in version 1.
+		p.v1 = true
+	case 2, 3:
+	default:
+		return nil, fmt.Errorf("unsupported GGUF version %d", version)
+	}
+
+	numTensors, err := p.readCount()
+	if err != nil {
+		return nil, fmt.Errorf("read tensor count: %s", err)
+	}
+	numKVs, err := p.readCount()
+	if err != nil {
+		return nil, fmt.Errorf("read metadata count: %s", err)
+	}
+
+	kvs := map[string]interface{}{}
+	for i := uint64(0); i < numKVs; i++ {
+		key, err := p.readString()
+		if err != nil {
+			return nil, fmt.Errorf("read metadata key: %s", err)
+		}
+		t, err := p.readUint32()
+		if err != nil {
+			return nil, fmt.Errorf("read type of %q: %s", key, err)
+		}
+		v, err := p.readValue(valueType(t))
+		if err != nil {
+			return nil, fmt.Errorf("read value of %q: %s", key, err)
+		}
+		kvs[key] = v
+	}
+
+	var numParams int64
+	for i := uint64(0); i < numTensors; i++ {
+		n, err := p.readTensorInfo()
+		if err != nil {
+			return nil, fmt.Errorf("read tensor info: %s", err)
+		}
+		numParams += n
+	}
+
+	md := &Metadata{
+		Architecture:   stringValue(kvs["general.architecture"]),
+		ParameterCount: numParams,
+		Tokenizer:      stringValue(kvs["tokenizer.ggml.model"]),
+		ChatTemplate:   stringValue(kvs["tokenizer.chat_template"]),
+	}
+	if n, ok := uintValue(kvs["split.count"]); ok && n > 1 {
+		md.ParameterCount = 0
+	}
+	if md.Architecture != "" {
+		if n, ok := uintValue(kvs[md.Architecture+".context_length"]); ok {
+			md.ContextLength = int64(n)
+		}
+	}
+	if n, ok := uintValue(kvs["general.file_type"]); ok {
+		if name, ok := fileTypes[n]; ok {
+			md.QuantizationType = name
+		} else {
+			md.QuantizationType = fmt.Sprintf("UNKNOWN_%d", n)
+		}
+	}
+	return md, nil
+}
+
+type parser struct {
+	r  *bufio.Reader
+	v1 bool
+}
+
+func (p *parser) readUint32() (uint32, error) {
+	var v uint32
+	err := binary.Read(p.r, binary.LittleEndian, &v)
+	return v, err
+}
+
+func (p *parser) readUint64() (uint64, error) {
+	var v uint64
+	err := binary.Read(p.r, binary.LittleEndian, &v)
+	return v, err
+}
+
+// readLength reads a length or a count, which is 32-bit in version 1 and 64-bit otherwise.
+func (p *parser) readLength() (uint64, error) {
+	if p.v1 {
+		v, err := p.readUint32()
+		return uint64(v), err
+	}
+	return p.readUint64()
+}
+
+func (p *parser) readCount() (uint64, error) {
+	n, err := p.readLength()
+	if err != nil {
+		return 0, err
+	}
+	if n > maxCount {
+		return 0, fmt.Errorf("count %d is too large", n)
+	}
+	return n, nil
+}
+
+func (p *parser) readString() (string, error) {
+	n, err := p.readLength()
+	if err != nil {
+		return "", err
+	}
+	if n > maxStringLength {
+		return "", fmt.Errorf("string length %d is too large", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(p.r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (p *parser) skipString() error {
+	n, err := p.readLength()
+	if err != nil {
+		return err
+	}
+	if n > maxStringLength {
+		return fmt.Errorf("string length %d is too large", n)
+	}
+	_, err = p.r.Discard(int(n))
+	return err
+}
+
+// readValue reads a value of the type. Arrays are skipped and returned as nil as none of them is used.
+func (p *parser) readValue(t valueType) (interface{}, error) {
+	switch t {
+	case typeUint8, typeInt8, typeBool:
+		b, err := p.r.ReadByte()
+		return uint64(b), err
+	case typeUint16, typeInt16:
+		var v uint16
+		err := binary.Read(p.r, binary.LittleEndian, &v)
+		return uint64(v), err
+	case typeUint32:
+		v, err := p.readUint32()
+		return uint64(v), err
+	case typeInt32:
+		v, err := p.readUint32()
+		return int64(int32(v)), err
+	case typeFloat32:
+		_, err := p.readUint32()
+		return nil, err
+	case typeUint64:
+		return p.readUint64()
+	case typeInt64:
+		v, err := p.readUint64()
+		return int64(v), err
+	case typeFloat64:
+		_, err := p.readUint64()
+		return nil, err
+	case typeString:
+		return p.readString()
+	case typeArray:
+		return nil, p.skipArray()
+	default:
+		return nil, fmt.Errorf("unknown value type %d", t)
+	}
+}
+
+func (p *parser) skipArray() error {
+	t, err := p.readUint32()
+	if err != nil {
+		return err
+	}
+	n, err := p.readLength()
+	if err != nil {
+		return err
+	}
+	if n > maxArrayLength {
+		return fmt.Errorf("array length %d is too large", n)
+	}
+
+	var size int
+	switch valueType(t) {
+	case typeUint8, typeInt8, typeBool:
+		size = 1
+	case typeUint16, typeInt16:
+		size = 2
+	case typeUint32, typeInt32, typeFloat32:
+		size = 4
+	case typeUint64, typeInt64, typeFloat64:
+		size = 8
+	}
+	if size > 0 {
+		_, err := p.r.Discard(int(n) * size)
+		return err
+	}
+
+	for i := uint64(0); i < n; i++ {
+		switch valueType(t) {
+		case typeString:
+			if err := p.skipString(); err != nil {
+				return err
+			}
+		case typeArray:
+			if err := p.skipArray(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown array element type %d", t)
+		}
+	}
+	return nil
+}
+
+// readTensorInfo reads the information of a tensor and returns the number of its elements.
+func (p *parser) readTensorInfo() (int64, error) {
+	if err := p.skipString(); err != nil {
+		return 0, err
+	}
+	numDims, err := p.readUint32()
+	if err != nil {
+		return 0, err
+	}
+	if numDims > maxDimensions {
+		return 0, fmt.Errorf("number of dimensions %d is too large", numDims)
+	}
+	n := int64(1)
+	for i := uint32(0); i < numDims; i++ {
+		d, err := p.readLength()
+		if err != nil {
+			return 0, err
+		}
+		n *= int64(d)
+	}
+	// Skip the type and the offset.
+	if _, err := p.readUint32(); err != nil {
+		return 0, err
+	}
+	if _, err := p.readUint64(); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func stringValue(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func uintValue(v interface{}) (uint64, bool) {
+	switch n := v.(type) {
+	case uint64:
+		return n, true
+	case int64:
+		if n >= 0 {
+			return uint64(n), true
+		}
+	}
+	return 0, false
+}
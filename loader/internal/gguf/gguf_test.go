@@ -0,0 +1,132 @@
+package gguf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// ggufWriter builds the header of a GGUF file for testing.
+type ggufWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *ggufWriter) write(v interface{}) {
+	_ = binary.Write(&w.buf, binary.LittleEndian, v)
+}
+
+func (w *ggufWriter) writeString(s string) {
+	w.write(uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+func (w *ggufWriter) writeKV(key string, t valueType, v interface{}) {
+	w.writeString(key)
+	w.write(uint32(t))
+	if s, ok := v.(string); ok {
+		w.writeString(s)
+		return
+	}
+	w.write(v)
+}
+
+func (w *ggufWriter) writeTensor(name string, dims ...uint64) {
+	w.writeString(name)
+	w.write(uint32(len(dims)))
+	for _, d := range dims {
+		w.write(d)
+	}
+	w.write(uint32(0))
+	w.write(uint64(0))
+}
+
+func newTestGGUF(splitCount uint16) []byte {
+	w := &ggufWriter{}
+	w.buf.WriteString("GGUF")
+	w.write(uint32(3))
+	w.write(uint64(2)) // tensors
+	numKVs := uint64(8)
+	if splitCount > 0 {
+		numKVs++
+	}
+	w.write(numKVs)
+
+	w.writeKV("general.architecture", typeString, "llama")
+	w.writeKV("general.file_type", typeUint32, uint32(15))
+	w.writeKV("llama.context_length", typeUint32, uint32(8192))
+	w.writeKV("llama.rope.freq_base", typeFloat32, float32(10000))
+	w.writeKV("tokenizer.ggml.model", typeString, "gpt2")
+	// The vocabulary is skipped.
+	w.writeString("tokenizer.ggml.tokens")
+	w.write(uint32(typeArray))
+	w.write(uint32(typeString))
+	w.write(uint64(3))
+	for _, s := range []string{"a", "b", "c"} {
+		w.writeString(s)
+	}
+	w.writeString("tokenizer.ggml.scores")
+	w.write(uint32(typeArray))
+	w.write(uint32(typeFloat32))
+	w.write(uint64(3))
+	w.write([]float32{0, 1, 2})
+	w.writeKV("tokenizer.chat_template", typeString, "{{ messages }}")
+	if splitCount > 0 {
+		w.writeKV("split.count", typeUint16, splitCount)
+	}
+
+	w.writeTensor("token_embd.weight", 4096, 32000)
+	w.writeTensor("output_norm.weight", 4096)
+	return w.buf.Bytes()
+}
+
+func TestParse(t *testing.T) {
+	got, err := Parse(bytes.NewReader(newTestGGUF(0)))
+	assert.NoError(t, err)
+	assert.Equal(t, &Metadata{
+		Architecture:     "llama",
+		ParameterCount:   4096*32000 + 4096,
+		ContextLength:    8192,
+		QuantizationType: "Q4_K_M",
+		Tokenizer:        "gpt2",
+		ChatTemplate:     "{{ messages }}",
+	}, got)
+}
+
+func TestParse_Split(t *testing.T) {
+	got, err := Parse(bytes.NewReader(newTestGGUF(3)))
+	assert.NoError(t, err)
+	assert.Equal(t, "llama", got.Architecture)
+	assert.Zero(t, got.ParameterCount)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tcs := []struct {
+		name string
+		data []byte
+	}{
+		{
+			name: "empty",
+			data: nil,
+		},
+		{
+			name: "not gguf",
+			data: []byte("GGML0000"),
+		},
+		{
+			name: "unsupported version",
+			data: append([]byte("GGUF"), 9, 0, 0, 0),
+		},
+		{
+			name: "truncated",
+			data: newTestGGUF(0)[:100],
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(bytes.NewReader(tc.data))
+			assert.Error(t, err)
+		})
+	}
+}
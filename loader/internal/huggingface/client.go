@@ -0,0 +1,216 @@
+package huggingface
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/go-logr/logr"
+	"github.com/llmariner/model-manager/loader/internal/download"
+)
+
+const (
+	// DefaultEndpoint is the endpoint of the public Hugging Face Hub.
+	DefaultEndpoint = "https://huggingface.co"
+
+	// DefaultRevision is the revision used when no revision is specified.
+	DefaultRevision = "main"
+)
+
+// NewClient returns a new client of the Hugging Face Hub. The token is used to access gated and private repositories.
+func NewClient(endpoint, token string, log logr.Logger) *Client {
+	return &Client{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		token:      token,
+		httpClient: http.DefaultClient,
+		retryDelay: time.Second,
+		log:        log,
+	}
+}
+
+// Client is a client of the Hugging Face Hub.
+type Client struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+	// retryDelay is the initial delay before retrying a failed download.
+	retryDelay time.Duration
+	log        logr.Logger
+}
+
+// LFS is the Git LFS metadata of a file.
+type LFS struct {
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// File is a file in a repository.
+type File struct {
+	Path string
+	Size int64
+	// LFS is set if the file is stored with Git LFS.
+	LFS *LFS
+}
+
+// RepoInfo is the information of a repository at a specific commit.
+type RepoInfo struct {
+	// SHA is the commit SHA that the requested revision is resolved to.
+	SHA   string
+	Files []File
+}
+
+// TotalSize returns the total size of the files.
+func (r *RepoInfo) TotalSize() int64 {
+	var size int64
+	for _, f := range r.Files {
+		size += f.Size
+	}
+	return size
+}
+
+type modelInfo struct {
+	SHA      string `json:"sha"`
+	Siblings []struct {
+		RFilename string `json:"rfilename"`
+		Size      int64  `json:"size"`
+		LFS       *LFS   `json:"lfs"`
+	} `json:"siblings"`
+}
+
+// GetRepoInfo returns the files of the repository at the given revision. The revision can be a branch, a tag,
+// or a commit SHA. The default branch is used if the revision is empty.
+func (c *Client) GetRepoInfo(ctx context.Context, repo, revision string) (*RepoInfo, error) {
+	if revision == "" {
+		revision = DefaultRevision
+	}
+	u := fmt.Sprintf("%s/api/models/%s/revision/%s?blobs=true", c.endpoint, escapePath(repo), url.PathEscape(revision))
+	req, err := c.newRequest(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get model info: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if err := checkResponse(resp, repo); err != nil {
+		return nil, fmt.Errorf("get model info: %w", err)
+	}
+
+	var info modelInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("decode model info: %s", err)
+	}
+	if info.SHA == "" {
+		return nil, fmt.Errorf("model info of %q at revision %q has no commit SHA", repo, revision)
+	}
+	ri := &RepoInfo{
+		SHA: info.SHA,
+	}
+	for _, s := range info.Siblings {
+		ri.Files = append(ri.Files, File{
+			Path: s.RFilename,
+			Size: s.Size,
+			LFS:  s.LFS,
+		})
+	}
+	return ri, nil
+}
+
+// DownloadFile downloads the file of the repository at the given commit to destPath.
+//
+// The content is first written to incompletePath so that a partially downloaded file is never seen at destPath.
+// If incompletePath already has content (e.g., from a previous failed attempt), the download resumes from there.
+// The SHA-256 of LFS files is verified before the file is moved to destPath.
+func (c *Client) DownloadFile(ctx context.Context, repo, sha string, f File, destPath, incompletePath string) error {
+	df := download.File{
+		URL:  fmt.Sprintf("%s/%s/resolve/%s/%s", c.endpoint, escapePath(repo), url.PathEscape(sha), escapePath(f.Path)),
+		Size: f.Size,
+	}
+	if c.token != "" {
+		df.Header = http.Header{"Authorization": []string{"Bearer " + c.token}}
+	}
+	if f.LFS != nil {
+		df.SHA256 = f.LFS.SHA256
+	}
+	if err := c.newDownloader(repo).Download(ctx, df, destPath, incompletePath); err != nil {
+		return fmt.Errorf("download %q: %w", f.Path, err)
+	}
+	return nil
+}
+
+// newDownloader returns a downloader that reports errors from the Hub with hints for the repository.
+func (c *Client) newDownloader(repo string) *download.Downloader {
+	d := download.New(c.httpClient, c.log)
+	d.RetryDelay = c.retryDelay
+	d.CheckResponse = func(resp *http.Response) error {
+		return checkResponse(resp, repo)
+	}
+	return d
+}
+
+func (c *Client) newRequest(ctx context.Context, u string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %s", err)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	return req, nil
+}
+
+// checkResponse returns an error if the response is not successful. Client errors are not retried.
+func checkResponse(resp *http.Response, repo string) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var msg string
+	// The Hub returns the reason of the error in the JSON body or in the header.
+	var body struct {
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 64*1024)).Decode(&body); err == nil && body.Error != "" {
+		msg = body.Error
+	} else {
+		msg = resp.Header.Get("X-Error-Message")
+	}
+
+	var err error
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		err = fmt.Errorf("access to %q is denied (%s): the repository might be gated or private; "+
+			"set HUGGING_FACE_HUB_TOKEN to a token that has access to it", repo, resp.Status)
+	case http.StatusNotFound:
+		err = fmt.Errorf("%q is not found (%s)", repo, resp.Status)
+	default:
+		err = fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	if msg != "" {
+		err = fmt.Errorf("%s: %s", err, msg)
+	}
+
+	serr := &download.StatusError{StatusCode: resp.StatusCode, Err: err}
+	if !serr.IsTransient() {
+		return retry.Unrecoverable(serr)
+	}
+	return serr
+}
+
+// escapePath escapes each segment of the slash-separated path.
+func escapePath(p string) string {
+	ss := strings.Split(p, "/")
+	for i, s := range ss {
+		ss[i] = url.PathEscape(s)
+	}
+	return strings.Join(ss, "/")
+}
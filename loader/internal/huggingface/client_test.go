@@ -0,0 +1,283 @@
+package huggingface
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testRepo = "google/gemma-2b"
+	testSHA  = "0123456789abcdef0123456789abcdef01234567"
+)
+
+// fakeHub is a stand-in of the Hugging Face Hub that serves a single repository.
+type fakeHub struct {
+	files map[string][]byte
+	// lfsFiles is the set of the files stored with LFS.
+	lfsFiles map[string]bool
+	// gated requires the token if true.
+	gated bool
+	// dropAfter is the number of bytes sent before the connection is dropped. The connection
+	// is dropped only once for each file.
+	dropAfter int
+	// corrupt is set to true to send content that does not match the SHA-256.
+	corrupt bool
+
+	mu            sync.Mutex
+	dropped       map[string]bool
+	rangeRequests []string
+}
+
+func (h *fakeHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.gated && r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"Access to model google/gemma-2b is restricted."}`))
+		return
+	}
+
+	infoPrefix := "/api/models/" + testRepo + "/revision/"
+	resolvePrefix := "/" + testRepo + "/resolve/" + testSHA + "/"
+	switch p := r.URL.Path; {
+	case strings.HasPrefix(p, infoPrefix):
+		if rev := strings.TrimPrefix(p, infoPrefix); rev != "main" && rev != testSHA {
+			http.NotFound(w, r)
+			return
+		}
+		h.serveInfo(w)
+	case strings.HasPrefix(p, resolvePrefix):
+		h.serveFile(w, r, strings.TrimPrefix(p, resolvePrefix))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *fakeHub) serveInfo(w http.ResponseWriter) {
+	type sibling struct {
+		RFilename string `json:"rfilename"`
+		Size      int64  `json:"size"`
+		LFS       *LFS   `json:"lfs,omitempty"`
+	}
+	info := struct {
+		SHA      string    `json:"sha"`
+		Siblings []sibling `json:"siblings"`
+	}{
+		SHA: testSHA,
+	}
+	for name, b := range h.files {
+		s := sibling{
+			RFilename: name,
+			Size:      int64(len(b)),
+		}
+		if h.lfsFiles[name] {
+			sum := sha256.Sum256(b)
+			s.LFS = &LFS{
+				SHA256: hex.EncodeToString(sum[:]),
+				Size:   int64(len(b)),
+			}
+		}
+		info.Siblings = append(info.Siblings, s)
+	}
+	_ = json.NewEncoder(w).Encode(info)
+}
+
+func (h *fakeHub) serveFile(w http.ResponseWriter, r *http.Request, name string) {
+	b, ok := h.files[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if h.corrupt {
+		b = bytes.ToUpper(b)
+	}
+
+	h.mu.Lock()
+	if rg := r.Header.Get("Range"); rg != "" {
+		h.rangeRequests = append(h.rangeRequests, rg)
+	}
+	drop := h.dropAfter > 0 && !h.dropped[name]
+	if drop {
+		h.dropped[name] = true
+	}
+	h.mu.Unlock()
+
+	if !drop {
+		http.ServeContent(w, r, name, time.Time{}, bytes.NewReader(b))
+		return
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(b)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b[:h.dropAfter])
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	// Abort the response so that the client sees an unexpected EOF.
+	panic(http.ErrAbortHandler)
+}
+
+func newTestClient(t *testing.T, h *fakeHub, token string) *Client {
+	if h.dropped == nil {
+		h.dropped = map[string]bool{}
+	}
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	c := NewClient(srv.URL, token, testr.New(t))
+	c.retryDelay = time.Millisecond
+	return c
+}
+
+func TestGetRepoInfo(t *testing.T) {
+	hub := &fakeHub{
+		files: map[string][]byte{
+			"config.json":       []byte(`{}`),
+			"model.safetensors": []byte("weights"),
+		},
+		lfsFiles: map[string]bool{
+			"model.safetensors": true,
+		},
+		gated: true,
+	}
+
+	tcs := []struct {
+		name     string
+		revision string
+		token    string
+		wantErr  string
+	}{
+		{
+			name:  "default revision",
+			token: "token",
+		},
+		{
+			name:     "commit sha",
+			revision: testSHA,
+			token:    "token",
+		},
+		{
+			name:     "unknown revision",
+			revision: "unknown",
+			token:    "token",
+			wantErr:  "not found",
+		},
+		{
+			name:    "gated",
+			wantErr: "HUGGING_FACE_HUB_TOKEN",
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t, hub, tc.token)
+			got, err := c.GetRepoInfo(context.Background(), testRepo, tc.revision)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testSHA, got.SHA)
+			assert.Len(t, got.Files, 2)
+			assert.Equal(t, int64(9), got.TotalSize())
+			for _, f := range got.Files {
+				assert.Equal(t, f.Path == "model.safetensors", f.LFS != nil)
+			}
+		})
+	}
+}
+
+func TestDownloadFile(t *testing.T) {
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+
+	tcs := []struct {
+		name string
+		hub  *fakeHub
+		// existing is the content of the incomplete file before the download.
+		existing  []byte
+		wantRange []string
+		wantErr   bool
+	}{
+		{
+			name: "lfs",
+			hub: &fakeHub{
+				lfsFiles: map[string]bool{"model.safetensors": true},
+			},
+		},
+		{
+			name: "non-lfs",
+			hub:  &fakeHub{},
+		},
+		{
+			name: "resume after dropped connection",
+			hub: &fakeHub{
+				lfsFiles:  map[string]bool{"model.safetensors": true},
+				dropAfter: 10,
+			},
+			wantRange: []string{"bytes=10-"},
+		},
+		{
+			name: "resume from incomplete file",
+			hub: &fakeHub{
+				lfsFiles: map[string]bool{"model.safetensors": true},
+			},
+			existing:  content[:20],
+			wantRange: []string{"bytes=20-"},
+		},
+		{
+			name: "sha256 mismatch",
+			hub: &fakeHub{
+				lfsFiles: map[string]bool{"model.safetensors": true},
+				corrupt:  true,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.hub.files = map[string][]byte{"model.safetensors": content}
+			c := newTestClient(t, tc.hub, "")
+
+			ctx := context.Background()
+			info, err := c.GetRepoInfo(ctx, testRepo, "")
+			assert.NoError(t, err)
+			assert.Len(t, info.Files, 1)
+
+			dir := t.TempDir()
+			destPath := filepath.Join(dir, "model.safetensors")
+			incompletePath := filepath.Join(dir, "cache", "model.safetensors.incomplete")
+			if tc.existing != nil {
+				err := os.MkdirAll(filepath.Dir(incompletePath), 0755)
+				assert.NoError(t, err)
+				err = os.WriteFile(incompletePath, tc.existing, 0644)
+				assert.NoError(t, err)
+			}
+
+			err = c.DownloadFile(ctx, testRepo, info.SHA, info.Files[0], destPath, incompletePath)
+			if tc.wantErr {
+				assert.Error(t, err)
+				_, err := os.Stat(destPath)
+				assert.True(t, os.IsNotExist(err))
+				return
+			}
+			assert.NoError(t, err)
+
+			got, err := os.ReadFile(destPath)
+			assert.NoError(t, err)
+			assert.Equal(t, content, got)
+			_, err = os.Stat(incompletePath)
+			assert.True(t, os.IsNotExist(err))
+			assert.Equal(t, tc.wantRange, tc.hub.rangeRequests)
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package huggingface
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	configFile           = "config.json"
+	generationConfigFile = "generation_config.json"
+	tokenizerConfigFile  = "tokenizer_config.json"
+	safetensorsIndexFile = "model.safetensors.index.json"
+
+	// maxSafetensorsHeaderSize is the maximum size of the JSON header of a safetensors file.
+	maxSafetensorsHeaderSize = 100 << 20
+)
+
+// chatTemplateFiles are the files that hold a chat template outside of tokenizer_config.json.
+var chatTemplateFiles = []string{
+	"chat_template.jinja",
+	"chat_template.json",
+}
+
+// safetensorsDtypes maps the data types of safetensors to the names used in config.json.
+var safetensorsDtypes = map[string]string{
+	"F64":  "float64",
+	"F32":  "float32",
+	"F16":  "float16",
+	"BF16": "bfloat16",
+	"I64":  "int64",
+	"I32":  "int32",
+	"I16":  "int16",
+	"I8":   "int8",
+	"U8":   "uint8",
+	"BOOL": "bool",
+}
+
+// ModelMetadata is the metadata of a model in the Hugging Face format.
+type ModelMetadata struct {
+	// Architecture is the class of the model (e.g., "LlamaForCausalLM").
+	Architecture string
+	// ModelType is the type of the model (e.g., "llama").
+	ModelType string
+	// Dtype is the data type of the weights (e.g., "bfloat16").
+	Dtype string
+	// ParameterCount is the number of the parameters in the safetensors files. It is zero if
+	// the model has no safetensors file or some of the files are missing.
+	ParameterCount int64
+	// MaxPositionEmbeddings is the maximum sequence length that the model supports.
+	MaxPositionEmbeddings int64
+	// VocabSize is the size of the vocabulary.
+	VocabSize int64
+	// HasChatTemplate is true if the tokenizer has a chat template.
+	HasChatTemplate bool
+}
+
+type modelConfig struct {
+	Architectures         []string `json:"architectures"`
+	ModelType             string   `json:"model_type"`
+	TorchDtype            string   `json:"torch_dtype"`
+	Dtype                 string   `json:"dtype"`
+	MaxPositionEmbeddings int64    `json:"max_position_embeddings"`
+	// NPositions is used instead of max_position_embeddings by some models (e.g., GPT-2).
+	NPositions int64 `json:"n_positions"`
+	VocabSize  int64 `json:"vocab_size"`
+
+	// TextConfig is the configuration of the language model of a multimodal model.
+	TextConfig *modelConfig `json:"text_config"`
+}
+
+type generationConfig struct {
+	MaxLength int64 `json:"max_length"`
+}
+
+type tokenizerConfig struct {
+	// ChatTemplate is either a string or a list of named templates.
+	ChatTemplate json.RawMessage `json:"chat_template"`
+}
+
+type safetensorsIndex struct {
+	WeightMap map[string]string `json:"weight_map"`
+}
+
+type safetensorsTensor struct {
+	Dtype string  `json:"dtype"`
+	Shape []int64 `json:"shape"`
+}
+
+// ParseModelMetadata parses the configuration files and the headers of the safetensors files in the directory.
+// config.json is required, and the other files are optional.
+func ParseModelMetadata(dir string) (*ModelMetadata, error) {
+	var c modelConfig
+	if err := readJSON(filepath.Join(dir, configFile), &c); err != nil {
+		return nil, fmt.Errorf("read %s: %s", configFile, err)
+	}
+
+	md := &ModelMetadata{
+		ModelType: c.ModelType,
+		Dtype:     c.dtype(),
+	}
+	if len(c.Architectures) > 0 {
+		md.Architecture = c.Architectures[0]
+	}
+	md.MaxPositionEmbeddings = c.maxPositionEmbeddings()
+	md.VocabSize = c.VocabSize
+	if tc := c.TextConfig; tc != nil {
+		// Multimodal models have the parameters of the language model in the text config.
+		if md.Dtype == "" {
+			md.Dtype = tc.dtype()
+		}
+		if md.MaxPositionEmbeddings == 0 {
+			md.MaxPositionEmbeddings = tc.maxPositionEmbeddings()
+		}
+		if md.VocabSize == 0 {
+			md.VocabSize = tc.VocabSize
+		}
+	}
+
+	if md.MaxPositionEmbeddings == 0 {
+		// Fall back to the default maximum length of generation.
+		var gc generationConfig
+		if err := readJSON(filepath.Join(dir, generationConfigFile), &gc); err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				return nil, fmt.Errorf("read %s: %s", generationConfigFile, err)
+			}
+		}
+		md.MaxPositionEmbeddings = gc.MaxLength
+	}
+
+	hasChatTemplate, err := hasChatTemplate(dir)
+	if err != nil {
+		return nil, err
+	}
+	md.HasChatTemplate = hasChatTemplate
+
+	numParams, dtype, err := countSafetensorsParameters(dir)
+	if err != nil {
+		return nil, err
+	}
+	md.ParameterCount = numParams
+	if md.Dtype == "" {
+		md.Dtype = dtype
+	}
+
+	return md, nil
+}
+
+func (c *modelConfig) dtype() string {
+	if c.Dtype != "" {
+		return c.Dtype
+	}
+	return c.TorchDtype
+}
+
+func (c *modelConfig) maxPositionEmbeddings() int64 {
+	if c.MaxPositionEmbeddings != 0 {
+		return c.MaxPositionEmbeddings
+	}
+	return c.NPositions
+}
+
+func hasChatTemplate(dir string) (bool, error) {
+	var tc tokenizerConfig
+	if err := readJSON(filepath.Join(dir, tokenizerConfigFile), &tc); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return false, fmt.Errorf("read %s: %s", tokenizerConfigFile, err)
+		}
+	}
+	if t := strings.TrimSpace(string(tc.ChatTemplate)); t != "" && t != "null" && t != `""` && t != "[]" {
+		return true, nil
+	}
+
+	for _, f := range chatTemplateFiles {
+		if _, err := os.Stat(filepath.Join(dir, f)); err == nil {
+			return true, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// countSafetensorsParameters returns the number of the parameters in the safetensors files and
+// the data type of the most of them. The files are listed in the index if it exists.
+func countSafetensorsParameters(dir string) (int64, string, error) {
+	var files []string
+	var index safetensorsIndex
+	if err := readJSON(filepath.Join(dir, safetensorsIndexFile), &index); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return 0, "", fmt.Errorf("read %s: %s", safetensorsIndexFile, err)
+		}
+		files, err = filepath.Glob(filepath.Join(dir, "*.safetensors"))
+		if err != nil {
+			return 0, "", err
+		}
+	} else {
+		seen := map[string]bool{}
+		for _, f := range index.WeightMap {
+			if seen[f] {
+				continue
+			}
+			seen[f] = true
+			files = append(files, filepath.Join(dir, filepath.FromSlash(f)))
+		}
+		sort.Strings(files)
+	}
+
+	var total int64
+	countsByDtype := map[string]int64{}
+	for _, f := range files {
+		tensors, err := readSafetensorsHeader(f)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				// Some of the files are excluded by the file patterns.
+				return 0, "", nil
+			}
+			return 0, "", fmt.Errorf("read safetensors header of %s: %s", filepath.Base(f), err)
+		}
+		for _, t := range tensors {
+			n := int64(1)
+			for _, d := range t.Shape {
+				n *= d
+			}
+			total += n
+			countsByDtype[t.Dtype] += n
+		}
+	}
+
+	var dtype string
+	var maxCount int64
+	for d, n := range countsByDtype {
+		if n > maxCount || (n == maxCount && d < dtype) {
+			dtype, maxCount = d, n
+		}
+	}
+	if name, ok := safetensorsDtypes[dtype]; ok {
+		dtype = name
+	} else {
+		dtype = strings.ToLower(dtype)
+	}
+	return total, dtype, nil
+}
+
+// readSafetensorsHeader reads the tensor information from the JSON header at the beginning of a safetensors file.
+func readSafetensorsHeader(path string) (map[string]safetensorsTensor, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	var n uint64
+	if err := binary.Read(f, binary.LittleEndian, &n); err != nil {
+		return nil, fmt.Errorf("read header size: %s", err)
+	}
+	if n > maxSafetensorsHeaderSize {
+		return nil, fmt.Errorf("header size %d is too large", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(f, b); err != nil {
+		return nil, fmt.Errorf("read header: %s", err)
+	}
+
+	var header map[string]json.RawMessage
+	if err := json.Unmarshal(b, &header); err != nil {
+		return nil, fmt.Errorf("unmarshal header: %s", err)
+	}
+	tensors := map[string]safetensorsTensor{}
+	for name, v := range header {
+		if name == "__metadata__" {
+			continue
+		}
+		var t safetensorsTensor
+		if err := json.Unmarshal(v, &t); err != nil {
+			return nil, fmt.Errorf("unmarshal tensor %q: %s", name, err)
+		}
+		tensors[name] = t
+	}
+	return tensors, nil
+}
+
+func readJSON(path string, v interface{}) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,121 @@
+package huggingface
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestFile(t *testing.T, dir, name, content string) {
+	err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+	assert.NoError(t, err)
+}
+
+func writeTestSafetensors(t *testing.T, dir, name string, tensors map[string]safetensorsTensor) {
+	header := map[string]interface{}{
+		"__metadata__": map[string]string{"format": "pt"},
+	}
+	for n, t := range tensors {
+		header[n] = t
+	}
+	b, err := json.Marshal(header)
+	assert.NoError(t, err)
+
+	f, err := os.Create(filepath.Join(dir, name))
+	assert.NoError(t, err)
+	defer func() {
+		_ = f.Close()
+	}()
+	err = binary.Write(f, binary.LittleEndian, uint64(len(b)))
+	assert.NoError(t, err)
+	_, err = f.Write(b)
+	assert.NoError(t, err)
+}
+
+func TestParseModelMetadata(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, dir, configFile, `{
+  "architectures": ["LlamaForCausalLM"],
+  "model_type": "llama",
+  "torch_dtype": "bfloat16",
+  "max_position_embeddings": 131072,
+  "vocab_size": 128256
+}`)
+	writeTestFile(t, dir, tokenizerConfigFile, `{"chat_template": "{{ messages }}"}`)
+	writeTestFile(t, dir, safetensorsIndexFile, `{
+  "metadata": {"total_size": 100},
+  "weight_map": {
+    "a": "model-00001-of-00002.safetensors",
+    "b": "model-00002-of-00002.safetensors",
+    "c": "model-00002-of-00002.safetensors"
+  }
+}`)
+	writeTestSafetensors(t, dir, "model-00001-of-00002.safetensors", map[string]safetensorsTensor{
+		"a": {Dtype: "BF16", Shape: []int64{10, 20}},
+	})
+	writeTestSafetensors(t, dir, "model-00002-of-00002.safetensors", map[string]safetensorsTensor{
+		"b": {Dtype: "BF16", Shape: []int64{30}},
+		"c": {Dtype: "F32", Shape: []int64{5}},
+	})
+
+	got, err := ParseModelMetadata(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, &ModelMetadata{
+		Architecture:          "LlamaForCausalLM",
+		ModelType:             "llama",
+		Dtype:                 "bfloat16",
+		ParameterCount:        235,
+		MaxPositionEmbeddings: 131072,
+		VocabSize:             128256,
+		HasChatTemplate:       true,
+	}, got)
+}
+
+func TestParseModelMetadata_Fallbacks(t *testing.T) {
+	dir := t.TempDir()
+	// A multimodal model that has the parameters of the language model in the text config.
+	writeTestFile(t, dir, configFile, `{
+  "architectures": ["Gemma3ForConditionalGeneration"],
+  "model_type": "gemma3",
+  "text_config": {"vocab_size": 262208}
+}`)
+	writeTestFile(t, dir, generationConfigFile, `{"max_length": 8192}`)
+	writeTestFile(t, dir, tokenizerConfigFile, `{}`)
+	writeTestFile(t, dir, "chat_template.jinja", "{{ messages }}")
+	writeTestSafetensors(t, dir, "model.safetensors", map[string]safetensorsTensor{
+		"a": {Dtype: "F16", Shape: []int64{2, 3}},
+	})
+
+	got, err := ParseModelMetadata(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, &ModelMetadata{
+		Architecture:          "Gemma3ForConditionalGeneration",
+		ModelType:             "gemma3",
+		Dtype:                 "float16",
+		ParameterCount:        6,
+		MaxPositionEmbeddings: 8192,
+		VocabSize:             262208,
+		HasChatTemplate:       true,
+	}, got)
+}
+
+func TestParseModelMetadata_MissingFiles(t *testing.T) {
+	dir := t.TempDir()
+	_, err := ParseModelMetadata(dir)
+	assert.Error(t, err)
+
+	writeTestFile(t, dir, configFile, `{"model_type": "llama"}`)
+	// The shard is excluded by the file patterns.
+	writeTestFile(t, dir, safetensorsIndexFile, `{"weight_map": {"a": "model-00001-of-00001.safetensors"}}`)
+	got, err := ParseModelMetadata(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, &ModelMetadata{ModelType: "llama"}, got)
+
+	writeTestFile(t, dir, "model-00001-of-00001.safetensors", "invalid")
+	_, err = ParseModelMetadata(dir)
+	assert.Error(t, err)
+}
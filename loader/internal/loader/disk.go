@@ -0,0 +1,15 @@
+package loader
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// freeDiskBytes returns the number of bytes available to unprivileged users in the file system of the directory.
+func freeDiskBytes(dir string) (int64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(dir, &st); err != nil {
+		return 0, fmt.Errorf("statfs: %s", err)
+	}
+	return int64(st.Bavail) * int64(st.Bsize), nil
+}
@@ -0,0 +1,16 @@
+package loader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFreeDiskBytes(t *testing.T) {
+	n, err := freeDiskBytes(t.TempDir())
+	assert.NoError(t, err)
+	assert.Greater(t, n, int64(0))
+
+	_, err = freeDiskBytes("/non-existent-dir")
+	assert.Error(t, err)
+}
@@ -3,6 +3,7 @@ package loader
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	v1 "github.com/llmariner/model-manager/api/v1"
 	"google.golang.org/grpc"
@@ -13,11 +14,16 @@ import (
 // NewFakeModelClient creates a fake model client.
 func NewFakeModelClient() *FakeModelClient {
 	return &FakeModelClient{
-		pathsByID:   map[string]string{},
-		ggufsByID:   map[string]string{},
-		formatsByID: map[string][]v1.ModelFormat{},
+		pathsByID:     map[string]string{},
+		ggufsByID:     map[string]string{},
+		formatsByID:   map[string][]v1.ModelFormat{},
+		revisionsByID: map[string]string{},
+		filesByID:     map[string][]*v1.ModelFile{},
+		ggufMetadata:  map[string]*v1.GGUFMetadata{},
+		hfMetadata:    map[string]*v1.HuggingFaceMetadata{},
+		artifactsByID: map[string][]*v1.FormatArtifact{},
 
-		hfModelRepos: map[string]bool{},
+		hfModelRepos: map[string]string{},
 	}
 }
 
@@ -26,11 +32,40 @@ type FakeModelClient struct {
 	pathsByID   map[string]string
 	ggufsByID   map[string]string
 	formatsByID map[string][]v1.ModelFormat
+	// revisionsByID is the resolved revisions of base models.
+	revisionsByID map[string]string
+	// filesByID is the files of the loaded models.
+	filesByID map[string][]*v1.ModelFile
+	// ggufMetadata is the GGUF metadata of base models.
+	ggufMetadata map[string]*v1.GGUFMetadata
+	// hfMetadata is the Hugging Face metadata of base models.
+	hfMetadata map[string]*v1.HuggingFaceMetadata
+	// artifactsByID is the format artifacts of base models.
+	artifactsByID map[string][]*v1.FormatArtifact
 
-	hfModelRepos map[string]bool
+	// hfModelRepos is the resolved revisions of the downloaded HuggingFace repos keyed by their names.
+	hfModelRepos map[string]string
 
 	requestedBaseModelID string
+	requestedRevision    string
 	sourceRepository     v1.SourceRepository
+
+	// gcUnimplemented simulates a server that does not implement the RPCs for garbage collection and
+	// reconciliation.
+	gcUnimplemented bool
+	// deletedModelPaths is the paths of the deleted models whose objects have not been deleted.
+	deletedModelPaths []string
+	// uploadsOnlyPaths is the paths whose deletion tasks are for the uploads of abandoned loadings.
+	uploadsOnlyPaths map[string]bool
+	numTasks         int64
+	// completedTaskPaths is the paths of the completed object deletion tasks.
+	completedTaskPaths []string
+	taskPaths          map[int64]string
+
+	// loadedModels is the models returned by ListLoadedModelPaths.
+	loadedModels []*v1.LoadedModelPath
+	// degradationUpdates is the requests received by UpdateModelDegradation.
+	degradationUpdates []*v1.UpdateModelDegradationRequest
 }
 
 // CreateBaseModel creates a base model.
@@ -41,6 +76,11 @@ func (c *FakeModelClient) CreateBaseModel(ctx context.Context, in *v1.CreateBase
 	c.pathsByID[in.Id] = in.Path
 	c.ggufsByID[in.Id] = in.GgufModelPath
 	c.formatsByID[in.Id] = in.Formats
+	c.revisionsByID[in.Id] = in.ResolvedRevision
+	c.filesByID[in.Id] = in.Files
+	c.ggufMetadata[in.Id] = in.GgufMetadata
+	c.hfMetadata[in.Id] = in.HuggingFaceMetadata
+	c.artifactsByID[in.Id] = in.Artifacts
 
 	return &v1.BaseModel{
 		Id: in.Id,
@@ -66,6 +106,7 @@ func (c *FakeModelClient) GetBaseModelPath(ctx context.Context, in *v1.GetBaseMo
 		Path:          path,
 		Formats:       formats,
 		GgufModelPath: ggufPath,
+		Artifacts:     c.artifactsByID[in.Id],
 	}, nil
 }
 
@@ -103,27 +144,34 @@ func (c *FakeModelClient) CreateHFModelRepo(ctx context.Context, in *v1.CreateHF
 	if _, ok := c.hfModelRepos[in.Name]; ok {
 		return nil, status.Errorf(codes.AlreadyExists, "hugging-face model repo %q already exists", in.Name)
 	}
-	c.hfModelRepos[in.Name] = true
-	return &v1.HFModelRepo{Name: in.Name}, nil
+	c.hfModelRepos[in.Name] = in.Revision
+	return &v1.HFModelRepo{Name: in.Name, Revision: in.Revision}, nil
 
 }
 
 // GetHFModelRepo returns a HuggingFace model repo.
 func (c *FakeModelClient) GetHFModelRepo(ctx context.Context, in *v1.GetHFModelRepoRequest, opts ...grpc.CallOption) (*v1.HFModelRepo, error) {
-	if _, ok := c.hfModelRepos[in.Name]; !ok {
+	rev, ok := c.hfModelRepos[in.Name]
+	if !ok {
 		return nil, status.Errorf(codes.NotFound, "hugging-face model repo %q not found", in.Name)
 	}
-	return &v1.HFModelRepo{Name: in.Name}, nil
+	return &v1.HFModelRepo{Name: in.Name, Revision: rev}, nil
 }
 
 // AcquireUnloadedBaseModel acquires an unloaded base model.
 func (c *FakeModelClient) AcquireUnloadedBaseModel(ctx context.Context, in *v1.AcquireUnloadedBaseModelRequest, opts ...grpc.CallOption) (*v1.AcquireUnloadedBaseModelResponse, error) {
+	if len(in.SupportedSourceRepositories) > 0 && !slices.Contains(in.SupportedSourceRepositories, c.sourceRepository) {
+		// The loader cannot load the requested model.
+		return &v1.AcquireUnloadedBaseModelResponse{}, nil
+	}
 	resp := &v1.AcquireUnloadedBaseModelResponse{
 		BaseModelId:      c.requestedBaseModelID,
 		SourceRepository: c.sourceRepository,
+		Revision:         c.requestedRevision,
 	}
 	// Clear the requested base model ID so that the next call to this function will return an empty response.
 	c.requestedBaseModelID = ""
+	c.requestedRevision = ""
 	c.sourceRepository = v1.SourceRepository_SOURCE_REPOSITORY_UNSPECIFIED
 	return resp, nil
 }
@@ -140,5 +188,74 @@ func (c *FakeModelClient) AcquireUnloadedModel(ctx context.Context, in *v1.Acqui
 
 // UpdateModelLoadingStatus updates the loading status of a model.
 func (c *FakeModelClient) UpdateModelLoadingStatus(ctx context.Context, in *v1.UpdateModelLoadingStatusRequest, opts ...grpc.CallOption) (*v1.UpdateModelLoadingStatusResponse, error) {
+	if s := in.GetSuccess(); s != nil {
+		c.filesByID[in.Id] = s.Files
+	}
 	return &v1.UpdateModelLoadingStatusResponse{}, nil
 }
+
+// AcquireObjectDeletionTask acquires an object deletion task.
+func (c *FakeModelClient) AcquireObjectDeletionTask(ctx context.Context, in *v1.AcquireObjectDeletionTaskRequest, opts ...grpc.CallOption) (*v1.AcquireObjectDeletionTaskResponse, error) {
+	if c.gcUnimplemented {
+		return nil, status.Errorf(codes.Unimplemented, "method AcquireObjectDeletionTask not implemented")
+	}
+	if len(c.deletedModelPaths) == 0 {
+		return &v1.AcquireObjectDeletionTaskResponse{}, nil
+	}
+	path := c.deletedModelPaths[0]
+	c.deletedModelPaths = c.deletedModelPaths[1:]
+	c.numTasks++
+	if c.taskPaths == nil {
+		c.taskPaths = map[int64]string{}
+	}
+	c.taskPaths[c.numTasks] = path
+	return &v1.AcquireObjectDeletionTaskResponse{
+		TaskId:      c.numTasks,
+		Path:        path,
+		UploadsOnly: c.uploadsOnlyPaths[path],
+	}, nil
+}
+
+// CompleteObjectDeletionTask completes an object deletion task.
+func (c *FakeModelClient) CompleteObjectDeletionTask(ctx context.Context, in *v1.CompleteObjectDeletionTaskRequest, opts ...grpc.CallOption) (*v1.CompleteObjectDeletionTaskResponse, error) {
+	path, ok := c.taskPaths[in.TaskId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "object deletion task %d not found", in.TaskId)
+	}
+	delete(c.taskPaths, in.TaskId)
+	c.completedTaskPaths = append(c.completedTaskPaths, path)
+	return &v1.CompleteObjectDeletionTaskResponse{}, nil
+}
+
+// ListModelPaths lists the paths of the models.
+func (c *FakeModelClient) ListModelPaths(ctx context.Context, in *v1.ListModelPathsRequest, opts ...grpc.CallOption) (*v1.ListModelPathsResponse, error) {
+	if c.gcUnimplemented {
+		return nil, status.Errorf(codes.Unimplemented, "method ListModelPaths not implemented")
+	}
+	var paths []string
+	for _, p := range c.pathsByID {
+		if p != "" && !slices.Contains(paths, p) {
+			paths = append(paths, p)
+		}
+	}
+	slices.Sort(paths)
+	return &v1.ListModelPathsResponse{
+		Paths: paths,
+	}, nil
+}
+
+// ListLoadedModelPaths lists the paths of the loaded models.
+func (c *FakeModelClient) ListLoadedModelPaths(ctx context.Context, in *v1.ListLoadedModelPathsRequest, opts ...grpc.CallOption) (*v1.ListLoadedModelPathsResponse, error) {
+	if c.gcUnimplemented {
+		return nil, status.Errorf(codes.Unimplemented, "method ListLoadedModelPaths not implemented")
+	}
+	return &v1.ListLoadedModelPathsResponse{
+		Models: c.loadedModels,
+	}, nil
+}
+
+// UpdateModelDegradation updates the degradation of a model.
+func (c *FakeModelClient) UpdateModelDegradation(ctx context.Context, in *v1.UpdateModelDegradationRequest, opts ...grpc.CallOption) (*v1.UpdateModelDegradationResponse, error) {
+	c.degradationUpdates = append(c.degradationUpdates, in)
+	return &v1.UpdateModelDegradationResponse{}, nil
+}
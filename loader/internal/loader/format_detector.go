@@ -0,0 +1,426 @@
+package loader
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+)
+
+// FormatDetector detects the models of a format in the downloaded files.
+type FormatDetector interface {
+	// detect returns the models found in the tree. It returns no model if the files are not in the format.
+	// The returned models have the ID, the path, the formats, and the format-specific fields.
+	detect(t *modelTree) []*modelInfo
+}
+
+// defaultFormatDetectors returns the detectors of all supported formats. The formats of a model are ordered
+// in the order of the detectors.
+func defaultFormatDetectors() []FormatDetector {
+	return []FormatDetector{
+		&huggingFaceDetector{},
+		&tritonDetector{},
+		&ggufDetector{},
+		&ollamaDetector{},
+		&onnxDetector{},
+		&mlxDetector{},
+		&quantizedSafetensorsDetector{},
+		&vllmDetector{},
+	}
+}
+
+// modelTree is the tree of the downloaded files of a model.
+type modelTree struct {
+	modelID string
+	// filename is the name of the file to download from the repository. It is empty if the whole
+	// repository is downloaded.
+	filename string
+	// rootDir is the local directory where the files are downloaded.
+	rootDir string
+	// pathPrefix is the object store path where the files are uploaded.
+	pathPrefix string
+
+	// files are the slash-separated paths of the files relative to rootDir.
+	files []string
+	// dirs are the slash-separated paths of the directories relative to rootDir.
+	dirs []string
+
+	log logr.Logger
+}
+
+// defaultID returns the ID of a model that consists of the whole tree.
+func (t *modelTree) defaultID() string {
+	if t.filename != "" {
+		return t.modelID + "/" + t.filename
+	}
+	return t.modelID
+}
+
+// key returns the object store key of the file.
+func (t *modelTree) key(rel string) string {
+	return filepath.Join(t.pathPrefix, rel)
+}
+
+// localPath returns the local path of the file.
+func (t *modelTree) localPath(rel string) string {
+	return filepath.Join(t.rootDir, filepath.FromSlash(rel))
+}
+
+// findTopLevelFile returns the path of the file of the name in the shallowest directory.
+func (t *modelTree) findTopLevelFile(name string) (string, bool) {
+	var found string
+	for _, f := range t.files {
+		if path.Base(f) != name {
+			continue
+		}
+		if found == "" || dirDepth(path.Dir(f)) < dirDepth(path.Dir(found)) {
+			found = f
+		}
+	}
+	return found, found != ""
+}
+
+// filesInDir returns the files directly under the directory.
+func (t *modelTree) filesInDir(dir string) []string {
+	var fs []string
+	for _, f := range t.files {
+		if path.Dir(f) == dir {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// quantizationConfig is the part of config.json that describes how the model is quantized.
+type quantizationConfig struct {
+	// Quantization is set by MLX.
+	Quantization map[string]interface{} `json:"quantization"`
+	// QuantizationConfig is set by Transformers.
+	QuantizationConfig struct {
+		QuantMethod string `json:"quant_method"`
+	} `json:"quantization_config"`
+}
+
+// readQuantizationConfig reads the quantization config from config.json. It returns false if the file
+// cannot be parsed.
+func (t *modelTree) readQuantizationConfig(rel string) (*quantizationConfig, bool) {
+	b, err := os.ReadFile(t.localPath(rel))
+	if err != nil {
+		t.log.Error(err, "Failed to read the config", "path", rel)
+		return nil, false
+	}
+	var c quantizationConfig
+	if err := json.Unmarshal(b, &c); err != nil {
+		t.log.V(1).Info("Failed to parse the config", "path", rel, "error", err)
+		return nil, false
+	}
+	return &c, true
+}
+
+// detectModels runs the detectors and merges the models of the same ID into one model that has multiple formats.
+func detectModels(detectors []FormatDetector, t *modelTree) []*modelInfo {
+	var minfos []*modelInfo
+	byID := map[string]*modelInfo{}
+	for _, d := range detectors {
+		for _, mi := range d.detect(t) {
+			if existing, ok := byID[mi.id]; ok {
+				existing.merge(mi)
+				continue
+			}
+			byID[mi.id] = mi
+			minfos = append(minfos, mi)
+		}
+	}
+	return minfos
+}
+
+func (mi *modelInfo) merge(o *modelInfo) {
+	mi.formats = append(mi.formats, o.formats...)
+	for f, p := range o.formatPaths {
+		if mi.formatPaths == nil {
+			mi.formatPaths = map[v1.ModelFormat]string{}
+		}
+		mi.formatPaths[f] = p
+	}
+	if o.ggufModelPath != "" {
+		mi.ggufModelPath = o.ggufModelPath
+	}
+	if o.ggufMetadata != nil {
+		mi.ggufMetadata = o.ggufMetadata
+	}
+	if o.huggingFaceMetadata != nil {
+		mi.huggingFaceMetadata = o.huggingFaceMetadata
+	}
+}
+
+// huggingFaceDetector detects a model in the Hugging Face format, which has config.json. Fine-tuned
+// adapters have adapter_config.json instead.
+type huggingFaceDetector struct{}
+
+func (d *huggingFaceDetector) detect(t *modelTree) []*modelInfo {
+	config, hasConfig := t.findTopLevelFile("config.json")
+	_, hasAdapterConfig := t.findTopLevelFile("adapter_config.json")
+	if !hasConfig && !hasAdapterConfig {
+		return nil
+	}
+
+	mi := &modelInfo{
+		id:      t.defaultID(),
+		path:    t.pathPrefix,
+		formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+	}
+	if hasConfig {
+		// Extract the metadata from the top-level config.json.
+		mi.huggingFaceMetadata = parseHuggingFaceMetadata(t.localPath(path.Dir(config)), t.log)
+	}
+	return []*modelInfo{mi}
+}
+
+// dirDepth returns the depth of a slash-separated relative directory path. The root directory is ".".
+func dirDepth(dir string) int {
+	if dir == "." {
+		return 0
+	}
+	return strings.Count(dir, "/") + 1
+}
+
+// tritonDetector detects a model built for the TensorRT-LLM backend of NVIDIA Triton Inference Server.
+type tritonDetector struct{}
+
+func (d *tritonDetector) detect(t *modelTree) []*modelInfo {
+	for _, f := range t.files {
+		if strings.HasSuffix("/"+f, "/tensorrt_llm/config.pbtxt") {
+			return []*modelInfo{
+				{
+					id:      t.defaultID(),
+					path:    t.pathPrefix,
+					formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_NVIDIA_TRITON},
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// ggufDetector detects models in the GGUF format. If the tree has multiple GGUF files, each of them is
+// a separate model.
+type ggufDetector struct{}
+
+func (d *ggufDetector) detect(t *modelTree) []*modelInfo {
+	var ggufFiles []string
+	for _, f := range t.files {
+		if strings.HasSuffix(f, ".gguf") {
+			ggufFiles = append(ggufFiles, f)
+		}
+	}
+
+	switch len(ggufFiles) {
+	case 0:
+		return nil
+	case 1:
+		return []*modelInfo{
+			{
+				id:            t.defaultID(),
+				path:          t.pathPrefix,
+				ggufModelPath: t.key(ggufFiles[0]),
+				formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+				ggufMetadata:  parseGGUFMetadata(t.localPath(ggufFiles[0]), t.log),
+			},
+		}
+	}
+
+	t.log.Info("Found multiple GGUF files. Creating a model info per GGUF file")
+	var minfos []*modelInfo
+	for _, f := range ggufFiles {
+		gpath := t.key(f)
+		filename := extractFileNameFromGGUFPath(gpath)
+		minfos = append(minfos, &modelInfo{
+			id:            filepath.Join(t.modelID, filename),
+			path:          filepath.Join(t.pathPrefix, filename),
+			ggufModelPath: gpath,
+			formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+			ggufMetadata:  parseGGUFMetadata(t.localPath(f), t.log),
+		})
+	}
+	return minfos
+}
+
+// ollamaDetector detects a model pulled from an Ollama registry, which stores the layers in the blobs directory.
+type ollamaDetector struct{}
+
+func (d *ollamaDetector) detect(t *modelTree) []*modelInfo {
+	for _, dir := range t.dirs {
+		if path.Base(dir) == "blobs" {
+			return []*modelInfo{
+				{
+					id:      t.modelID,
+					path:    t.pathPrefix,
+					formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_OLLAMA},
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// onnxDetector detects a model for ONNX Runtime. The entry point is the shallowest .onnx file, and
+// model.onnx is preferred among the files in the same directory.
+type onnxDetector struct{}
+
+func (d *onnxDetector) detect(t *modelTree) []*modelInfo {
+	var entry string
+	for _, f := range t.files {
+		if path.Ext(f) != ".onnx" {
+			continue
+		}
+		if entry == "" {
+			entry = f
+			continue
+		}
+		if de, df := dirDepth(path.Dir(entry)), dirDepth(path.Dir(f)); df < de || (df == de && path.Base(f) == "model.onnx") {
+			entry = f
+		}
+	}
+	if entry == "" {
+		return nil
+	}
+	return []*modelInfo{
+		{
+			id:          t.defaultID(),
+			path:        t.pathPrefix,
+			formats:     []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_ONNX},
+			formatPaths: map[v1.ModelFormat]string{v1.ModelFormat_MODEL_FORMAT_ONNX: t.key(entry)},
+		},
+	}
+}
+
+// mlxDetector detects a model converted by MLX. MLX models have the quantization parameters in config.json
+// or the weights in .npz files. Unquantized models converted to safetensors cannot be distinguished from
+// Hugging Face models.
+type mlxDetector struct{}
+
+func (d *mlxDetector) detect(t *modelTree) []*modelInfo {
+	config, ok := t.findTopLevelFile("config.json")
+	if !ok {
+		return nil
+	}
+	dir := path.Dir(config)
+
+	isMLX := false
+	if c, ok := t.readQuantizationConfig(config); ok && c.Quantization != nil {
+		isMLX = true
+	}
+	for _, f := range t.filesInDir(dir) {
+		if path.Ext(f) == ".npz" {
+			isMLX = true
+		}
+	}
+	if !isMLX {
+		return nil
+	}
+	return []*modelInfo{
+		{
+			id:          t.defaultID(),
+			path:        t.pathPrefix,
+			formats:     []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_MLX},
+			formatPaths: map[v1.ModelFormat]string{v1.ModelFormat_MODEL_FORMAT_MLX: t.key(dir)},
+		},
+	}
+}
+
+// quantizedSafetensorsDetector detects a model whose safetensors files are quantized with GPTQ or AWQ.
+type quantizedSafetensorsDetector struct{}
+
+func (d *quantizedSafetensorsDetector) detect(t *modelTree) []*modelInfo {
+	config, ok := t.findTopLevelFile("config.json")
+	if !ok {
+		return nil
+	}
+	dir := path.Dir(config)
+	files := t.filesInDir(dir)
+	if !hasFileWithExt(files, ".safetensors") {
+		return nil
+	}
+
+	c, ok := t.readQuantizationConfig(config)
+	if !ok {
+		return nil
+	}
+	var format v1.ModelFormat
+	switch strings.ToLower(c.QuantizationConfig.QuantMethod) {
+	case "gptq":
+		format = v1.ModelFormat_MODEL_FORMAT_GPTQ
+	case "awq":
+		format = v1.ModelFormat_MODEL_FORMAT_AWQ
+	case "":
+		// AutoGPTQ writes the parameters to a separate file.
+		if !hasFileWithName(files, "quantize_config.json") {
+			return nil
+		}
+		format = v1.ModelFormat_MODEL_FORMAT_GPTQ
+	default:
+		return nil
+	}
+	return []*modelInfo{
+		{
+			id:          t.defaultID(),
+			path:        t.pathPrefix,
+			formats:     []v1.ModelFormat{format},
+			formatPaths: map[v1.ModelFormat]string{format: t.key(dir)},
+		},
+	}
+}
+
+// vllmDetector detects a directory that vLLM can serve without conversion. The directory has config.json,
+// a tokenizer, and the weights in safetensors files.
+type vllmDetector struct{}
+
+func (d *vllmDetector) detect(t *modelTree) []*modelInfo {
+	config, ok := t.findTopLevelFile("config.json")
+	if !ok {
+		return nil
+	}
+	dir := path.Dir(config)
+	files := t.filesInDir(dir)
+	if !hasFileWithExt(files, ".safetensors") {
+		return nil
+	}
+	if !hasFileWithName(files, "tokenizer.json") && !hasFileWithName(files, "tokenizer.model") {
+		return nil
+	}
+	c, ok := t.readQuantizationConfig(config)
+	if !ok || c.Quantization != nil {
+		// vLLM does not load the weights quantized by MLX.
+		return nil
+	}
+	return []*modelInfo{
+		{
+			id:          t.defaultID(),
+			path:        t.pathPrefix,
+			formats:     []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_VLLM},
+			formatPaths: map[v1.ModelFormat]string{v1.ModelFormat_MODEL_FORMAT_VLLM: t.key(dir)},
+		},
+	}
+}
+
+func hasFileWithExt(files []string, ext string) bool {
+	for _, f := range files {
+		if path.Ext(f) == ext {
+			return true
+		}
+	}
+	return false
+}
+
+func hasFileWithName(files []string, name string) bool {
+	for _, f := range files {
+		if path.Base(f) == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,505 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+// detectedModel is the subset of modelInfo that detectors set.
+type detectedModel struct {
+	id            string
+	path          string
+	ggufModelPath string
+	formats       []v1.ModelFormat
+	formatPaths   map[v1.ModelFormat]string
+}
+
+func newTestModelTree(t *testing.T, filename string, files, dirs []string) *modelTree {
+	return &modelTree{
+		modelID:  "org/m0",
+		filename: filename,
+		// The files do not exist, so no metadata is extracted.
+		rootDir:    t.TempDir(),
+		pathPrefix: "models/base-models/global/org/m0",
+		files:      files,
+		dirs:       dirs,
+		log:        testr.New(t),
+	}
+}
+
+// newTestModelTreeWithContents creates a tree of the files that have the contents.
+func newTestModelTreeWithContents(t *testing.T, contents map[string]string) *modelTree {
+	var files []string
+	for f := range contents {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	tree := newTestModelTree(t, "", files, nil)
+	for f, c := range contents {
+		p := tree.localPath(f)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		assert.NoError(t, os.WriteFile(p, []byte(c), 0644))
+	}
+	return tree
+}
+
+func toDetectedModels(minfos []*modelInfo) []detectedModel {
+	var ms []detectedModel
+	for _, mi := range minfos {
+		ms = append(ms, detectedModel{
+			id:            mi.id,
+			path:          mi.path,
+			ggufModelPath: mi.ggufModelPath,
+			formats:       mi.formats,
+			formatPaths:   mi.formatPaths,
+		})
+	}
+	return ms
+}
+
+func TestHuggingFaceDetector(t *testing.T) {
+	tcs := []struct {
+		name  string
+		files []string
+		want  []detectedModel
+	}{
+		{
+			name:  "config.json",
+			files: []string{"config.json", "model.safetensors"},
+			want: []detectedModel{
+				{
+					id:      "org/m0",
+					path:    "models/base-models/global/org/m0",
+					formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+				},
+			},
+		},
+		{
+			name:  "adapter_config.json",
+			files: []string{"adapter_config.json", "adapter_model.safetensors"},
+			want: []detectedModel{
+				{
+					id:      "org/m0",
+					path:    "models/base-models/global/org/m0",
+					formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+				},
+			},
+		},
+		{
+			name:  "no config",
+			files: []string{"model.gguf"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &huggingFaceDetector{}
+			got := d.detect(newTestModelTree(t, "", tc.files, nil))
+			assert.Equal(t, tc.want, toDetectedModels(got))
+		})
+	}
+}
+
+func TestTritonDetector(t *testing.T) {
+	tcs := []struct {
+		name  string
+		files []string
+		want  []detectedModel
+	}{
+		{
+			name:  "tensorrt_llm",
+			files: []string{"repo/tensorrt_llm/config.pbtxt", "repo/tensorrt_llm/1/rank0.engine"},
+			want: []detectedModel{
+				{
+					id:      "org/m0",
+					path:    "models/base-models/global/org/m0",
+					formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_NVIDIA_TRITON},
+				},
+			},
+		},
+		{
+			name:  "other backend",
+			files: []string{"repo/ensemble/config.pbtxt"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &tritonDetector{}
+			got := d.detect(newTestModelTree(t, "", tc.files, nil))
+			assert.Equal(t, tc.want, toDetectedModels(got))
+		})
+	}
+}
+
+func TestGGUFDetector(t *testing.T) {
+	tcs := []struct {
+		name     string
+		filename string
+		files    []string
+		want     []detectedModel
+	}{
+		{
+			name:  "single file",
+			files: []string{"dir/model.gguf", "README.md"},
+			want: []detectedModel{
+				{
+					id:            "org/m0",
+					path:          "models/base-models/global/org/m0",
+					ggufModelPath: "models/base-models/global/org/m0/dir/model.gguf",
+					formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+				},
+			},
+		},
+		{
+			name:     "single file in a repository",
+			filename: "model-Q4_K_M.gguf",
+			files:    []string{"model-Q4_K_M.gguf"},
+			want: []detectedModel{
+				{
+					id:            "org/m0/model-Q4_K_M.gguf",
+					path:          "models/base-models/global/org/m0",
+					ggufModelPath: "models/base-models/global/org/m0/model-Q4_K_M.gguf",
+					formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+				},
+			},
+		},
+		{
+			name:  "multiple files",
+			files: []string{"model-Q4_K_M.gguf", "model-Q8_0.gguf"},
+			want: []detectedModel{
+				{
+					id:            "org/m0/model-Q4_K_M",
+					path:          "models/base-models/global/org/m0/model-Q4_K_M",
+					ggufModelPath: "models/base-models/global/org/m0/model-Q4_K_M.gguf",
+					formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+				},
+				{
+					id:            "org/m0/model-Q8_0",
+					path:          "models/base-models/global/org/m0/model-Q8_0",
+					ggufModelPath: "models/base-models/global/org/m0/model-Q8_0.gguf",
+					formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+				},
+			},
+		},
+		{
+			name:  "no gguf file",
+			files: []string{"config.json"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &ggufDetector{}
+			got := d.detect(newTestModelTree(t, tc.filename, tc.files, nil))
+			assert.Equal(t, tc.want, toDetectedModels(got))
+		})
+	}
+}
+
+func TestOllamaDetector(t *testing.T) {
+	tcs := []struct {
+		name string
+		dirs []string
+		want []detectedModel
+	}{
+		{
+			name: "blobs",
+			dirs: []string{"manifests", "blobs"},
+			want: []detectedModel{
+				{
+					id:      "org/m0",
+					path:    "models/base-models/global/org/m0",
+					formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_OLLAMA},
+				},
+			},
+		},
+		{
+			name: "no blobs",
+			dirs: []string{"original"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &ollamaDetector{}
+			got := d.detect(newTestModelTree(t, "", nil, tc.dirs))
+			assert.Equal(t, tc.want, toDetectedModels(got))
+		})
+	}
+}
+
+func TestDetectModels(t *testing.T) {
+	tcs := []struct {
+		name  string
+		files []string
+		want  []detectedModel
+	}{
+		{
+			name:  "hugging face and single gguf",
+			files: []string{"config.json", "model.gguf"},
+			want: []detectedModel{
+				{
+					id:            "org/m0",
+					path:          "models/base-models/global/org/m0",
+					ggufModelPath: "models/base-models/global/org/m0/model.gguf",
+					formats: []v1.ModelFormat{
+						v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE,
+						v1.ModelFormat_MODEL_FORMAT_GGUF,
+					},
+				},
+			},
+		},
+		{
+			name:  "hugging face and multiple gguf",
+			files: []string{"config.json", "model.safetensors", "model-Q4_K_M.gguf", "model-Q8_0.gguf"},
+			want: []detectedModel{
+				{
+					id:      "org/m0",
+					path:    "models/base-models/global/org/m0",
+					formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+				},
+				{
+					id:            "org/m0/model-Q4_K_M",
+					path:          "models/base-models/global/org/m0/model-Q4_K_M",
+					ggufModelPath: "models/base-models/global/org/m0/model-Q4_K_M.gguf",
+					formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+				},
+				{
+					id:            "org/m0/model-Q8_0",
+					path:          "models/base-models/global/org/m0/model-Q8_0",
+					ggufModelPath: "models/base-models/global/org/m0/model-Q8_0.gguf",
+					formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+				},
+			},
+		},
+		{
+			name:  "unknown format",
+			files: []string{"README.md"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got := detectModels(defaultFormatDetectors(), newTestModelTree(t, "", tc.files, nil))
+			assert.Equal(t, tc.want, toDetectedModels(got))
+		})
+	}
+}
+
+func TestONNXDetector(t *testing.T) {
+	tcs := []struct {
+		name  string
+		files []string
+		want  string
+	}{
+		{
+			name:  "model.onnx is preferred",
+			files: []string{"onnx/decoder.onnx", "onnx/model.onnx", "onnx/model_quantized.onnx"},
+			want:  "models/base-models/global/org/m0/onnx/model.onnx",
+		},
+		{
+			name:  "shallowest file",
+			files: []string{"a/b/model.onnx", "a/encoder.onnx"},
+			want:  "models/base-models/global/org/m0/a/encoder.onnx",
+		},
+		{
+			name:  "no onnx file",
+			files: []string{"model.safetensors"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &onnxDetector{}
+			got := d.detect(newTestModelTree(t, "", tc.files, nil))
+			if tc.want == "" {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, []detectedModel{
+				{
+					id:          "org/m0",
+					path:        "models/base-models/global/org/m0",
+					formats:     []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_ONNX},
+					formatPaths: map[v1.ModelFormat]string{v1.ModelFormat_MODEL_FORMAT_ONNX: tc.want},
+				},
+			}, toDetectedModels(got))
+		})
+	}
+}
+
+func TestMLXDetector(t *testing.T) {
+	tcs := []struct {
+		name     string
+		contents map[string]string
+		want     bool
+	}{
+		{
+			name: "quantized",
+			contents: map[string]string{
+				"config.json":       `{"quantization": {"group_size": 64, "bits": 4}}`,
+				"model.safetensors": "",
+			},
+			want: true,
+		},
+		{
+			name: "npz weights",
+			contents: map[string]string{
+				"config.json": `{}`,
+				"weights.npz": "",
+			},
+			want: true,
+		},
+		{
+			name: "hugging face",
+			contents: map[string]string{
+				"config.json":       `{}`,
+				"model.safetensors": "",
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &mlxDetector{}
+			got := d.detect(newTestModelTreeWithContents(t, tc.contents))
+			if !tc.want {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, []detectedModel{
+				{
+					id:          "org/m0",
+					path:        "models/base-models/global/org/m0",
+					formats:     []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_MLX},
+					formatPaths: map[v1.ModelFormat]string{v1.ModelFormat_MODEL_FORMAT_MLX: "models/base-models/global/org/m0"},
+				},
+			}, toDetectedModels(got))
+		})
+	}
+}
+
+func TestQuantizedSafetensorsDetector(t *testing.T) {
+	tcs := []struct {
+		name     string
+		contents map[string]string
+		want     v1.ModelFormat
+	}{
+		{
+			name: "gptq",
+			contents: map[string]string{
+				"config.json":       `{"quantization_config": {"quant_method": "gptq", "bits": 4}}`,
+				"model.safetensors": "",
+			},
+			want: v1.ModelFormat_MODEL_FORMAT_GPTQ,
+		},
+		{
+			name: "awq",
+			contents: map[string]string{
+				"config.json":       `{"quantization_config": {"quant_method": "awq"}}`,
+				"model.safetensors": "",
+			},
+			want: v1.ModelFormat_MODEL_FORMAT_AWQ,
+		},
+		{
+			name: "auto gptq",
+			contents: map[string]string{
+				"config.json":          `{}`,
+				"quantize_config.json": `{"bits": 4}`,
+				"model.safetensors":    "",
+			},
+			want: v1.ModelFormat_MODEL_FORMAT_GPTQ,
+		},
+		{
+			name: "other quantization",
+			contents: map[string]string{
+				"config.json":       `{"quantization_config": {"quant_method": "fp8"}}`,
+				"model.safetensors": "",
+			},
+		},
+		{
+			name: "no safetensors",
+			contents: map[string]string{
+				"config.json":       `{"quantization_config": {"quant_method": "gptq"}}`,
+				"pytorch_model.bin": "",
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &quantizedSafetensorsDetector{}
+			got := d.detect(newTestModelTreeWithContents(t, tc.contents))
+			if tc.want == v1.ModelFormat_MODEL_FORMAT_UNSPECIFIED {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, []detectedModel{
+				{
+					id:          "org/m0",
+					path:        "models/base-models/global/org/m0",
+					formats:     []v1.ModelFormat{tc.want},
+					formatPaths: map[v1.ModelFormat]string{tc.want: "models/base-models/global/org/m0"},
+				},
+			}, toDetectedModels(got))
+		})
+	}
+}
+
+func TestVLLMDetector(t *testing.T) {
+	tcs := []struct {
+		name     string
+		contents map[string]string
+		want     bool
+	}{
+		{
+			name: "vllm ready",
+			contents: map[string]string{
+				"config.json":       `{"architectures": ["LlamaForCausalLM"]}`,
+				"tokenizer.json":    `{}`,
+				"model.safetensors": "",
+			},
+			want: true,
+		},
+		{
+			name: "no tokenizer",
+			contents: map[string]string{
+				"config.json":       `{}`,
+				"model.safetensors": "",
+			},
+		},
+		{
+			name: "pytorch weights",
+			contents: map[string]string{
+				"config.json":       `{}`,
+				"tokenizer.model":   "",
+				"pytorch_model.bin": "",
+			},
+		},
+		{
+			name: "mlx",
+			contents: map[string]string{
+				"config.json":       `{"quantization": {"bits": 4}}`,
+				"tokenizer.json":    `{}`,
+				"model.safetensors": "",
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &vllmDetector{}
+			got := d.detect(newTestModelTreeWithContents(t, tc.contents))
+			if !tc.want {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, []detectedModel{
+				{
+					id:          "org/m0",
+					path:        "models/base-models/global/org/m0",
+					formats:     []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_VLLM},
+					formatPaths: map[v1.ModelFormat]string{v1.ModelFormat_MODEL_FORMAT_VLLM: "models/base-models/global/org/m0"},
+				},
+			}, toDetectedModels(got))
+		})
+	}
+}
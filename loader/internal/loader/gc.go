@@ -0,0 +1,151 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+)
+
+// OrphanObject is an object in the object store that is not owned by any model.
+type OrphanObject struct {
+	Key  string
+	Size int64
+}
+
+// collectGarbage deletes the objects of the deleted models and the uploads of the abandoned loadings
+// from the object store.
+func (l *L) collectGarbage(ctx context.Context) error {
+	actx := auth.AppendWorkerAuthorization(ctx)
+	for {
+		resp, err := l.modelClient.AcquireObjectDeletionTask(actx, &v1.AcquireObjectDeletionTaskRequest{
+			LoaderId: l.loaderID,
+		})
+		if err != nil {
+			if isUnimplementedError(err) {
+				// The server does not support garbage collection yet.
+				l.log.V(1).Info("Skipping garbage collection", "error", err)
+				return nil
+			}
+			return err
+		}
+		if resp.TaskId == 0 {
+			return nil
+		}
+
+		log := l.log.WithValues("path", resp.Path)
+		if resp.UploadsOnly {
+			// The loading was abandoned while being cancelled. Only delete the objects that the upload
+			// manifest records as the path can be shared with another model.
+			log.Info("Deleting the uploaded objects of an abandoned loading")
+			err = l.deleteUploadedObjects(ctx, resp.Path, log)
+		} else {
+			log.Info("Deleting the objects of a deleted model")
+			err = l.deleteModelObjects(ctx, resp.Path)
+		}
+		if err != nil {
+			// Leave the task uncompleted. It will be acquired again once the lease expires.
+			log.Error(err, "Failed to delete the objects")
+			continue
+		}
+
+		if _, err := l.modelClient.CompleteObjectDeletionTask(actx, &v1.CompleteObjectDeletionTaskRequest{
+			TaskId:   resp.TaskId,
+			LoaderId: l.loaderID,
+		}); err != nil {
+			return err
+		}
+		log.Info("Deleted the objects")
+	}
+}
+
+// deleteModelObjects deletes the objects of a model stored at the path, including the multipart uploads
+// that have not been completed and the upload manifest.
+func (l *L) deleteModelObjects(ctx context.Context, path string) error {
+	if err := l.deleteUploadedObjects(ctx, path, l.log); err != nil {
+		return fmt.Errorf("delete uploads: %s", err)
+	}
+
+	var keys []string
+	if err := l.s3Client.ListObjectsPages(ctx, l.objectStoreBucket, path+"/", func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			keys = append(keys, aws.ToString(obj.Key))
+		}
+		return true
+	}); err != nil {
+		return fmt.Errorf("list objects: %s", err)
+	}
+	// The path is the key of the model file itself if the model consists of a single file.
+	keys = append(keys, path)
+
+	for _, key := range keys {
+		if err := l.s3Client.DeleteObject(ctx, l.objectStoreBucket, key); err != nil {
+			return fmt.Errorf("delete object %q: %s", key, err)
+		}
+	}
+	return nil
+}
+
+// ScanOrphanObjects lists the objects under the object store path prefix that are not owned by any model
+// in the tenant. The objects are only reported and not deleted. Note that the objects of the models
+// that are being loaded are reported as well.
+func (l *L) ScanOrphanObjects(ctx context.Context) ([]OrphanObject, error) {
+	resp, err := l.modelClient.ListModelPaths(auth.AppendWorkerAuthorization(ctx), &v1.ListModelPathsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("list model paths: %s", err)
+	}
+
+	objs, err := l.listObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findOrphanObjects(objs, resp.Paths), nil
+}
+
+// listObjects lists the objects under the object store path prefix in the lexicographical order of their keys.
+func (l *L) listObjects(ctx context.Context) ([]types.Object, error) {
+	prefix := l.objectStorePathPrefix
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	var objs []types.Object
+	if err := l.s3Client.ListObjectsPages(ctx, l.objectStoreBucket, prefix, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		objs = append(objs, page.Contents...)
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("list objects: %s", err)
+	}
+	return objs, nil
+}
+
+// findOrphanObjects returns the objects that are not stored under any of the model paths.
+func findOrphanObjects(objs []types.Object, modelPaths []string) []OrphanObject {
+	var orphans []OrphanObject
+	for _, obj := range objs {
+		key := aws.ToString(obj.Key)
+		if isOwnedObject(key, modelPaths) {
+			continue
+		}
+		orphans = append(orphans, OrphanObject{
+			Key:  key,
+			Size: aws.ToInt64(obj.Size),
+		})
+	}
+	return orphans
+}
+
+// isOwnedObject returns true if the object is stored under any of the model paths.
+func isOwnedObject(key string, modelPaths []string) bool {
+	for _, p := range modelPaths {
+		if key == p || key == uploadManifestKey(p) || strings.HasPrefix(key, p+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package loader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectGarbage(t *testing.T) {
+	s3Client := &mockS3Client{
+		objects: map[string]*mockS3Object{
+			"models/base-models/global/m0/config.json":       {data: []byte("{}")},
+			"models/base-models/global/m0/model.safetensors": {data: []byte("data")},
+			"models/base-models/global/m0.upload-manifest.json": {
+				data: []byte(`{"files":{}}`),
+			},
+			"models/base-models/global/m01/config.json": {data: []byte("{}")},
+			"models/t0/p0/ft:m1/adapter.bin":            {data: []byte("data")},
+		},
+	}
+	mc := NewFakeModelClient()
+	mc.deletedModelPaths = []string{
+		"models/base-models/global/m0",
+		"models/t0/p0/ft:m1",
+	}
+
+	ld := New("bucket", "models", "base-models", &fakeDownloaderFactory{}, s3Client, mc, "loader0", "cluster0", 1, testr.New(t))
+	err := ld.collectGarbage(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"models/base-models/global/m0", "models/t0/p0/ft:m1"}, mc.completedTaskPaths)
+	// The object of the model whose path only shares the prefix is kept.
+	var keys []string
+	for k := range s3Client.objects {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"models/base-models/global/m01/config.json"}, keys)
+}
+
+func TestCollectGarbage_UploadsOnly(t *testing.T) {
+	s3Client := &mockS3Client{
+		objects: map[string]*mockS3Object{
+			"models/base-models/global/m0/config.json":       {data: []byte("{}")},
+			"models/base-models/global/m0/model.safetensors": {data: []byte("data")},
+			"models/base-models/global/m0.upload-manifest.json": {
+				data: []byte(`{"files":{"models/base-models/global/m0/model.safetensors":{"size":4,"completed":true}}}`),
+			},
+		},
+	}
+	mc := NewFakeModelClient()
+	mc.deletedModelPaths = []string{"models/base-models/global/m0"}
+	mc.uploadsOnlyPaths = map[string]bool{"models/base-models/global/m0": true}
+
+	ld := New("bucket", "models", "base-models", &fakeDownloaderFactory{}, s3Client, mc, "loader0", "cluster0", 1, testr.New(t))
+	err := ld.collectGarbage(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"models/base-models/global/m0"}, mc.completedTaskPaths)
+	// Only the objects recorded in the upload manifest and the manifest itself are deleted.
+	var keys []string
+	for k := range s3Client.objects {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"models/base-models/global/m0/config.json"}, keys)
+}
+
+func TestCollectGarbage_Unimplemented(t *testing.T) {
+	s3Client := &mockS3Client{
+		objects: map[string]*mockS3Object{
+			"models/base-models/global/m0/config.json": {data: []byte("{}")},
+		},
+	}
+	mc := NewFakeModelClient()
+	mc.gcUnimplemented = true
+
+	// A server that does not support garbage collection does not fail the loader.
+	ld := New("bucket", "models", "base-models", &fakeDownloaderFactory{}, s3Client, mc, "loader0", "cluster0", 1, testr.New(t))
+	err := ld.collectGarbage(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, s3Client.objects, 1)
+
+	err = ld.reconcile(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, mc.degradationUpdates)
+}
+
+func TestScanOrphanObjects(t *testing.T) {
+	s3Client := &mockS3Client{
+		objects: map[string]*mockS3Object{
+			"models/base-models/global/m0/config.json": {data: []byte("{}")},
+			"models/base-models/global/m1/config.json": {data: []byte("{}")},
+			"models/base-models/global/m1.upload-manifest.json": {
+				data: []byte(`{"files":{}}`),
+			},
+			"models/base-models/global/m2/model.gguf": {data: []byte("data")},
+			"models/t0/p0/ft:m3/adapter.bin":          {data: []byte("data")},
+			"other/file":                              {data: []byte("data")},
+		},
+	}
+	mc := NewFakeModelClient()
+	mc.pathsByID["m0"] = "models/base-models/global/m0"
+	mc.pathsByID["m1"] = "models/base-models/global/m1"
+
+	ld := New("bucket", "models", "base-models", &fakeDownloaderFactory{}, s3Client, mc, "loader0", "cluster0", 1, testr.New(t))
+	got, err := ld.ScanOrphanObjects(context.Background())
+	assert.NoError(t, err)
+	want := []OrphanObject{
+		{Key: "models/base-models/global/m2/model.gguf", Size: 4},
+		{Key: "models/t0/p0/ft:m3/adapter.bin", Size: 4},
+	}
+	assert.Equal(t, want, got)
+	// Nothing has been deleted.
+	assert.Len(t, s3Client.objects, 6)
+}
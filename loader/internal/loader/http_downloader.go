@@ -0,0 +1,219 @@
+package loader
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/model-manager/common/pkg/netaddr"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
+	"github.com/llmariner/model-manager/loader/internal/archive"
+	"github.com/llmariner/model-manager/loader/internal/config"
+	"github.com/llmariner/model-manager/loader/internal/download"
+)
+
+// NewHTTPDownloader creates a new HTTPDownloader.
+func NewHTTPDownloader(c config.HTTPDownloaderConfig, log logr.Logger) *HTTPDownloader {
+	log = log.WithName("http")
+	d := &HTTPDownloader{
+		config: c,
+		log:    log,
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if !c.AllowPrivateNetworks {
+		// The addresses are checked after DNS resolution so that a public host name cannot
+		// resolve to an internal address.
+		dialer.Control = rejectNonPublicAddress
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	if !c.AllowPrivateNetworks {
+		// A proxy would dial the internal addresses on behalf of the loader.
+		transport.Proxy = nil
+	}
+	d.httpClient = &http.Client{
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxHTTPRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxHTTPRedirects)
+			}
+			return d.validateURL(req.URL)
+		},
+	}
+	d.downloader = download.New(d.httpClient, log)
+	return d
+}
+
+const maxHTTPRedirects = 10
+
+// HTTPDownloader downloads a model file from an HTTP(S) URL. If the file is a .tar, .tar.gz, .tgz or .zip
+// archive, it is unpacked into the destination directory.
+type HTTPDownloader struct {
+	config     config.HTTPDownloaderConfig
+	httpClient *http.Client
+	downloader *download.Downloader
+	log        logr.Logger
+}
+
+// validateURL returns an error if the scheme or the host of the URL is not allowed.
+func (d *HTTPDownloader) validateURL(u *url.URL) error {
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !d.config.Insecure {
+			return fmt.Errorf("insecure URL %q is not allowed", u.Redacted())
+		}
+	default:
+		return fmt.Errorf("unsupported URL scheme %q", u.Scheme)
+	}
+	if !d.config.IsAllowedHost(u.Hostname()) {
+		return fmt.Errorf("host %q is not allowed", u.Hostname())
+	}
+	return nil
+}
+
+// rejectNonPublicAddress is a dialer control function that rejects connections to addresses
+// that are not publicly routable.
+func rejectNonPublicAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !netaddr.IsPublic(addr) {
+		return fmt.Errorf("connection to non-public address %s is not allowed", addr)
+	}
+	return nil
+}
+
+// download downloads the file at the URL that modelPath specifies.
+func (d *HTTPDownloader) download(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter, destDir string) error {
+	return d.downloadWithChecksum(ctx, modelPath, filename, "", filter, destDir)
+}
+
+// downloadWithChecksum downloads the file and verifies that it has the checksum. The checksum is not verified
+// if it is empty.
+func (d *HTTPDownloader) downloadWithChecksum(ctx context.Context, modelPath, filename, checksum string, filter *pathfilter.Filter, destDir string) error {
+	name, err := d.fileNameFromURL(modelPath)
+	if err != nil {
+		return err
+	}
+	var sha string
+	if checksum != "" {
+		var ok bool
+		sha, ok = strings.CutPrefix(checksum, "sha256:")
+		if !ok {
+			return fmt.Errorf("unsupported checksum %q", checksum)
+		}
+	}
+	isArchive := archive.IsArchive(name)
+	if !isArchive && !filter.Match(name) {
+		return fmt.Errorf("%q does not match the file patterns", name)
+	}
+
+	// The size is used to detect incomplete downloads. It is not available if the server does not
+	// send the content length.
+	size, err := d.contentLength(ctx, modelPath)
+	if err != nil {
+		d.log.Error(err, "Failed to get the file size", "url", modelPath)
+	}
+
+	// Archives are kept in the download cache so that they are not uploaded.
+	destPath := filepath.Join(destDir, name)
+	if isArchive {
+		destPath = filepath.Join(destDir, httpDownloadCache, name)
+	}
+	sum := sha256.Sum256([]byte(modelPath))
+	incompletePath := filepath.Join(destDir, httpDownloadCache, hex.EncodeToString(sum[:])+".incomplete")
+
+	d.log.Info("Downloading the file", "url", modelPath, "size", size)
+	if err := d.downloader.Download(ctx, download.File{
+		URL:    modelPath,
+		Size:   size,
+		SHA256: strings.ToLower(sha),
+	}, destPath, incompletePath); err != nil {
+		return fmt.Errorf("download %s: %w", name, err)
+	}
+	if !isArchive {
+		return nil
+	}
+
+	d.log.Info("Extracting the archive", "name", name)
+	if err := archive.Extract(destPath, destDir, filter.Match); err != nil {
+		return fmt.Errorf("extract %s: %s", name, err)
+	}
+	if err := os.Remove(destPath); err != nil {
+		return fmt.Errorf("remove archive: %s", err)
+	}
+	return nil
+}
+
+// modelSize returns the size of the file. An archive is counted with its compressed size.
+func (d *HTTPDownloader) modelSize(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter) (int64, error) {
+	if _, err := d.fileNameFromURL(modelPath); err != nil {
+		return 0, err
+	}
+	size, err := d.contentLength(ctx, modelPath)
+	if err != nil {
+		return 0, err
+	}
+	if size == 0 {
+		return 0, fmt.Errorf("content length is unknown")
+	}
+	return size, nil
+}
+
+// contentLength sends a HEAD request and returns the content length. Zero is returned if it is unknown.
+func (d *HTTPDownloader) contentLength(ctx context.Context, rawURL string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, rawURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("create request: %s", err)
+	}
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status %q", resp.Status)
+	}
+	if resp.ContentLength < 0 {
+		return 0, nil
+	}
+	return resp.ContentLength, nil
+}
+
+// fileNameFromURL validates the URL and returns the last element of its path.
+func (d *HTTPDownloader) fileNameFromURL(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %s", rawURL, err)
+	}
+	if err := d.validateURL(u); err != nil {
+		return "", err
+	}
+	name := path.Base(u.Path)
+	if name == "." || name == "/" || name == ".." {
+		return "", fmt.Errorf("URL %q does not have a file name", rawURL)
+	}
+	return name, nil
+}
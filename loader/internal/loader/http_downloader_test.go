@@ -0,0 +1,320 @@
+package loader
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
+	"github.com/llmariner/model-manager/loader/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// testHTTPDownloaderConfig allows the downloads from the test servers.
+var testHTTPDownloaderConfig = config.HTTPDownloaderConfig{
+	AllowedHosts:         []string{"127.0.0.1"},
+	Insecure:             true,
+	AllowPrivateNetworks: true,
+}
+
+// newFakeHTTPServer returns a server that serves the files at their paths.
+func newFakeHTTPServer(t *testing.T, files map[string][]byte) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, r.URL.Path, time.Time{}, bytes.NewReader(b))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestTarGz(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, content := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})
+		assert.NoError(t, err)
+		_, err = tw.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func sha256Checksum(b []byte) string {
+	sum := sha256.Sum256(b)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func TestHTTPDownload(t *testing.T) {
+	gguf := []byte("weights")
+	archive := newTestTarGz(t, map[string]string{
+		"model/config.json":       "{}",
+		"model/model.safetensors": "weights",
+	})
+	srv := newFakeHTTPServer(t, map[string][]byte{
+		"/models/phi-4.gguf":   gguf,
+		"/models/model.tar.gz": archive,
+	})
+
+	tcs := []struct {
+		name     string
+		url      string
+		checksum string
+		exclude  []string
+		want     []string
+		wantSize int64
+		wantErr  bool
+	}{
+		{
+			name:     "single file",
+			url:      srv.URL + "/models/phi-4.gguf",
+			checksum: sha256Checksum(gguf),
+			want:     []string{"phi-4.gguf"},
+			wantSize: int64(len(gguf)),
+		},
+		{
+			name:     "archive",
+			url:      srv.URL + "/models/model.tar.gz",
+			checksum: sha256Checksum(archive),
+			want:     []string{"model/config.json", "model/model.safetensors"},
+			wantSize: int64(len(archive)),
+		},
+		{
+			name:     "archive with file patterns",
+			url:      srv.URL + "/models/model.tar.gz",
+			exclude:  []string{"*.json"},
+			want:     []string{"model/model.safetensors"},
+			wantSize: int64(len(archive)),
+		},
+		{
+			name:     "checksum mismatch",
+			url:      srv.URL + "/models/phi-4.gguf",
+			checksum: sha256Checksum([]byte("other")),
+			wantSize: int64(len(gguf)),
+			wantErr:  true,
+		},
+		{
+			name:     "excluded file",
+			url:      srv.URL + "/models/phi-4.gguf",
+			exclude:  []string{"*.gguf"},
+			wantSize: int64(len(gguf)),
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewHTTPDownloader(testHTTPDownloaderConfig, testr.New(t))
+			d.downloader.RetryDelay = time.Millisecond
+			destDir := t.TempDir()
+			filter, err := pathfilter.New(nil, tc.exclude)
+			assert.NoError(t, err)
+
+			size, err := d.modelSize(context.Background(), tc.url, "", filter)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantSize, size)
+
+			err = d.downloadWithChecksum(context.Background(), tc.url, "", tc.checksum, filter, destDir)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var got []string
+			err = filepath.Walk(destDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !info.IsDir() && !isInDownloadCache(path) {
+					rel, err := filepath.Rel(destDir, path)
+					if err != nil {
+						return err
+					}
+					got = append(got, filepath.ToSlash(rel))
+				}
+				return nil
+			})
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
+
+func TestFileNameFromURL(t *testing.T) {
+	tcs := []struct {
+		url     string
+		want    string
+		wantErr bool
+	}{
+		{
+			url:  "https://example.com/models/phi-4.gguf",
+			want: "phi-4.gguf",
+		},
+		{
+			url:  "https://files.example.org/model.tar.gz?token=abc",
+			want: "model.tar.gz",
+		},
+		{
+			url:     "https://example.com/",
+			wantErr: true,
+		},
+		{
+			url:     "ftp://example.com/model.gguf",
+			wantErr: true,
+		},
+		{
+			// Insecure URLs are not allowed.
+			url:     "http://example.com/model.gguf",
+			wantErr: true,
+		},
+		{
+			url:     "https://example.net/model.gguf",
+			wantErr: true,
+		},
+	}
+	d := NewHTTPDownloader(config.HTTPDownloaderConfig{
+		AllowedHosts: []string{"example.com", "*.example.org"},
+	}, testr.New(t))
+	for _, tc := range tcs {
+		t.Run(tc.url, func(t *testing.T) {
+			got, err := d.fileNameFromURL(tc.url)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestHTTPDownloader_PrivateNetworks(t *testing.T) {
+	gguf := []byte("weights")
+	srv := newFakeHTTPServer(t, map[string][]byte{
+		"/models/phi-4.gguf": gguf,
+	})
+	c := testHTTPDownloaderConfig
+	c.AllowPrivateNetworks = false
+	d := NewHTTPDownloader(c, testr.New(t))
+	d.downloader.RetryDelay = time.Millisecond
+	filter, err := pathfilter.New(nil, nil)
+	assert.NoError(t, err)
+
+	// The test server listens on a loopback address.
+	_, err = d.modelSize(context.Background(), srv.URL+"/models/phi-4.gguf", "", filter)
+	assert.Error(t, err)
+	err = d.downloadWithChecksum(context.Background(), srv.URL+"/models/phi-4.gguf", "", "", filter, t.TempDir())
+	assert.Error(t, err)
+}
+
+func TestHTTPDownloader_Proxy(t *testing.T) {
+	tcs := []struct {
+		name                 string
+		allowPrivateNetworks bool
+		wantProxy            bool
+	}{
+		{
+			name:                 "private networks allowed",
+			allowPrivateNetworks: true,
+			wantProxy:            true,
+		},
+		{
+			// The addresses could not be checked if the proxy dialed them.
+			name:                 "private networks not allowed",
+			allowPrivateNetworks: false,
+			wantProxy:            false,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			c := testHTTPDownloaderConfig
+			c.AllowPrivateNetworks = tc.allowPrivateNetworks
+			d := NewHTTPDownloader(c, testr.New(t))
+			transport, ok := d.httpClient.Transport.(*http.Transport)
+			assert.True(t, ok)
+			assert.Equal(t, tc.wantProxy, transport.Proxy != nil)
+		})
+	}
+}
+
+func TestHTTPDownloader_Redirect(t *testing.T) {
+	srv := newFakeHTTPServer(t, map[string][]byte{
+		"/models/phi-4.gguf": []byte("weights"),
+	})
+	redirect := httptest.NewServer(http.RedirectHandler("https://example.net/phi-4.gguf", http.StatusFound))
+	t.Cleanup(redirect.Close)
+	d := NewHTTPDownloader(testHTTPDownloaderConfig, testr.New(t))
+	filter, err := pathfilter.New(nil, nil)
+	assert.NoError(t, err)
+
+	// The redirect target is not an allowed host.
+	_, err = d.modelSize(context.Background(), redirect.URL+"/phi-4.gguf", "", filter)
+	assert.Error(t, err)
+	_, err = d.modelSize(context.Background(), srv.URL+"/models/phi-4.gguf", "", filter)
+	assert.NoError(t, err)
+}
+
+func TestLoadBaseModel_HTTP(t *testing.T) {
+	gguf := []byte("weights")
+	srv := newFakeHTTPServer(t, map[string][]byte{
+		"/models/phi-4-Q4_K_M.gguf": gguf,
+	})
+
+	s3Client := &mockS3Client{}
+	mc := NewFakeModelClient()
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{d: NewHTTPDownloader(testHTTPDownloaderConfig, testr.New(t))},
+		s3Client,
+		mc,
+		"loader0",
+		"cluster0",
+		1,
+		testr.New(t),
+	)
+	url := srv.URL + "/models/phi-4-Q4_K_M.gguf"
+	err := ld.loadBaseModel(context.Background(), "phi-4", "", nil, url, sha256Checksum(gguf), "", v1.SourceRepository_SOURCE_REPOSITORY_HTTP, nil)
+	assert.NoError(t, err)
+
+	want := []string{
+		"models/base-models/global/phi-4/phi-4-Q4_K_M.gguf",
+	}
+	assert.ElementsMatch(t, want, s3Client.uploadedKeys)
+
+	got, err := mc.GetBaseModelPath(context.Background(), &v1.GetBaseModelPathRequest{
+		Id: "phi-4",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "models/base-models/global/phi-4", got.Path)
+	assert.Equal(t, "models/base-models/global/phi-4/phi-4-Q4_K_M.gguf", got.GgufModelPath)
+
+	// The URL is required.
+	err = ld.loadBaseModel(context.Background(), "phi-5", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_HTTP, nil)
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "model file location is required"))
+}
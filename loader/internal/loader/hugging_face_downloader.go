@@ -1,54 +1,115 @@
 package loader
 
 import (
-	"bytes"
 	"context"
-	"errors"
 	"fmt"
-	"os/exec"
+	"os"
+	"path/filepath"
 
 	"github.com/go-logr/logr"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
+	"github.com/llmariner/model-manager/loader/internal/huggingface"
 )
 
 // NewHuggingFaceDownloader creates a new HuggingFaceDownloader.
-func NewHuggingFaceDownloader(cacheDir string, log logr.Logger) *HuggingFaceDownloader {
+func NewHuggingFaceDownloader(concurrency int, log logr.Logger) *HuggingFaceDownloader {
+	// Use the same environment variable as the huggingface_hub library to override the endpoint.
+	endpoint := os.Getenv("HF_ENDPOINT")
+	if endpoint == "" {
+		endpoint = huggingface.DefaultEndpoint
+	}
+	log = log.WithName("huggingface")
 	return &HuggingFaceDownloader{
-		cacheDir: cacheDir,
-		log:      log.WithName("huggingface"),
+		client:      huggingface.NewClient(endpoint, huggingFaceToken(), log),
+		concurrency: concurrency,
+		log:         log,
 	}
 }
 
 // HuggingFaceDownloader downloads models from Hugging Face.
 type HuggingFaceDownloader struct {
-	cacheDir string
-	log      logr.Logger
-}
-
-func (h *HuggingFaceDownloader) download(ctx context.Context, modelPath, filename, destDir string) error {
-	// Download the image with huggingface-cli. If the access to the specified model is gated,
-	// the environment variable HUGGING_FACE_HUB_TOKEN needs to be set.
-	cmdline := []string{
-		"huggingface-cli",
-		"download",
-		modelPath,
-	}
-	if filename != "" {
-		cmdline = append(cmdline, filename)
-	}
-
-	cmdline = append(cmdline,
-		fmt.Sprintf("--local-dir=%s", destDir),
-		fmt.Sprintf("--cache-dir=%s", h.cacheDir),
-		"--local-dir-use-symlinks=True",
-		"--quiet",
-	)
-	h.log.Info("Downloading the image with command", "cmd", cmdline)
-	cmd := exec.CommandContext(ctx, cmdline[0], cmdline[1:]...)
-	var errb bytes.Buffer
-	cmd.Stderr = &errb
-	if err := cmd.Run(); err != nil {
-		h.log.Error(errors.New(errb.String()), "Failed to download the image")
-		return fmt.Errorf("download: %s", err)
-	}
-	return nil
+	client *huggingface.Client
+	// concurrency is the maximum number of files downloaded in parallel.
+	concurrency int
+	log         logr.Logger
+}
+
+func (h *HuggingFaceDownloader) download(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter, destDir string) error {
+	_, err := h.downloadRevision(ctx, modelPath, filename, "", filter, destDir)
+	return err
+}
+
+// downloadRevision downloads the files of the repository at the given revision and returns the commit SHA
+// that the revision is resolved to.
+//
+// Files are first written under the Hugging Face download cache directory in destDir so that
+// incomplete files are not uploaded.
+func (h *HuggingFaceDownloader) downloadRevision(ctx context.Context, modelPath, filename, revision string, filter *pathfilter.Filter, destDir string) (string, error) {
+	info, err := h.listFiles(ctx, modelPath, filename, revision, filter)
+	if err != nil {
+		return "", err
+	}
+	h.log.Info("Downloading the model", "modelPath", modelPath, "sha", info.SHA, "files", len(info.Files))
+
+	if err := runInParallel(ctx, h.concurrency, len(info.Files), func(ctx context.Context, i int) error {
+		f := info.Files[i]
+		destPath := filepath.Join(destDir, filepath.FromSlash(f.Path))
+		incompletePath := filepath.Join(destDir, huggingFaceDownloadCache, filepath.FromSlash(f.Path)+".incomplete")
+		return h.client.DownloadFile(ctx, modelPath, info.SHA, f, destPath, incompletePath)
+	}); err != nil {
+		return "", err
+	}
+	return info.SHA, nil
+}
+
+// modelSize returns the total size of the files in the repository by querying the Hugging Face Hub API.
+func (h *HuggingFaceDownloader) modelSize(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter) (int64, error) {
+	return h.modelSizeRevision(ctx, modelPath, filename, "", filter)
+}
+
+// modelSizeRevision returns the total size of the files in the repository at the given revision.
+func (h *HuggingFaceDownloader) modelSizeRevision(ctx context.Context, modelPath, filename, revision string, filter *pathfilter.Filter) (int64, error) {
+	info, err := h.listFiles(ctx, modelPath, filename, revision, filter)
+	if err != nil {
+		return 0, err
+	}
+	return info.TotalSize(), nil
+}
+
+// listFiles returns the files to be downloaded. Only the given file is returned if filename is not empty.
+// Otherwise, the files that match the filter are returned.
+func (h *HuggingFaceDownloader) listFiles(ctx context.Context, modelPath, filename, revision string, filter *pathfilter.Filter) (*huggingface.RepoInfo, error) {
+	info, err := h.client.GetRepoInfo(ctx, modelPath, revision)
+	if err != nil {
+		return nil, err
+	}
+	if filename == "" {
+		var files []huggingface.File
+		for _, f := range info.Files {
+			if filter.Match(f.Path) {
+				files = append(files, f)
+			}
+		}
+		if len(files) == 0 && !filter.IsEmpty() {
+			return nil, fmt.Errorf("no files in %q match the file patterns", modelPath)
+		}
+		info.Files = files
+		return info, nil
+	}
+
+	for _, f := range info.Files {
+		if f.Path == filename {
+			info.Files = []huggingface.File{f}
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("file %q is not found in %q", filename, modelPath)
+}
+
+// huggingFaceToken returns the access token for gated models.
+func huggingFaceToken() string {
+	if t := os.Getenv("HUGGING_FACE_HUB_TOKEN"); t != "" {
+		return t
+	}
+	return os.Getenv("HF_TOKEN")
 }
@@ -0,0 +1,178 @@
+package loader
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
+	"github.com/stretchr/testify/assert"
+)
+
+const testHuggingFaceSHA = "0123456789abcdef0123456789abcdef01234567"
+
+func newFakeHuggingFaceHub(t *testing.T) *httptest.Server {
+	files := map[string]string{
+		"config.json":       "{}",
+		"model.safetensors": "weights",
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/models/google/gemma-2b/revision/main", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("blobs") != "true" {
+			http.Error(w, "blobs must be set", http.StatusBadRequest)
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"sha":"` + testHuggingFaceSHA + `","siblings":[{"rfilename":"config.json","size":2},{"rfilename":"model.safetensors","size":7}]}`))
+	})
+	mux.HandleFunc("/api/models/google/gemma-2b/revision/v1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"sha":"` + testHuggingFaceSHA + `","siblings":[{"rfilename":"config.json","size":2}]}`))
+	})
+	mux.HandleFunc("/google/gemma-2b/resolve/"+testHuggingFaceSHA+"/{path...}", func(w http.ResponseWriter, r *http.Request) {
+		b, ok := files[r.PathValue("path")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, r.PathValue("path"), time.Time{}, bytes.NewReader([]byte(b)))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHuggingFaceDownload(t *testing.T) {
+	srv := newFakeHuggingFaceHub(t)
+	t.Setenv("HF_ENDPOINT", srv.URL)
+	t.Setenv("HUGGING_FACE_HUB_TOKEN", "token")
+
+	tcs := []struct {
+		name     string
+		filename string
+		exclude  []string
+		want     []string
+	}{
+		{
+			name: "all files",
+			want: []string{"config.json", "model.safetensors"},
+		},
+		{
+			name:     "specific file",
+			filename: "model.safetensors",
+			want:     []string{"model.safetensors"},
+		},
+		{
+			name:    "file patterns",
+			exclude: []string{"*.json"},
+			want:    []string{"model.safetensors"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewHuggingFaceDownloader(2, testr.New(t))
+			destDir := t.TempDir()
+			filter, err := pathfilter.New(nil, tc.exclude)
+			assert.NoError(t, err)
+			sha, err := d.downloadRevision(context.Background(), "google/gemma-2b", tc.filename, "", filter, destDir)
+			assert.NoError(t, err)
+			assert.Equal(t, testHuggingFaceSHA, sha)
+
+			var got []string
+			err = filepath.Walk(destDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !info.IsDir() {
+					rel, err := filepath.Rel(destDir, path)
+					if err != nil {
+						return err
+					}
+					got = append(got, rel)
+				}
+				return nil
+			})
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
+
+func TestHuggingFaceModelSize(t *testing.T) {
+	srv := newFakeHuggingFaceHub(t)
+	t.Setenv("HF_ENDPOINT", srv.URL)
+	t.Setenv("HUGGING_FACE_HUB_TOKEN", "token")
+
+	tcs := []struct {
+		name      string
+		modelPath string
+		filename  string
+		revision  string
+		include   []string
+		want      int64
+		wantErr   bool
+	}{
+		{
+			name:      "all files",
+			modelPath: "google/gemma-2b",
+			want:      9,
+		},
+		{
+			name:      "specific file",
+			modelPath: "google/gemma-2b",
+			filename:  "model.safetensors",
+			want:      7,
+		},
+		{
+			name:      "file patterns",
+			modelPath: "google/gemma-2b",
+			include:   []string{"*.json"},
+			want:      2,
+		},
+		{
+			name:      "revision",
+			modelPath: "google/gemma-2b",
+			revision:  "v1",
+			want:      2,
+		},
+		{
+			name:      "no matching file",
+			modelPath: "google/gemma-2b",
+			include:   []string{"*.gguf"},
+			wantErr:   true,
+		},
+		{
+			name:      "unknown file",
+			modelPath: "google/gemma-2b",
+			filename:  "unknown.gguf",
+			wantErr:   true,
+		},
+		{
+			name:      "unknown model",
+			modelPath: "google/unknown",
+			wantErr:   true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewHuggingFaceDownloader(1, testr.New(t))
+			filter, err := pathfilter.New(tc.include, nil)
+			assert.NoError(t, err)
+			got, err := d.modelSizeRevision(context.Background(), tc.modelPath, tc.filename, tc.revision, filter)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
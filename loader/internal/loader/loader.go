@@ -2,18 +2,35 @@ package loader
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
+	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/avast/retry-go"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/go-logr/logr"
 	v1 "github.com/llmariner/model-manager/api/v1"
 	"github.com/llmariner/model-manager/common/pkg/id"
+	"github.com/llmariner/model-manager/common/pkg/lease"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
 	"github.com/llmariner/model-manager/loader/internal/config"
+	"github.com/llmariner/model-manager/loader/internal/download"
+	"github.com/llmariner/model-manager/loader/internal/gguf"
+	"github.com/llmariner/model-manager/loader/internal/huggingface"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -22,6 +39,10 @@ import (
 
 const (
 	huggingFaceDownloadCache = ".cache/huggingface/download"
+	// ociDownloadCache is the directory where incomplete layers of OCI artifacts are written.
+	ociDownloadCache = ".cache/oci/download"
+	// httpDownloadCache is the directory where incomplete files and archives downloaded over HTTP are written.
+	httpDownloadCache = ".cache/http/download"
 
 	// projectDirForGlobalScopedModel is the project directory for global scoped based models.
 	projectDirForGlobalScopedModel = "global"
@@ -29,28 +50,257 @@ const (
 
 // ModelDownloader is an interface for downloading a model.
 type ModelDownloader interface {
-	download(ctx context.Context, modelPath, filename, destDir string) error
+	// download downloads the model files that match the filter to destDir.
+	download(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter, destDir string) error
+}
+
+// modelSizer is an optional interface of ModelDownloader that returns the total size of the model files
+// before downloading them.
+type modelSizer interface {
+	modelSize(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter) (int64, error)
+}
+
+// revisionModelSizer is an optional interface of ModelDownloader that returns the total size of the model
+// files at a specific revision.
+type revisionModelSizer interface {
+	modelSizeRevision(ctx context.Context, modelPath, filename, revision string, filter *pathfilter.Filter) (int64, error)
+}
+
+// revisionDownloader is an optional interface of ModelDownloader that downloads a specific revision of
+// the model.
+type revisionDownloader interface {
+	// downloadRevision downloads the model at the given revision and returns the commit SHA that the
+	// revision is resolved to. The default branch is downloaded if the revision is empty.
+	downloadRevision(ctx context.Context, modelPath, filename, revision string, filter *pathfilter.Filter, destDir string) (string, error)
+}
+
+// checksumDownloader is an optional interface of ModelDownloader that verifies the checksum of the
+// downloaded model.
+type checksumDownloader interface {
+	// downloadWithChecksum downloads the model and verifies that it has the checksum ("sha256:<hex>").
+	// The checksum is not verified if it is empty.
+	downloadWithChecksum(ctx context.Context, modelPath, filename, checksum string, filter *pathfilter.Filter, destDir string) error
 }
 
 // modelDownloaderFactory is the factory for ModelDownloader.
 type modelDownloaderFactory interface {
 	Create(context.Context, v1.SourceRepository) (ModelDownloader, error)
+	// SupportedSourceRepositories returns the source repositories that the factory can create downloaders for.
+	// All source repositories are supported if empty.
+	SupportedSourceRepositories() []v1.SourceRepository
 }
 
 // S3Client is an interface for uploading a file to S3.
 type S3Client interface {
 	Upload(ctx context.Context, r io.Reader, bucket, key string) error
+	Download(ctx context.Context, w io.WriterAt, bucket, key string) error
+	HeadObject(ctx context.Context, bucket, key string) (*s3.HeadObjectOutput, error)
+
+	CreateMultipartUpload(ctx context.Context, bucket, key string, metadata map[string]string) (string, error)
+	UploadPart(ctx context.Context, r io.ReadSeeker, bucket, key, uploadID string, partNumber int32, checksumSHA256 string) (string, error)
+	ListParts(ctx context.Context, bucket, key, uploadID string) ([]types.Part, error)
+	CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string, parts []types.CompletedPart) error
+	AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error
+
+	DeleteObject(ctx context.Context, bucket, key string) error
+	ListObjectsPages(ctx context.Context, bucket, prefix string, f func(page *s3.ListObjectsV2Output, lastPage bool) bool) error
 }
 
-// NoopS3Client is a no-op S3 client.
+// NoopS3Client is an S3 client that does not store the content of model files. It keeps the objects uploaded
+// with Upload and the sizes and the checksums of the objects uploaded with multipart uploads in memory so that
+// the uploads can be verified in the same way as the ones to S3.
 type NoopS3Client struct {
+	objects    map[string]*noopS3Object
+	uploads    map[string]map[int32]*noopS3Part
+	numUploads int
+
+	mu sync.Mutex
+}
+
+type noopS3Object struct {
+	// data is nil for the objects uploaded with multipart uploads.
+	data           []byte
+	size           int64
+	checksumSHA256 string
+}
+
+type noopS3Part struct {
+	size           int64
+	checksumSHA256 string
+}
+
+// lock locks mu and initializes the maps. The caller must unlock mu.
+func (c *NoopS3Client) lock() {
+	c.mu.Lock()
+	if c.objects == nil {
+		c.objects = map[string]*noopS3Object{}
+	}
+	if c.uploads == nil {
+		c.uploads = map[string]map[int32]*noopS3Part{}
+	}
 }
 
 // Upload uploads a file to S3.
 func (c *NoopS3Client) Upload(ctx context.Context, r io.Reader, bucket, key string) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	c.lock()
+	defer c.mu.Unlock()
+	c.objects[key] = &noopS3Object{data: b, size: int64(len(b))}
+	return nil
+}
+
+// Download downloads a file from S3. Nothing is written for the objects uploaded with multipart uploads.
+func (c *NoopS3Client) Download(ctx context.Context, w io.WriterAt, bucket, key string) error {
+	c.lock()
+	defer c.mu.Unlock()
+	o, ok := c.objects[key]
+	if !ok {
+		return &types.NoSuchKey{}
+	}
+	_, err := w.WriteAt(o.data, 0)
+	return err
+}
+
+// HeadObject returns the metadata of an object.
+func (c *NoopS3Client) HeadObject(ctx context.Context, bucket, key string) (*s3.HeadObjectOutput, error) {
+	c.lock()
+	defer c.mu.Unlock()
+	o, ok := c.objects[key]
+	if !ok {
+		return nil, &types.NotFound{}
+	}
+	resp := &s3.HeadObjectOutput{
+		ContentLength: aws.Int64(o.size),
+	}
+	if o.checksumSHA256 != "" {
+		resp.ChecksumSHA256 = aws.String(o.checksumSHA256)
+	}
+	return resp, nil
+}
+
+// CreateMultipartUpload initiates a multipart upload.
+func (c *NoopS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string, metadata map[string]string) (string, error) {
+	c.lock()
+	defer c.mu.Unlock()
+	uploadID := fmt.Sprintf("noop-%d", c.numUploads)
+	c.numUploads++
+	c.uploads[uploadID] = map[int32]*noopS3Part{}
+	return uploadID, nil
+}
+
+// UploadPart uploads a part of a multipart upload. The part is rejected if its content does not match the checksum.
+func (c *NoopS3Client) UploadPart(ctx context.Context, r io.ReadSeeker, bucket, key, uploadID string, partNumber int32, checksumSHA256 string) (string, error) {
+	h := sha256.New()
+	n, err := io.Copy(h, r)
+	if err != nil {
+		return "", err
+	}
+	if got := base64.StdEncoding.EncodeToString(h.Sum(nil)); got != checksumSHA256 {
+		return "", fmt.Errorf("checksum mismatch of part %d: got %s, want %s", partNumber, got, checksumSHA256)
+	}
+
+	c.lock()
+	defer c.mu.Unlock()
+	parts, ok := c.uploads[uploadID]
+	if !ok {
+		return "", fmt.Errorf("upload %q not found", uploadID)
+	}
+	parts[partNumber] = &noopS3Part{size: n, checksumSHA256: checksumSHA256}
+	return noopS3ETag(uploadID, partNumber), nil
+}
+
+// ListParts returns the uploaded parts of a multipart upload.
+func (c *NoopS3Client) ListParts(ctx context.Context, bucket, key, uploadID string) ([]types.Part, error) {
+	c.lock()
+	defer c.mu.Unlock()
+	parts, ok := c.uploads[uploadID]
+	if !ok {
+		return nil, fmt.Errorf("upload %q not found", uploadID)
+	}
+	var ps []types.Part
+	for n, p := range parts {
+		ps = append(ps, types.Part{
+			PartNumber:     aws.Int32(n),
+			ETag:           aws.String(noopS3ETag(uploadID, n)),
+			Size:           aws.Int64(p.size),
+			ChecksumSHA256: aws.String(p.checksumSHA256),
+		})
+	}
+	return ps, nil
+}
+
+// CompleteMultipartUpload completes a multipart upload. The object has the composite checksum of the parts.
+func (c *NoopS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string, parts []types.CompletedPart) error {
+	c.lock()
+	defer c.mu.Unlock()
+	uploaded, ok := c.uploads[uploadID]
+	if !ok {
+		return fmt.Errorf("upload %q not found", uploadID)
+	}
+	o := &noopS3Object{}
+	var digests [][]byte
+	for _, cp := range parts {
+		p, ok := uploaded[aws.ToInt32(cp.PartNumber)]
+		if !ok || aws.ToString(cp.ChecksumSHA256) != p.checksumSHA256 {
+			return fmt.Errorf("invalid part %d", aws.ToInt32(cp.PartNumber))
+		}
+		b, err := base64.StdEncoding.DecodeString(p.checksumSHA256)
+		if err != nil {
+			return err
+		}
+		digests = append(digests, b)
+		o.size += p.size
+	}
+	o.checksumSHA256 = compositeSHA256(digests)
+	c.objects[key] = o
+	delete(c.uploads, uploadID)
+	return nil
+}
+
+// AbortMultipartUpload aborts a multipart upload.
+func (c *NoopS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	c.lock()
+	defer c.mu.Unlock()
+	if _, ok := c.uploads[uploadID]; !ok {
+		return fmt.Errorf("upload %q not found", uploadID)
+	}
+	delete(c.uploads, uploadID)
 	return nil
 }
 
+// DeleteObject deletes an object.
+func (c *NoopS3Client) DeleteObject(ctx context.Context, bucket, key string) error {
+	c.lock()
+	defer c.mu.Unlock()
+	delete(c.objects, key)
+	return nil
+}
+
+// ListObjectsPages lists objects with pagination.
+func (c *NoopS3Client) ListObjectsPages(ctx context.Context, bucket, prefix string, f func(page *s3.ListObjectsV2Output, lastPage bool) bool) error {
+	c.lock()
+	var objs []types.Object
+	for key, o := range c.objects {
+		if strings.HasPrefix(key, prefix) {
+			objs = append(objs, types.Object{
+				Key:  aws.String(key),
+				Size: aws.Int64(o.size),
+			})
+		}
+	}
+	c.mu.Unlock()
+	sort.Slice(objs, func(i, j int) bool { return aws.ToString(objs[i].Key) < aws.ToString(objs[j].Key) })
+	f(&s3.ListObjectsV2Output{Contents: objs}, true)
+	return nil
+}
+
+func noopS3ETag(uploadID string, partNumber int32) string {
+	return fmt.Sprintf("%s-%d", uploadID, partNumber)
+}
+
 // ModelClient is an interface for the model client.
 type ModelClient interface {
 	CreateBaseModel(ctx context.Context, in *v1.CreateBaseModelRequest, opts ...grpc.CallOption) (*v1.BaseModel, error)
@@ -67,6 +317,13 @@ type ModelClient interface {
 
 	AcquireUnloadedModel(ctx context.Context, in *v1.AcquireUnloadedModelRequest, opts ...grpc.CallOption) (*v1.AcquireUnloadedModelResponse, error)
 	UpdateModelLoadingStatus(ctx context.Context, in *v1.UpdateModelLoadingStatusRequest, opts ...grpc.CallOption) (*v1.UpdateModelLoadingStatusResponse, error)
+
+	AcquireObjectDeletionTask(ctx context.Context, in *v1.AcquireObjectDeletionTaskRequest, opts ...grpc.CallOption) (*v1.AcquireObjectDeletionTaskResponse, error)
+	CompleteObjectDeletionTask(ctx context.Context, in *v1.CompleteObjectDeletionTaskRequest, opts ...grpc.CallOption) (*v1.CompleteObjectDeletionTaskResponse, error)
+	ListModelPaths(ctx context.Context, in *v1.ListModelPathsRequest, opts ...grpc.CallOption) (*v1.ListModelPathsResponse, error)
+
+	ListLoadedModelPaths(ctx context.Context, in *v1.ListLoadedModelPathsRequest, opts ...grpc.CallOption) (*v1.ListLoadedModelPathsResponse, error)
+	UpdateModelDegradation(ctx context.Context, in *v1.UpdateModelDegradationRequest, opts ...grpc.CallOption) (*v1.UpdateModelDegradationResponse, error)
 }
 
 // New creates a new loader.
@@ -77,6 +334,9 @@ func New(
 	modelDownloaderFactory modelDownloaderFactory,
 	s3Client S3Client,
 	modelClient ModelClient,
+	loaderID string,
+	clusterID string,
+	concurrency int,
 	log logr.Logger,
 ) *L {
 	return &L{
@@ -84,10 +344,16 @@ func New(
 		objectStorePathPrefix:  objectStorePathPrefix,
 		baseModelPathPrefix:    baseModelPathPrefix,
 		modelDownloaderFactory: modelDownloaderFactory,
+		formatDetectors:        defaultFormatDetectors(),
 		s3Client:               s3Client,
 		modelClient:            modelClient,
+		loaderID:               loaderID,
+		clusterID:              clusterID,
+		concurrency:            concurrency,
 		log:                    log.WithName("loader"),
 		tmpDir:                 "/tmp",
+		uploadPartSize:         defaultUploadPartSize,
+		statusSenderInterval:   defaultStatusSenderInterval,
 	}
 }
 
@@ -99,27 +365,47 @@ type L struct {
 	baseModelPathPrefix   string
 
 	modelDownloaderFactory modelDownloaderFactory
+	// formatDetectors detect the formats of the downloaded models.
+	formatDetectors []FormatDetector
 
 	s3Client S3Client
 
 	modelClient ModelClient
 
+	// loaderID is the ID of the loader. The server grants a lease on an acquired model to this ID.
+	loaderID string
+	// clusterID is the ID of the cluster where the loader runs. The server uses it only if it does not
+	// authenticate workers.
+	clusterID string
+
+	// concurrency is the maximum number of files uploaded in parallel.
+	concurrency int
+
 	log logr.Logger
 
 	tmpDir string
+
+	// uploadPartSize is the size of each part in a multipart upload.
+	uploadPartSize int64
+
+	// statusSenderInterval is the interval of reporting the loading status, which also renews the lease
+	// and tells whether the loading has been cancelled.
+	statusSenderInterval time.Duration
 }
 
-// Run runs the loader.
+// Run runs the loader. The files of the loaded models are checked every reconcileInterval
+// unless it is zero.
 func (l *L) Run(
 	ctx context.Context,
-	baseModels []string,
+	baseModels []config.BaseModelConfig,
 	models []config.ModelConfig,
 	sourceRepository v1.SourceRepository,
 	interval time.Duration,
+	reconcileInterval time.Duration,
 ) error {
 	return retry.Do(
 		func() error {
-			return l.run(ctx, baseModels, models, sourceRepository, interval)
+			return l.run(ctx, baseModels, models, sourceRepository, interval, reconcileInterval)
 		},
 		retry.Context(ctx),
 		retry.Attempts(5),
@@ -129,20 +415,33 @@ func (l *L) Run(
 
 func (l *L) run(
 	ctx context.Context,
-	baseModels []string,
+	baseModels []config.BaseModelConfig,
 	models []config.ModelConfig,
 	sourceRepository v1.SourceRepository,
 	interval time.Duration,
+	reconcileInterval time.Duration,
 ) error {
 	if err := l.LoadModels(ctx, baseModels, models, sourceRepository); err != nil {
 		return err
 	}
 
+	// A nil channel blocks forever, which disables the reconciliation.
+	var reconcileC <-chan time.Time
+	if reconcileInterval > 0 {
+		reconcileTicker := time.NewTicker(reconcileInterval)
+		defer reconcileTicker.Stop()
+		reconcileC = reconcileTicker.C
+	}
+
 	ticker := time.NewTicker(interval)
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
+		case <-reconcileC:
+			if err := l.reconcile(ctx); err != nil {
+				return err
+			}
 		case <-ticker.C:
 			if err := l.pullAndLoadBaseModels(ctx); err != nil {
 				return err
@@ -150,19 +449,37 @@ func (l *L) run(
 			if err := l.pullAndLoadModels(ctx); err != nil {
 				return err
 			}
+			if err := l.collectGarbage(ctx); err != nil {
+				return err
+			}
 		}
 	}
 }
 
-// LoadModels loads base and non-base models.
+// LoadModels loads base and non-base models. Models are downloaded from sourceRepository unless base models
+// specify their source.
 func (l *L) LoadModels(
 	ctx context.Context,
-	baseModels []string,
+	baseModels []config.BaseModelConfig,
 	models []config.ModelConfig,
 	sourceRepository v1.SourceRepository,
 ) error {
 	for _, baseModel := range baseModels {
-		if err := l.loadBaseModel(ctx, baseModel, "" /* projectID */, sourceRepository, nil /* statusUpdateClient */); err != nil {
+		sr := sourceRepository
+		if baseModel.Source != "" {
+			sr = baseModel.Source.SourceRepository()
+		}
+		if err := l.loadBaseModel(
+			ctx,
+			baseModel.ID,
+			baseModel.Revision,
+			baseModel.FilePatterns(),
+			baseModel.URL,
+			baseModel.Checksum,
+			"", /* projectID */
+			sr,
+			nil, /* statusUpdateClient */
+		); err != nil {
 			return err
 		}
 	}
@@ -177,7 +494,12 @@ func (l *L) LoadModels(
 func (l *L) pullAndLoadBaseModels(ctx context.Context) error {
 	actx := auth.AppendWorkerAuthorization(ctx)
 	for {
-		resp, err := l.modelClient.AcquireUnloadedBaseModel(actx, &v1.AcquireUnloadedBaseModelRequest{})
+		resp, err := l.modelClient.AcquireUnloadedBaseModel(actx, &v1.AcquireUnloadedBaseModelRequest{
+			LoaderId:                    l.loaderID,
+			SupportedSourceRepositories: l.modelDownloaderFactory.SupportedSourceRepositories(),
+			ClusterId:                   l.clusterID,
+			FreeDiskBytes:               l.freeDiskBytes(),
+		})
 		if err != nil {
 			if status.Code(err) == codes.FailedPrecondition {
 				l.log.Error(err, "Failed to acquire an unloaded base model")
@@ -195,20 +517,52 @@ func (l *L) pullAndLoadBaseModels(ctx context.Context) error {
 			l.modelClient,
 			resp.BaseModelId,
 			resp.ProjectId,
+			l.loaderID,
 		)
 
-		if err := l.loadBaseModel(ctx, resp.BaseModelId, resp.ProjectId, resp.SourceRepository, statusUpdateClient); err != nil {
+		if err := l.loadBaseModel(
+			ctx,
+			resp.BaseModelId,
+			resp.Revision,
+			resp.FilePatterns,
+			resp.ModelFileLocation,
+			resp.Checksum,
+			resp.ProjectId,
+			resp.SourceRepository,
+			statusUpdateClient,
+		); err != nil {
+			if errors.Is(err, errLoadingCancelled) {
+				l.log.Info("Cancelled loading base model", "modelID", resp.BaseModelId)
+				if _, err := l.modelClient.UpdateBaseModelLoadingStatus(actx, &v1.UpdateBaseModelLoadingStatusRequest{
+					Id:            resp.BaseModelId,
+					ProjectId:     resp.ProjectId,
+					LoaderId:      l.loaderID,
+					LoadingResult: &v1.UpdateBaseModelLoadingStatusRequest_Cancelled_{},
+				}); err != nil {
+					if !isLeaseLostError(err) {
+						return err
+					}
+					l.log.Error(err, "Lost the lease of base model", "modelID", resp.BaseModelId)
+				}
+				continue
+			}
+
 			l.log.Error(err, "Failed to load base model", "modelID", resp.BaseModelId)
 			if _, err := l.modelClient.UpdateBaseModelLoadingStatus(actx, &v1.UpdateBaseModelLoadingStatusRequest{
 				Id:        resp.BaseModelId,
 				ProjectId: resp.ProjectId,
+				LoaderId:  l.loaderID,
 				LoadingResult: &v1.UpdateBaseModelLoadingStatusRequest_Failure_{
 					Failure: &v1.UpdateBaseModelLoadingStatusRequest_Failure{
-						Reason: err.Error(),
+						Reason:    err.Error(),
+						Retryable: isRetryableError(err),
 					},
 				},
 			}); err != nil {
-				return err
+				if !isLeaseLostError(err) {
+					return err
+				}
+				l.log.Error(err, "Lost the lease of base model", "modelID", resp.BaseModelId)
 			}
 			// Do not return the error here. We need to continue loading other models.
 			continue
@@ -218,9 +572,13 @@ func (l *L) pullAndLoadBaseModels(ctx context.Context) error {
 		if _, err := l.modelClient.UpdateBaseModelLoadingStatus(actx, &v1.UpdateBaseModelLoadingStatusRequest{
 			Id:            resp.BaseModelId,
 			ProjectId:     resp.ProjectId,
+			LoaderId:      l.loaderID,
 			LoadingResult: &v1.UpdateBaseModelLoadingStatusRequest_Success_{},
 		}); err != nil {
-			return err
+			if !isLeaseLostError(err) {
+				return err
+			}
+			l.log.Error(err, "Lost the lease of base model", "modelID", resp.BaseModelId)
 		}
 	}
 }
@@ -228,7 +586,12 @@ func (l *L) pullAndLoadBaseModels(ctx context.Context) error {
 func (l *L) pullAndLoadModels(ctx context.Context) error {
 	actx := auth.AppendWorkerAuthorization(ctx)
 	for {
-		resp, err := l.modelClient.AcquireUnloadedModel(actx, &v1.AcquireUnloadedModelRequest{})
+		resp, err := l.modelClient.AcquireUnloadedModel(actx, &v1.AcquireUnloadedModelRequest{
+			LoaderId:                    l.loaderID,
+			SupportedSourceRepositories: l.modelDownloaderFactory.SupportedSourceRepositories(),
+			ClusterId:                   l.clusterID,
+			FreeDiskBytes:               l.freeDiskBytes(),
+		})
 		if err != nil {
 			if status.Code(err) == codes.FailedPrecondition {
 				l.log.Error(err, "Failed to acquire an unloaded base model")
@@ -245,38 +608,75 @@ func (l *L) pullAndLoadModels(ctx context.Context) error {
 		statusUpdateClient := newFineTunedModelStatusUpdateClient(
 			l.modelClient,
 			resp.ModelId,
+			l.loaderID,
 		)
 
-		if _, err := l.downloadAndUploadModel(
+		modelInfos, err := l.downloadAndUploadModel(
 			actx,
 			resp.ModelId,
 			resp.ModelFileLocation,
 			"", /* filename */
+			"", /* revision */
+			"", /* checksum */
+			resp.FilePatterns,
 			resp.SourceRepository,
 			resp.DestPath,
 			statusUpdateClient,
-		); err != nil {
+		)
+		if err != nil {
+			if errors.Is(err, errLoadingCancelled) {
+				l.log.Info("Cancelled loading model", "modelID", resp.ModelId)
+				if _, err := l.modelClient.UpdateModelLoadingStatus(actx, &v1.UpdateModelLoadingStatusRequest{
+					Id:            resp.ModelId,
+					LoaderId:      l.loaderID,
+					LoadingResult: &v1.UpdateModelLoadingStatusRequest_Cancelled_{},
+				}); err != nil {
+					if !isLeaseLostError(err) {
+						return err
+					}
+					l.log.Error(err, "Lost the lease of model", "modelID", resp.ModelId)
+				}
+				continue
+			}
+
 			l.log.Error(err, "Failed to load model", "modelID", resp.ModelId)
 			if _, err := l.modelClient.UpdateModelLoadingStatus(actx, &v1.UpdateModelLoadingStatusRequest{
-				Id: resp.ModelId,
+				Id:       resp.ModelId,
+				LoaderId: l.loaderID,
 				LoadingResult: &v1.UpdateModelLoadingStatusRequest_Failure_{
 					Failure: &v1.UpdateModelLoadingStatusRequest_Failure{
-						Reason: err.Error(),
+						Reason:    err.Error(),
+						Retryable: isRetryableError(err),
 					},
 				},
 			}); err != nil {
-				return err
+				if !isLeaseLostError(err) {
+					return err
+				}
+				l.log.Error(err, "Lost the lease of model", "modelID", resp.ModelId)
 			}
 			// Do not return the error here. We need to continue loading other models.
 			continue
 		}
 
 		l.log.Info("Successfully loaded model", "modelID", resp.ModelId)
+		success := &v1.UpdateModelLoadingStatusRequest_Success{}
+		if len(modelInfos) == 1 {
+			success.Files = modelInfos[0].files
+			success.FilesBasePath = modelInfos[0].filesBasePath
+			success.Size = modelInfos[0].modelSize()
+		}
 		if _, err := l.modelClient.UpdateModelLoadingStatus(actx, &v1.UpdateModelLoadingStatusRequest{
-			Id:            resp.ModelId,
-			LoadingResult: &v1.UpdateModelLoadingStatusRequest_Success_{},
+			Id:       resp.ModelId,
+			LoaderId: l.loaderID,
+			LoadingResult: &v1.UpdateModelLoadingStatusRequest_Success_{
+				Success: success,
+			},
 		}); err != nil {
-			return err
+			if !isLeaseLostError(err) {
+				return err
+			}
+			l.log.Error(err, "Lost the lease of model", "modelID", resp.ModelId)
 		}
 	}
 }
@@ -284,6 +684,10 @@ func (l *L) pullAndLoadModels(ctx context.Context) error {
 func (l *L) loadBaseModel(
 	ctx context.Context,
 	modelID string,
+	revision string,
+	filePatterns *v1.FilePatterns,
+	modelFileLocation string,
+	checksum string,
 	projectID string,
 	sourceRepository v1.SourceRepository,
 	statusUpdateClient statusUpdateClient,
@@ -307,9 +711,21 @@ func (l *L) loadBaseModel(
 		return err
 	}
 
-	modelIDToDownload, filename, err := splitHFRepoAndFile(modelID)
-	if err != nil {
-		return err
+	modelIDToDownload, modelPath, filename := modelID, modelID, ""
+	switch sourceRepository {
+	case v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA, v1.SourceRepository_SOURCE_REPOSITORY_OCI:
+		// The model ID is a reference in the registry and does not have a filename.
+	case v1.SourceRepository_SOURCE_REPOSITORY_HTTP:
+		// The model is downloaded from the URL. The model ID is only used as the name of the model.
+		if modelFileLocation == "" {
+			return fmt.Errorf("model file location is required for source repository %s", sourceRepository)
+		}
+		modelPath = modelFileLocation
+	default:
+		modelIDToDownload, filename, err = splitHFRepoAndFile(modelID)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Check if the HF repo has already been downloaded. We need to check if when
@@ -343,8 +759,11 @@ func (l *L) loadBaseModel(
 	modelInfos, err := l.downloadAndUploadModel(
 		ctx,
 		modelIDToDownload,
-		modelIDToDownload,
+		modelPath,
 		filename,
+		revision,
+		checksum,
+		filePatterns,
 		sourceRepository,
 		pathPrefix,
 		statusUpdateClient,
@@ -370,12 +789,22 @@ func (l *L) loadBaseModel(
 		}
 
 		if _, err := l.modelClient.CreateBaseModel(ctx, &v1.CreateBaseModelRequest{
-			Id:               modelID,
-			Path:             mi.path,
-			Formats:          mi.formats,
-			GgufModelPath:    mi.ggufModelPath,
-			SourceRepository: mi.sourceRepository,
-			ProjectId:        projectID,
+			Id:                  modelID,
+			Path:                mi.path,
+			Formats:             mi.formats,
+			GgufModelPath:       mi.ggufModelPath,
+			SourceRepository:    mi.sourceRepository,
+			ProjectId:           projectID,
+			Revision:            revision,
+			ResolvedRevision:    mi.resolvedRevision,
+			FilePatterns:        filePatterns,
+			Files:               mi.files,
+			FilesBasePath:       mi.filesBasePath,
+			Size:                mi.modelSize(),
+			GgufMetadata:        mi.ggufMetadata,
+			HuggingFaceMetadata: mi.huggingFaceMetadata,
+			Artifacts:           mi.artifacts(),
+			LoaderId:            l.loaderID,
 		}); err != nil {
 			return err
 		}
@@ -384,9 +813,14 @@ func (l *L) loadBaseModel(
 	}
 
 	if isDownloadingFromHuggingFace && filename == "" {
+		var resolvedRevision string
+		if len(modelInfos) > 0 {
+			resolvedRevision = modelInfos[0].resolvedRevision
+		}
 		req := &v1.CreateHFModelRepoRequest{
 			Name:      modelID,
 			ProjectId: projectID,
+			Revision:  resolvedRevision,
 		}
 		if _, err := l.modelClient.CreateHFModelRepo(ctx, req); err != nil {
 			return err
@@ -403,7 +837,17 @@ func (l *L) loadModelFromConfig(ctx context.Context, model config.ModelConfig, s
 		organizationID = "default"
 		tenantID       = "default-tenant-id"
 	)
-	if err := l.loadBaseModel(ctx, model.BaseModel, projectID, sourceRepository, nil /* statusUpdateClient */); err != nil {
+	if err := l.loadBaseModel(
+		ctx,
+		model.BaseModel,
+		"",  /* revision */
+		nil, /* filePatterns */
+		"",  /* modelFileLocation */
+		"",  /* checksum */
+		projectID,
+		sourceRepository,
+		nil, /* statusUpdateClient */
+	); err != nil {
 		return err
 	}
 
@@ -428,6 +872,9 @@ func (l *L) loadModelFromConfig(ctx context.Context, model config.ModelConfig, s
 		model.Model,
 		model.Model,
 		"", /* filename */
+		"", /* revision */
+		"", /* checksum */
+		model.FilePatterns(),
 		sourceRepository,
 		pathPrefix,
 		nil, /* statusUpdateClient */
@@ -466,29 +913,67 @@ type modelInfo struct {
 	ggufModelPath    string
 	formats          []v1.ModelFormat
 	sourceRepository v1.SourceRepository
+	// resolvedRevision is the commit SHA of the downloaded model. It is empty if the source repository
+	// does not have revisions.
+	resolvedRevision string
+	// files is the list of the uploaded files of the model. Their paths are relative to filesBasePath.
+	files         []*v1.ModelFile
+	filesBasePath string
+	// ggufMetadata is the metadata extracted from the GGUF file. It is nil if the model does not have
+	// the GGUF format or the metadata cannot be extracted.
+	ggufMetadata *v1.GGUFMetadata
+	// huggingFaceMetadata is the metadata extracted from the configuration files. It is nil if the model does
+	// not have the Hugging Face format or the metadata cannot be extracted.
+	huggingFaceMetadata *v1.HuggingFaceMetadata
+	// formatPaths is the entry points of the formats other than GGUF.
+	formatPaths map[v1.ModelFormat]string
+}
+
+// artifacts returns the artifacts of the formats of the model. The formats without an entry point
+// use the base path of the model.
+func (mi *modelInfo) artifacts() []*v1.FormatArtifact {
+	var as []*v1.FormatArtifact
+	for _, f := range mi.formats {
+		a := &v1.FormatArtifact{
+			Format: f,
+			Path:   mi.path,
+		}
+		switch f {
+		case v1.ModelFormat_MODEL_FORMAT_GGUF:
+			a.Path = mi.ggufModelPath
+			if md := mi.ggufMetadata; md != nil && md.QuantizationType != "" {
+				a.Attributes = map[string]string{"quantization_type": md.QuantizationType}
+			}
+		default:
+			if p, ok := mi.formatPaths[f]; ok {
+				a.Path = p
+			}
+		}
+		as = append(as, a)
+	}
+	return as
 }
 
 func (l *L) downloadAndUploadModel(
 	ctx context.Context,
 	modelID,
 	modelPath,
-	filename string,
+	filename,
+	revision,
+	checksum string,
+	filePatterns *v1.FilePatterns,
 	sourceRepository v1.SourceRepository,
 	pathPrefix string,
 	statusUpdateClient statusUpdateClient,
-) ([]*modelInfo, error) {
+) (_ []*modelInfo, err error) {
 	log := l.log.WithValues("modelID", modelID)
 	log.Info("Started loading model")
 
-	// Please note that the temp directory shouldn't contain a symlink. Otherwise
-	// symlinks created by Hugging Face doesn't work.
-	//
-	// For example, suppose that
-	// - /tmp is a symlink to private/tmp
-	// - the temp dir /tmp/base-model0 is created.
-	// - one of the symlinks created by Hugging Face is .gitattributes, which is linked to ../../Users/kenji/.cache/.
-	//
-	// Then, the link does not work since /private/tmp/base-model0/../../Users/kenji/.cache/ is not a valid path.
+	filter, err := pathfilter.New(filePatterns.GetInclude(), filePatterns.GetExclude())
+	if err != nil {
+		return nil, fmt.Errorf("invalid file patterns: %s", err)
+	}
+
 	tmpDir, err := os.MkdirTemp(l.tmpDir, "base-model")
 	if err != nil {
 		return nil, err
@@ -498,13 +983,46 @@ func (l *L) downloadAndUploadModel(
 		_ = os.RemoveAll(tmpDir)
 	}()
 
-	var ssender *statusSender
+	var (
+		ssender   *statusSender
+		cancelled atomic.Bool
+	)
+	defer func() {
+		if err == nil || !cancelled.Load() {
+			return
+		}
+		// Clean up with a context that has not been cancelled.
+		log.Info("Deleting the uploaded objects of the cancelled loading")
+		if err := l.deleteUploadedObjects(context.WithoutCancel(ctx), pathPrefix, log); err != nil {
+			log.Error(err, "Failed to delete the uploaded objects")
+		}
+		err = errLoadingCancelled
+	}()
 	if statusUpdateClient != nil {
-		ssender = newStatusSender(statusUpdateClient, tmpDir)
-		ctx, cancel := context.WithCancel(ctx)
+		ssender = newStatusSender(statusUpdateClient, tmpDir, pathPrefix)
+		// Loading stops when the status sender finds that the lease of the model has been lost or
+		// the loading has been cancelled. Another loader might be loading the model in the former case.
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
 		defer cancel()
 		go func() {
-			if err := ssender.run(ctx, defaultStatusSenderInterval); err != nil {
+			for {
+				err := ssender.run(ctx, l.statusSenderInterval)
+				if err == nil {
+					return
+				}
+				if errors.Is(err, errLoadingCancelled) {
+					log.Info("Loading has been cancelled. Stopping the loading")
+					cancelled.Store(true)
+					cancel()
+					return
+				}
+				if isLeaseLostError(err) {
+					log.Error(err, "Lost the lease. Stopping the loading")
+					cancel()
+					return
+				}
+				// Keep sending the status as it also renews the lease.
 				log.Error(err, "Failed to run status sender")
 			}
 		}()
@@ -515,8 +1033,38 @@ func (l *L) downloadAndUploadModel(
 	if err != nil {
 		return nil, err
 	}
-	if err := downloader.download(ctx, modelPath, filename, tmpDir); err != nil {
-		return nil, err
+	if ssender != nil {
+		// The size is only used for reporting the progress.
+		if size, ok, err := getModelSize(ctx, downloader, modelPath, filename, revision, filter); err != nil {
+			log.Error(err, "Failed to get the model size")
+		} else if ok {
+			ssender.setTotalBytes(size)
+		}
+	}
+	rd, isRevisionDownloader := downloader.(revisionDownloader)
+	if revision != "" && !isRevisionDownloader {
+		return nil, fmt.Errorf("revision is not supported for source repository %s", sourceRepository)
+	}
+	cd, isChecksumDownloader := downloader.(checksumDownloader)
+	if checksum != "" && !isChecksumDownloader {
+		return nil, fmt.Errorf("checksum is not supported for source repository %s", sourceRepository)
+	}
+	var resolvedRevision string
+	switch {
+	case isRevisionDownloader:
+		resolvedRevision, err = rd.downloadRevision(ctx, modelPath, filename, revision, filter, tmpDir)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("Downloaded the revision", "revision", revision, "resolvedRevision", resolvedRevision)
+	case isChecksumDownloader:
+		if err := cd.downloadWithChecksum(ctx, modelPath, filename, checksum, filter, tmpDir); err != nil {
+			return nil, err
+		}
+	default:
+		if err := downloader.download(ctx, modelPath, filename, filter, tmpDir); err != nil {
+			return nil, err
+		}
 	}
 
 	toKey := func(path string) string {
@@ -526,143 +1074,249 @@ func (l *L) downloadAndUploadModel(
 	}
 
 	var (
-		paths                    []string
-		ggufModelPaths           []string
-		hasHuggingFaceConfigJSON bool
-		hasTensorRTLLMConfig     bool
-		hasOllamaLayerDir        bool
+		paths      []string
+		totalBytes int64
 	)
+	tree := &modelTree{
+		modelID:    modelID,
+		filename:   filename,
+		rootDir:    tmpDir,
+		pathPrefix: pathPrefix,
+		log:        log,
+	}
 	if err := filepath.Walk(tmpDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
 		if info.IsDir() {
-			if info.Name() == "blobs" {
-				hasOllamaLayerDir = true
+			if path != tmpDir {
+				rel, err := filepath.Rel(tmpDir, path)
+				if err != nil {
+					return err
+				}
+				tree.dirs = append(tree.dirs, filepath.ToSlash(rel))
 			}
 			return nil
 		}
 
-		// Ignore the HuggingFace cache directory.
-		if strings.Contains(path, huggingFaceDownloadCache) {
+		// Ignore the download cache directories that hold incomplete files.
+		if isInDownloadCache(path) {
 			return nil
 		}
 
-		paths = append(paths, path)
-
-		if strings.HasSuffix(path, ".gguf") {
-			ggufModelPaths = append(ggufModelPaths, toKey(path))
+		// Skip the files that do not match the file patterns. Downloaders already skip them, but
+		// check them here as well so that no other file is uploaded.
+		rel, err := filepath.Rel(tmpDir, path)
+		if err != nil {
+			return err
 		}
-
-		if filepath.Base(path) == "config.json" || filepath.Base(path) == "adapter_config.json" {
-			hasHuggingFaceConfigJSON = true
+		if !filter.Match(filepath.ToSlash(rel)) {
+			log.V(1).Info("Skipping the file that does not match the file patterns", "path", rel)
+			return nil
 		}
 
-		if strings.HasSuffix(path, "tensorrt_llm/config.pbtxt") {
-			hasTensorRTLLMConfig = true
+		paths = append(paths, path)
+		tree.files = append(tree.files, filepath.ToSlash(rel))
+		// Follow symlinks.
+		if fi, err := os.Stat(path); err == nil {
+			totalBytes += fi.Size()
 		}
-
 		return nil
 	}); err != nil {
 		return nil, err
 	}
 	log.Info("Downloaded files", "count", len(paths))
 	if len(paths) == 0 {
+		if !filter.IsEmpty() {
+			return nil, fmt.Errorf("no downloaded files match the file patterns")
+		}
 		return nil, fmt.Errorf("no files downloaded")
 	}
 
-	log.Info("Uploading model to the object store")
-	for i, path := range paths {
-		log.Info("Uploading", "path", path)
-		r, err := os.Open(path)
-		if err != nil {
-			return nil, err
-		}
+	minfos := detectModels(l.formatDetectors, tree)
+	if len(minfos) == 0 {
+		return nil, fmt.Errorf("no model format found")
+	}
 
-		if err := l.s3Client.Upload(ctx, r, l.objectStoreBucket, toKey(path)); err != nil {
-			return nil, err
-		}
-		if err := r.Close(); err != nil {
-			return nil, err
+	log.Info("Uploading model to the object store")
+	uploader := newResumableUploader(l.s3Client, l.objectStoreBucket, uploadManifestKey(pathPrefix), l.uploadPartSize, log)
+	if ssender != nil {
+		// Replace the expected size with the actual one now that all files have been downloaded.
+		ssender.setTotalBytes(totalBytes)
+		uploader.onProgress = ssender.addUploadedBytes
+	}
+	if err := uploader.loadManifest(ctx); err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, path := range paths {
+		keys = append(keys, toKey(path))
+	}
+	if err := runInParallel(ctx, l.concurrency, len(paths), func(ctx context.Context, i int) error {
+		log.Info("Uploading", "path", paths[i])
+		if err := uploader.upload(ctx, paths[i], keys[i]); err != nil {
+			return err
 		}
-
 		if ssender != nil {
-			ssender.setNumUploadedFiles(i + 1)
+			ssender.incNumUploadedFiles()
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
-	if hasOllamaLayerDir {
-		return []*modelInfo{
-			{
-				id:               modelID,
-				path:             pathPrefix,
-				formats:          []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_OLLAMA},
-				sourceRepository: sourceRepository,
-			},
-		}, nil
+	log.Info("Verifying uploaded objects")
+	if err := uploader.verify(ctx, keys); err != nil {
+		return nil, err
 	}
 
-	var formats []v1.ModelFormat
-	if hasHuggingFaceConfigJSON {
-		formats = append(formats, v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE)
-	}
-	if hasTensorRTLLMConfig {
-		formats = append(formats, v1.ModelFormat_MODEL_FORMAT_NVIDIA_TRITON)
+	files, err := toModelFiles(uploader, pathPrefix, keys)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(ggufModelPaths) <= 1 {
-		var ggufModelPath string
-		if len(ggufModelPaths) == 1 {
-			formats = append(formats, v1.ModelFormat_MODEL_FORMAT_GGUF)
-			ggufModelPath = ggufModelPaths[0]
-		}
+	for _, mi := range minfos {
+		mi.sourceRepository = sourceRepository
+		mi.resolvedRevision = resolvedRevision
+		// The files are stored under the path prefix. Exclude the GGUF files of the other models.
+		mi.files = filterGGUFModelFiles(files, strings.TrimPrefix(mi.ggufModelPath, pathPrefix+"/"))
+		mi.filesBasePath = pathPrefix
+	}
+	return minfos, nil
+}
 
-		if len(formats) == 0 {
-			return nil, fmt.Errorf("no model format found")
-		}
+// freeDiskBytes returns the free disk space of the directory where models are downloaded. It returns zero
+// if the space cannot be determined so that the server does not check the disk space.
+func (l *L) freeDiskBytes() int64 {
+	dir := l.tmpDir
+	if dir == "" {
+		dir = os.TempDir()
+	}
+	n, err := freeDiskBytes(dir)
+	if err != nil {
+		l.log.Error(err, "Failed to get the free disk space", "dir", dir)
+		return 0
+	}
+	return n
+}
 
-		id := modelID
-		if filename != "" {
-			id = modelID + "/" + filename
-		}
-		return []*modelInfo{
-			{
-				id:               id,
-				path:             pathPrefix,
-				ggufModelPath:    ggufModelPath,
-				formats:          formats,
-				sourceRepository: sourceRepository,
-			},
-		}, nil
+// deleteUploadedObjects deletes the objects that the upload manifest at the path prefix records.
+func (l *L) deleteUploadedObjects(ctx context.Context, pathPrefix string, log logr.Logger) error {
+	uploader := newResumableUploader(l.s3Client, l.objectStoreBucket, uploadManifestKey(pathPrefix), l.uploadPartSize, log)
+	if err := uploader.loadManifest(ctx); err != nil {
+		return err
 	}
+	return uploader.deleteUploads(ctx)
+}
+
+// isLeaseLostError returns true if the error indicates that the loader no longer holds the lease of a model.
+func isLeaseLostError(err error) bool {
+	return lease.IsLostError(err)
+}
 
-	log.Info("Found multiple GGPU files. Creating a model info per GGUF file")
-	if len(formats) != 0 {
-		return nil, fmt.Errorf("multiple gguf files (%v) found with other model format (%v)", ggufModelPaths, formats)
+// isRetryableError returns true if the loading failure is likely to be transient, e.g., network errors,
+// server errors, and throttling. Other failures, such as missing models and checksum mismatches, are not
+// retried as loading the model again would fail in the same way.
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var serr *download.StatusError
+	if errors.As(err, &serr) {
+		return serr.IsTransient()
 	}
+	var rerr *awshttp.ResponseError
+	if errors.As(err, &rerr) {
+		code := rerr.HTTPStatusCode()
+		return code >= 500 || code == http.StatusTooManyRequests
+	}
+	var nerr net.Error
+	return errors.As(err, &nerr)
+}
 
-	var minfos []*modelInfo
-	for _, gpath := range ggufModelPaths {
-		filename := extractFileNameFromGGUFPath(gpath)
-		id := filepath.Join(modelID, filename)
+// isUnimplementedError returns true if the error indicates that the server does not implement the RPC.
+// This happens when the loader is rolled out before the server.
+func isUnimplementedError(err error) bool {
+	return status.Code(err) == codes.Unimplemented
+}
 
-		minfos = append(minfos, &modelInfo{
-			id:               id,
-			path:             filepath.Join(pathPrefix, filename),
-			ggufModelPath:    gpath,
-			formats:          []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
-			sourceRepository: sourceRepository,
-		})
+// parseGGUFMetadata extracts the metadata from the header of the GGUF file. The metadata is informational, so
+// it returns nil instead of failing the loading if the file cannot be parsed.
+func parseGGUFMetadata(path string, log logr.Logger) *v1.GGUFMetadata {
+	if path == "" {
+		return nil
 	}
+	md, err := gguf.ParseFile(path)
+	if err != nil {
+		log.Error(err, "Failed to parse the GGUF file", "path", path)
+		return nil
+	}
+	return &v1.GGUFMetadata{
+		Architecture:     md.Architecture,
+		ParameterCount:   md.ParameterCount,
+		ContextLength:    md.ContextLength,
+		QuantizationType: md.QuantizationType,
+		Tokenizer:        md.Tokenizer,
+		ChatTemplate:     md.ChatTemplate,
+	}
+}
 
-	return minfos, nil
+// parseHuggingFaceMetadata extracts the metadata from the configuration files and the safetensors files in
+// the directory. Like parseGGUFMetadata, it returns nil instead of failing the loading if the files cannot be parsed.
+func parseHuggingFaceMetadata(dir string, log logr.Logger) *v1.HuggingFaceMetadata {
+	if dir == "" {
+		return nil
+	}
+	md, err := huggingface.ParseModelMetadata(dir)
+	if err != nil {
+		log.Error(err, "Failed to parse the Hugging Face model files", "dir", dir)
+		return nil
+	}
+	return &v1.HuggingFaceMetadata{
+		Architecture:          md.Architecture,
+		ModelType:             md.ModelType,
+		Dtype:                 md.Dtype,
+		ParameterCount:        md.ParameterCount,
+		MaxPositionEmbeddings: md.MaxPositionEmbeddings,
+		VocabSize:             md.VocabSize,
+		HasChatTemplate:       md.HasChatTemplate,
+	}
 }
 
 func extractFileNameFromGGUFPath(path string) string {
 	return filepath.Base(strings.TrimSuffix(path, ".gguf"))
 }
 
+// getModelSize returns the total size of the model files at the revision. It returns false if the
+// downloader cannot get the size before downloading the files.
+func getModelSize(
+	ctx context.Context,
+	downloader ModelDownloader,
+	modelPath, filename, revision string,
+	filter *pathfilter.Filter,
+) (int64, bool, error) {
+	if sizer, ok := downloader.(revisionModelSizer); ok {
+		size, err := sizer.modelSizeRevision(ctx, modelPath, filename, revision, filter)
+		return size, true, err
+	}
+	if sizer, ok := downloader.(modelSizer); ok {
+		size, err := sizer.modelSize(ctx, modelPath, filename, filter)
+		return size, true, err
+	}
+	return 0, false, nil
+}
+
+// isInDownloadCache returns true if the path is in the cache directory of a downloader.
+func isInDownloadCache(path string) bool {
+	for _, dir := range []string{huggingFaceDownloadCache, ociDownloadCache, httpDownloadCache} {
+		if strings.Contains(path, dir) {
+			return true
+		}
+	}
+	return false
+}
+
 func toKeyModelID(modelID string) string {
 	// Ollama uses ':' as a separator, but it cannot be used for bucket name. Use '-' instead.
 	return strings.ReplaceAll(modelID, ":", "-")
@@ -1,15 +1,33 @@
 package loader
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
+	"net"
+	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
 	"github.com/llmariner/model-manager/loader/internal/config"
+	"github.com/llmariner/model-manager/loader/internal/download"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -39,10 +57,13 @@ func TestLoadBaseModel(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		4,
 		testr.New(t),
 	)
 	ld.tmpDir = "/tmp"
-	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
+	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
 	assert.NoError(t, err)
 
 	want := []string{
@@ -60,6 +81,211 @@ func TestLoadBaseModel(t *testing.T) {
 	assert.ElementsMatch(t, []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, got.Formats)
 	assert.Equal(t, "models/base-models/global/google/gemma-2b", got.Path)
 	assert.Equal(t, "models/base-models/global/google/gemma-2b/dir0/file1.gguf", got.GgufModelPath)
+
+	var paths []string
+	for _, f := range mc.filesByID["google-gemma-2b"] {
+		paths = append(paths, f.Path)
+		assert.Len(t, f.Sha256, 64)
+		assert.Equal(t, "application/octet-stream", f.ContentType)
+	}
+	assert.ElementsMatch(t, []string{"dir0/dir2/file3", "dir0/file1.gguf", "dir1/file2", "file0"}, paths)
+
+	// The empty GGUF file cannot be parsed, but the loading still succeeds.
+	assert.Nil(t, mc.ggufMetadata["google-gemma-2b"])
+}
+
+func TestLoadBaseModel_GGUFMetadata(t *testing.T) {
+	// Build the header of a GGUF file that has the architecture and no tensor.
+	var buf bytes.Buffer
+	writeString := func(s string) {
+		_ = binary.Write(&buf, binary.LittleEndian, uint64(len(s)))
+		buf.WriteString(s)
+	}
+	buf.WriteString("GGUF")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(3))
+	_ = binary.Write(&buf, binary.LittleEndian, uint64(0))
+	_ = binary.Write(&buf, binary.LittleEndian, uint64(2))
+	writeString("general.architecture")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(8))
+	writeString("llama")
+	writeString("general.file_type")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(4))
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(15))
+
+	downloader := &fakeDownloader{
+		files: []string{
+			"model.gguf",
+		},
+		contents: map[string][]byte{
+			"model.gguf": buf.Bytes(),
+		},
+	}
+
+	s3Client := &mockS3Client{}
+	mc := NewFakeModelClient()
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{d: downloader},
+		s3Client,
+		mc,
+		"loader0",
+		"cluster0",
+		4,
+		testr.New(t),
+	)
+	ld.tmpDir = "/tmp"
+	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
+	assert.NoError(t, err)
+
+	md := mc.ggufMetadata["google-gemma-2b"]
+	assert.NotNil(t, md)
+	assert.Equal(t, "llama", md.Architecture)
+
+	as := mc.artifactsByID["google-gemma-2b"]
+	assert.Len(t, as, 1)
+	assert.Equal(t, v1.ModelFormat_MODEL_FORMAT_GGUF, as[0].Format)
+	assert.Equal(t, "models/base-models/global/google/gemma-2b/model.gguf", as[0].Path)
+	assert.Equal(t, map[string]string{"quantization_type": "Q4_K_M"}, as[0].Attributes)
+}
+
+func TestLoadBaseModel_HuggingFaceMetadata(t *testing.T) {
+	downloader := &fakeDownloader{
+		files: []string{
+			"config.json",
+			"tokenizer_config.json",
+		},
+		contents: map[string][]byte{
+			"config.json":           []byte(`{"architectures": ["LlamaForCausalLM"], "model_type": "llama", "vocab_size": 128256}`),
+			"tokenizer_config.json": []byte(`{"chat_template": "{{ messages }}"}`),
+		},
+	}
+
+	s3Client := &mockS3Client{}
+	mc := NewFakeModelClient()
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{d: downloader},
+		s3Client,
+		mc,
+		"loader0",
+		"cluster0",
+		4,
+		testr.New(t),
+	)
+	ld.tmpDir = "/tmp"
+	err := ld.loadBaseModel(context.Background(), "meta-llama/Llama-3.1-8B", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
+	assert.NoError(t, err)
+
+	md := mc.hfMetadata["meta-llama-Llama-3.1-8B"]
+	assert.NotNil(t, md)
+	assert.Equal(t, "LlamaForCausalLM", md.Architecture)
+	assert.Equal(t, "llama", md.ModelType)
+	assert.Equal(t, int64(128256), md.VocabSize)
+	assert.True(t, md.HasChatTemplate)
+}
+
+func TestLoadBaseModel_QuantizedSafetensors(t *testing.T) {
+	downloader := &fakeDownloader{
+		files: []string{
+			"config.json",
+			"tokenizer.json",
+			"model.safetensors",
+		},
+		contents: map[string][]byte{
+			"config.json":    []byte(`{"quantization_config": {"quant_method": "awq"}}`),
+			"tokenizer.json": []byte(`{}`),
+		},
+	}
+
+	s3Client := &mockS3Client{}
+	mc := NewFakeModelClient()
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{d: downloader},
+		s3Client,
+		mc,
+		"loader0",
+		"cluster0",
+		4,
+		testr.New(t),
+	)
+	ld.tmpDir = "/tmp"
+	err := ld.loadBaseModel(context.Background(), "Qwen/Qwen2.5-7B-Instruct-AWQ", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
+	assert.NoError(t, err)
+
+	got, err := mc.GetBaseModelPath(context.Background(), &v1.GetBaseModelPathRequest{Id: "Qwen-Qwen2.5-7B-Instruct-AWQ"})
+	assert.NoError(t, err)
+	assert.Equal(t, []v1.ModelFormat{
+		v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE,
+		v1.ModelFormat_MODEL_FORMAT_AWQ,
+		v1.ModelFormat_MODEL_FORMAT_VLLM,
+	}, got.Formats)
+	const path = "models/base-models/global/Qwen/Qwen2.5-7B-Instruct-AWQ"
+	assert.Equal(t, []*v1.FormatArtifact{
+		{Format: v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE, Path: path},
+		{Format: v1.ModelFormat_MODEL_FORMAT_AWQ, Path: path},
+		{Format: v1.ModelFormat_MODEL_FORMAT_VLLM, Path: path},
+	}, got.Artifacts)
+	assert.Empty(t, got.GgufModelPath)
+}
+
+func TestLoadBaseModel_FilePatterns(t *testing.T) {
+	downloader := &fakeDownloader{
+		dirs: []string{
+			"original",
+		},
+		files: []string{
+			"config.json",
+			"model.safetensors",
+			"pytorch_model.bin",
+			"original/consolidated.00.pth",
+		},
+	}
+
+	s3Client := &mockS3Client{}
+	mc := NewFakeModelClient()
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{d: downloader},
+		s3Client,
+		mc,
+		"loader0",
+		"cluster0",
+		1,
+		testr.New(t),
+	)
+	ld.tmpDir = "/tmp"
+	filePatterns := &v1.FilePatterns{
+		Include: []string{"*.json", "*.safetensors", "*.pth"},
+		Exclude: []string{"original/"},
+	}
+	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "", filePatterns, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
+	assert.NoError(t, err)
+
+	// The fake downloader downloads all the files, but only the files that match the patterns are uploaded.
+	want := []string{
+		"models/base-models/global/google/gemma-2b/config.json",
+		"models/base-models/global/google/gemma-2b/model.safetensors",
+	}
+	assert.ElementsMatch(t, want, s3Client.uploadedKeys)
+
+	// No file is uploaded if no file matches the patterns.
+	s3Client = &mockS3Client{}
+	ld.s3Client = s3Client
+	filePatterns = &v1.FilePatterns{
+		Include: []string{"*.onnx"},
+	}
+	err = ld.loadBaseModel(context.Background(), "google/gemma-7b", "", filePatterns, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
+	assert.Error(t, err)
+	assert.Empty(t, s3Client.uploadedKeys)
 }
 
 func TestLoadBaseModel_HuggingFace(t *testing.T) {
@@ -79,10 +305,13 @@ func TestLoadBaseModel_HuggingFace(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		1,
 		testr.New(t),
 	)
 	ld.tmpDir = "/tmp"
-	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
+	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
 	assert.NoError(t, err)
 
 	want := []string{
@@ -121,9 +350,12 @@ func TestLoadBaseModel_Ollama(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		1,
 		testr.New(t),
 	)
-	err := ld.loadBaseModel(context.Background(), "gemma:2b", "", v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA, nil)
+	err := ld.loadBaseModel(context.Background(), "gemma:2b", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA, nil)
 	assert.NoError(t, err)
 
 	want := []string{
@@ -143,6 +375,48 @@ func TestLoadBaseModel_Ollama(t *testing.T) {
 	assert.Empty(t, got.GgufModelPath)
 }
 
+func TestLoadBaseModel_OCI(t *testing.T) {
+	downloader := &fakeDownloader{
+		dirs: []string{
+			".cache/oci/download",
+		},
+		files: []string{
+			"model.gguf",
+			".cache/oci/download/sha256-1234.incomplete",
+		},
+	}
+	s3Client := &mockS3Client{}
+	mc := NewFakeModelClient()
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{d: downloader},
+		s3Client,
+		mc,
+		"loader0",
+		"cluster0",
+		1,
+		testr.New(t),
+	)
+	// The reference has more than three path components and must not be split into a repo and a file.
+	err := ld.loadBaseModel(context.Background(), "registry.example.com/org/models/llama:v1", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_OCI, nil)
+	assert.NoError(t, err)
+
+	want := []string{
+		"models/base-models/global/registry.example.com/org/models/llama-v1/model.gguf",
+	}
+	assert.ElementsMatch(t, want, s3Client.uploadedKeys)
+
+	got, err := mc.GetBaseModelPath(context.Background(), &v1.GetBaseModelPathRequest{
+		Id: "registry.example.com-org-models-llama:v1",
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, got.Formats)
+	assert.Equal(t, "models/base-models/global/registry.example.com/org/models/llama-v1", got.Path)
+	assert.Equal(t, "models/base-models/global/registry.example.com/org/models/llama-v1/model.gguf", got.GgufModelPath)
+}
+
 func TestLoadBaseModel_NvidiaTriton(t *testing.T) {
 	downloader := &fakeDownloader{
 		dirs: []string{
@@ -164,10 +438,13 @@ func TestLoadBaseModel_NvidiaTriton(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		1,
 		testr.New(t),
 	)
 	ld.tmpDir = "/tmp"
-	err := ld.loadBaseModel(context.Background(), "meta-llama/Meta-Llama-3.1-70B-Instruct-awq-triton", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
+	err := ld.loadBaseModel(context.Background(), "meta-llama/Meta-Llama-3.1-70B-Instruct-awq-triton", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
 	assert.NoError(t, err)
 
 	want := []string{
@@ -202,10 +479,13 @@ func TestLoadBaseModel_MultipleGGUFFiles(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		1,
 		testr.New(t),
 	)
 	ld.tmpDir = "/tmp"
-	err := ld.loadBaseModel(context.Background(), "lmstudio-community/phi-4-GGUF", "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
+	err := ld.loadBaseModel(context.Background(), "lmstudio-community/phi-4-GGUF", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
 	assert.NoError(t, err)
 
 	want := []string{
@@ -230,12 +510,75 @@ func TestLoadBaseModel_MultipleGGUFFiles(t *testing.T) {
 		assert.ElementsMatch(t, []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, got.Formats)
 		assert.Equal(t, "models/base-models/global/lmstudio-community/phi-4-GGUF/phi-4-Q3_"+q, got.Path)
 		assert.Equal(t, "models/base-models/global/lmstudio-community/phi-4-GGUF/phi-4-Q3_"+q+".gguf", got.GgufModelPath)
+
+		// Only the GGUF file of the model is included in the files.
+		files := mc.filesByID["lmstudio-community-phi-4-GGUF-phi-4-Q3_"+q]
+		assert.Len(t, files, 1)
+		assert.Equal(t, "phi-4-Q3_"+q+".gguf", files[0].Path)
 	}
 
 	_, err = mc.GetHFModelRepo(ctx, &v1.GetHFModelRepoRequest{Name: "lmstudio-community/phi-4-GGUF"})
 	assert.NoError(t, err)
 }
 
+func TestLoadBaseModel_MultipleGGUFFilesWithConfig(t *testing.T) {
+	downloader := &fakeDownloader{
+		dirs: []string{},
+		files: []string{
+			"config.json",
+			"model.safetensors",
+			"phi-4-Q3_K_L.gguf",
+			"phi-4-Q3_K_M.gguf",
+		},
+	}
+
+	s3Client := &mockS3Client{}
+	mc := NewFakeModelClient()
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{d: downloader},
+		s3Client,
+		mc,
+		"loader0",
+		"cluster0",
+		1,
+		testr.New(t),
+	)
+	ld.tmpDir = "/tmp"
+	err := ld.loadBaseModel(context.Background(), "microsoft/phi-4", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
+	assert.NoError(t, err)
+
+	// A model is created for the Hugging Face format in addition to the GGUF files.
+	ctx := context.Background()
+	got, err := mc.GetBaseModelPath(ctx, &v1.GetBaseModelPathRequest{Id: "microsoft-phi-4"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE}, got.Formats)
+	assert.Equal(t, "models/base-models/global/microsoft/phi-4", got.Path)
+	assert.Empty(t, got.GgufModelPath)
+	var paths []string
+	for _, f := range mc.filesByID["microsoft-phi-4"] {
+		paths = append(paths, f.Path)
+	}
+	assert.ElementsMatch(t, []string{"config.json", "model.safetensors"}, paths)
+
+	for _, q := range []string{"K_L", "K_M"} {
+		got, err := mc.GetBaseModelPath(ctx, &v1.GetBaseModelPathRequest{
+			Id: "microsoft-phi-4-phi-4-Q3_" + q,
+		})
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, got.Formats)
+		assert.Equal(t, "models/base-models/global/microsoft/phi-4/phi-4-Q3_"+q+".gguf", got.GgufModelPath)
+
+		var paths []string
+		for _, f := range mc.filesByID["microsoft-phi-4-phi-4-Q3_"+q] {
+			paths = append(paths, f.Path)
+		}
+		assert.ElementsMatch(t, []string{"config.json", "model.safetensors", "phi-4-Q3_" + q + ".gguf"}, paths)
+	}
+}
+
 func TestLoadBaseModel_SelectedGGUFFile(t *testing.T) {
 	downloader := &fakeDownloader{
 		dirs: []string{},
@@ -253,10 +596,13 @@ func TestLoadBaseModel_SelectedGGUFFile(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		1,
 		testr.New(t),
 	)
 	ld.tmpDir = "/tmp"
-	err := ld.loadBaseModel(context.Background(), "lmstudio-community/phi-4-GGUF/phi-4-Q3_K_M.gguf", "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
+	err := ld.loadBaseModel(context.Background(), "lmstudio-community/phi-4-GGUF/phi-4-Q3_K_M.gguf", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
 	assert.NoError(t, err)
 
 	want := []string{
@@ -278,7 +624,7 @@ func TestLoadBaseModel_SelectedGGUFFile(t *testing.T) {
 		"phi-4-Q3_K_L.gguf",
 	}
 	s3Client.uploadedKeys = []string{}
-	err = ld.loadBaseModel(context.Background(), "lmstudio-community/phi-4-GGUF/phi-4-Q3_K_L.gguf", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
+	err = ld.loadBaseModel(context.Background(), "lmstudio-community/phi-4-GGUF/phi-4-Q3_K_L.gguf", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
 	assert.NoError(t, err)
 
 	want = []string{
@@ -312,10 +658,13 @@ func TestLoadBaseModel_ProjectScoped(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		1,
 		testr.New(t),
 	)
 	ld.tmpDir = "/tmp"
-	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "proj0", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
+	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "", nil, "", "", "proj0", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, nil)
 	assert.NoError(t, err)
 
 	want := []string{
@@ -349,6 +698,9 @@ func TestLoadModelFronConfig_HuggingFace(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		1,
 		testr.New(t),
 	)
 	ld.tmpDir = "/tmp"
@@ -396,10 +748,13 @@ func TestLoadModel_InvalidFileFormat(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		1,
 		testr.New(t),
 	)
 	ld.tmpDir = "/tmp"
-	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
+	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
 	assert.Error(t, err)
 }
 
@@ -476,6 +831,9 @@ func TestPullAndLoadBaseModels(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		1,
 		testr.New(t),
 	)
 	ld.tmpDir = "/tmp"
@@ -495,6 +853,101 @@ func TestPullAndLoadBaseModels(t *testing.T) {
 	assert.Equal(t, "models/base-models/global/google/gemma-2b", got.Path)
 }
 
+func TestPullAndLoadBaseModels_UnsupportedSourceRepository(t *testing.T) {
+	downloader := &fakeDownloader{
+		files: []string{
+			"file0.gguf",
+		},
+	}
+
+	s3Client := &mockS3Client{}
+
+	mc := NewFakeModelClient()
+	mc.requestedBaseModelID = "gemma:2b"
+	mc.sourceRepository = v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA
+
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{
+			d:         downloader,
+			supported: []v1.SourceRepository{v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE},
+		},
+		s3Client,
+		mc,
+		"loader0",
+		"cluster0",
+		1,
+		testr.New(t),
+	)
+	ld.tmpDir = "/tmp"
+	err := ld.pullAndLoadBaseModels(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, s3Client.uploadedKeys)
+}
+
+func TestPullAndLoadBaseModels_Revision(t *testing.T) {
+	downloader := &fakeRevisionDownloader{
+		fakeDownloader: fakeDownloader{
+			files: []string{
+				"config.json",
+			},
+		},
+	}
+
+	mc := NewFakeModelClient()
+	mc.requestedBaseModelID = "google/gemma-2b"
+	mc.requestedRevision = "v1.0"
+	mc.sourceRepository = v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE
+
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{d: downloader},
+		&mockS3Client{},
+		mc,
+		"loader0",
+		"cluster0",
+		1,
+		testr.New(t),
+	)
+	ld.tmpDir = "/tmp"
+	err := ld.pullAndLoadBaseModels(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, "v1.0", downloader.gotRevision)
+	assert.Equal(t, "sha-v1.0", mc.revisionsByID["google-gemma-2b"])
+	assert.Equal(t, "sha-v1.0", mc.hfModelRepos["google/gemma-2b"])
+}
+
+func TestLoadBaseModel_RevisionNotSupported(t *testing.T) {
+	downloader := &fakeDownloader{
+		files: []string{
+			"file0.gguf",
+		},
+	}
+
+	mc := NewFakeModelClient()
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{d: downloader},
+		&mockS3Client{},
+		mc,
+		"loader0",
+		"cluster0",
+		1,
+		testr.New(t),
+	)
+	ld.tmpDir = "/tmp"
+	err := ld.loadBaseModel(context.Background(), "google/gemma-2b", "v1.0", nil, "", "", "", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, nil)
+	assert.Error(t, err)
+	assert.Empty(t, mc.pathsByID)
+}
+
 func TestPullAndLoadBaseModels_NoRequestedModel(t *testing.T) {
 	downloader := &fakeDownloader{}
 
@@ -507,6 +960,9 @@ func TestPullAndLoadBaseModels_NoRequestedModel(t *testing.T) {
 		&fakeDownloaderFactory{d: downloader},
 		s3Client,
 		mc,
+		"loader0",
+		"cluster0",
+		1,
 		testr.New(t),
 	)
 
@@ -518,42 +974,350 @@ func TestPullAndLoadBaseModels_NoRequestedModel(t *testing.T) {
 	assert.Empty(t, mc.pathsByID)
 }
 
+func TestIsRetryableError(t *testing.T) {
+	tcs := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "server error",
+			err:  fmt.Errorf("download: %w", &download.StatusError{StatusCode: http.StatusServiceUnavailable, Err: errors.New("unavailable")}),
+			want: true,
+		},
+		{
+			name: "too many requests",
+			err:  &download.StatusError{StatusCode: http.StatusTooManyRequests, Err: errors.New("throttled")},
+			want: true,
+		},
+		{
+			name: "not found",
+			err:  fmt.Errorf("download: %w", &download.StatusError{StatusCode: http.StatusNotFound, Err: errors.New("not found")}),
+			want: false,
+		},
+		{
+			name: "network error",
+			err:  fmt.Errorf("read body: %w", &net.OpError{Op: "read", Err: syscall.ECONNRESET}),
+			want: true,
+		},
+		{
+			name: "timeout",
+			err:  fmt.Errorf("download: %w", context.DeadlineExceeded),
+			want: true,
+		},
+		{
+			name: "other error",
+			err:  errors.New("no model format found"),
+			want: false,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isRetryableError(tc.err))
+		})
+	}
+}
+
+func TestDownloadAndUploadModel_Cancelled(t *testing.T) {
+	const pathPrefix = "models/base-models/global/google/gemma-2b"
+
+	s3Client := &mockS3Client{}
+	ld := New(
+		"bucket",
+		"models",
+		"base-models",
+		&fakeDownloaderFactory{d: &blockingDownloader{}},
+		s3Client,
+		NewFakeModelClient(),
+		"loader0",
+		"cluster0",
+		1,
+		testr.New(t),
+	)
+	ld.tmpDir = t.TempDir()
+	ld.statusSenderInterval = time.Millisecond
+
+	// A previous attempt has uploaded a file.
+	path := filepath.Join(t.TempDir(), "file0")
+	err := os.WriteFile(path, []byte("0123"), 0644)
+	assert.NoError(t, err)
+	u := newResumableUploader(s3Client, "bucket", uploadManifestKey(pathPrefix), ld.uploadPartSize, testr.New(t))
+	err = u.loadManifest(context.Background())
+	assert.NoError(t, err)
+	err = u.upload(context.Background(), path, pathPrefix+"/file0")
+	assert.NoError(t, err)
+	assert.Len(t, s3Client.objects, 2)
+
+	_, err = ld.downloadAndUploadModel(
+		context.Background(),
+		"google/gemma-2b",
+		"google/gemma-2b",
+		"", /* filename */
+		"", /* revision */
+		"", /* checksum */
+		nil,
+		v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		pathPrefix,
+		&cancelledStatusUpdateClient{},
+	)
+	assert.ErrorIs(t, err, errLoadingCancelled)
+	// The uploaded file and the upload manifest have been deleted.
+	assert.Empty(t, s3Client.objects)
+}
+
+// blockingDownloader blocks until the context is cancelled.
+type blockingDownloader struct {
+}
+
+func (d *blockingDownloader) download(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter, destDir string) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// cancelledStatusUpdateClient reports that the cancellation of the loading has been requested.
+type cancelledStatusUpdateClient struct {
+}
+
+func (c *cancelledStatusUpdateClient) updateStatus(ctx context.Context, msg, uploadPath string, progress *v1.ModelLoadingProgress) error {
+	return errLoadingCancelled
+}
+
 type mockS3Client struct {
+	// uploadedKeys is the list of keys of model files uploaded with multipart uploads.
 	uploadedKeys []string
+
+	objects    map[string]*mockS3Object
+	uploads    map[string]*mockMultipartUpload
+	numUploads int
+
+	numUploadedParts int
+	// failPartUploadAfter makes UploadPart fail after the given number of parts are uploaded if positive.
+	failPartUploadAfter int
+
+	mu sync.Mutex
+}
+
+type mockS3Object struct {
+	data           []byte
+	metadata       map[string]string
+	checksumSHA256 string
+}
+
+type mockMultipartUpload struct {
+	metadata map[string]string
+	parts    map[int32][]byte
+}
+
+// lock locks mu and initializes the maps. The caller must unlock mu.
+func (c *mockS3Client) lock() {
+	c.mu.Lock()
+	if c.objects == nil {
+		c.objects = map[string]*mockS3Object{}
+	}
+	if c.uploads == nil {
+		c.uploads = map[string]*mockMultipartUpload{}
+	}
 }
 
 func (c *mockS3Client) Upload(ctx context.Context, r io.Reader, bucket, key string) error {
+	c.lock()
+	defer c.mu.Unlock()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	c.objects[key] = &mockS3Object{data: b}
+	return nil
+}
+
+func (c *mockS3Client) Download(ctx context.Context, w io.WriterAt, bucket, key string) error {
+	c.lock()
+	defer c.mu.Unlock()
+	o, ok := c.objects[key]
+	if !ok {
+		return &types.NoSuchKey{}
+	}
+	_, err := w.WriteAt(o.data, 0)
+	return err
+}
+
+func (c *mockS3Client) HeadObject(ctx context.Context, bucket, key string) (*s3.HeadObjectOutput, error) {
+	c.lock()
+	defer c.mu.Unlock()
+	o, ok := c.objects[key]
+	if !ok {
+		return nil, &types.NotFound{}
+	}
+	resp := &s3.HeadObjectOutput{
+		ContentLength: aws.Int64(int64(len(o.data))),
+		Metadata:      o.metadata,
+	}
+	if o.checksumSHA256 != "" {
+		resp.ChecksumSHA256 = aws.String(o.checksumSHA256)
+	}
+	return resp, nil
+}
+
+func (c *mockS3Client) CreateMultipartUpload(ctx context.Context, bucket, key string, metadata map[string]string) (string, error) {
+	c.lock()
+	defer c.mu.Unlock()
+	uploadID := fmt.Sprintf("upload-%d", c.numUploads)
+	c.numUploads++
+	c.uploads[uploadID] = &mockMultipartUpload{
+		metadata: metadata,
+		parts:    map[int32][]byte{},
+	}
+	return uploadID, nil
+}
+
+func (c *mockS3Client) UploadPart(ctx context.Context, r io.ReadSeeker, bucket, key, uploadID string, partNumber int32, checksumSHA256 string) (string, error) {
+	c.lock()
+	defer c.mu.Unlock()
+	if c.failPartUploadAfter > 0 && c.numUploadedParts >= c.failPartUploadAfter {
+		return "", fmt.Errorf("injected failure")
+	}
+	u, ok := c.uploads[uploadID]
+	if !ok {
+		return "", fmt.Errorf("upload %q not found", uploadID)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	if sum := sha256.Sum256(b); base64.StdEncoding.EncodeToString(sum[:]) != checksumSHA256 {
+		return "", fmt.Errorf("checksum mismatch of part %d", partNumber)
+	}
+	u.parts[partNumber] = b
+	c.numUploadedParts++
+	return fmt.Sprintf("etag-%s-%d", uploadID, partNumber), nil
+}
+
+func (c *mockS3Client) ListParts(ctx context.Context, bucket, key, uploadID string) ([]types.Part, error) {
+	c.lock()
+	defer c.mu.Unlock()
+	u, ok := c.uploads[uploadID]
+	if !ok {
+		return nil, fmt.Errorf("upload %q not found", uploadID)
+	}
+	var ps []types.Part
+	for n := range u.parts {
+		ps = append(ps, types.Part{
+			PartNumber: aws.Int32(n),
+			ETag:       aws.String(fmt.Sprintf("etag-%s-%d", uploadID, n)),
+		})
+	}
+	return ps, nil
+}
+
+func (c *mockS3Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string, parts []types.CompletedPart) error {
+	c.lock()
+	defer c.mu.Unlock()
+	u, ok := c.uploads[uploadID]
+	if !ok {
+		return fmt.Errorf("upload %q not found", uploadID)
+	}
+	var (
+		b       []byte
+		digests [][]byte
+	)
+	for _, p := range parts {
+		pb := u.parts[aws.ToInt32(p.PartNumber)]
+		sum := sha256.Sum256(pb)
+		if base64.StdEncoding.EncodeToString(sum[:]) != aws.ToString(p.ChecksumSHA256) {
+			return fmt.Errorf("checksum mismatch of part %d", aws.ToInt32(p.PartNumber))
+		}
+		b = append(b, pb...)
+		digests = append(digests, sum[:])
+	}
+	c.objects[key] = &mockS3Object{
+		data:           b,
+		metadata:       u.metadata,
+		checksumSHA256: compositeSHA256(digests),
+	}
+	delete(c.uploads, uploadID)
 	c.uploadedKeys = append(c.uploadedKeys, key)
 	return nil
 }
 
+func (c *mockS3Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	c.lock()
+	defer c.mu.Unlock()
+	if _, ok := c.uploads[uploadID]; !ok {
+		return fmt.Errorf("upload %q not found", uploadID)
+	}
+	delete(c.uploads, uploadID)
+	return nil
+}
+
+func (c *mockS3Client) DeleteObject(ctx context.Context, bucket, key string) error {
+	c.lock()
+	defer c.mu.Unlock()
+	delete(c.objects, key)
+	return nil
+}
+
+func (c *mockS3Client) ListObjectsPages(ctx context.Context, bucket, prefix string, f func(page *s3.ListObjectsV2Output, lastPage bool) bool) error {
+	c.lock()
+	var objs []types.Object
+	for key, o := range c.objects {
+		if strings.HasPrefix(key, prefix) {
+			objs = append(objs, types.Object{
+				Key:  aws.String(key),
+				Size: aws.Int64(int64(len(o.data))),
+			})
+		}
+	}
+	c.mu.Unlock()
+	sort.Slice(objs, func(i, j int) bool { return aws.ToString(objs[i].Key) < aws.ToString(objs[j].Key) })
+	f(&s3.ListObjectsV2Output{Contents: objs}, true)
+	return nil
+}
+
 type fakeDownloader struct {
 	dirs  []string
 	files []string
+	// contents is the contents of the files. Files not in the map are empty.
+	contents map[string][]byte
 }
 
-func (d *fakeDownloader) download(ctx context.Context, modelPath, filename string, destDir string) error {
+func (d *fakeDownloader) download(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter, destDir string) error {
 	for _, d := range d.dirs {
 		if err := os.MkdirAll(filepath.Join(destDir, d), 0755); err != nil {
 			return err
 		}
 	}
 	for _, f := range d.files {
-		file, err := os.Create(filepath.Join(destDir, f))
-		if err != nil {
-			return err
-		}
-		if err := file.Close(); err != nil {
+		if err := os.WriteFile(filepath.Join(destDir, f), d.contents[f], 0644); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// fakeRevisionDownloader is a fakeDownloader that resolves a revision to "sha-<revision>".
+type fakeRevisionDownloader struct {
+	fakeDownloader
+
+	gotRevision string
+}
+
+func (d *fakeRevisionDownloader) downloadRevision(ctx context.Context, modelPath, filename, revision string, filter *pathfilter.Filter, destDir string) (string, error) {
+	d.gotRevision = revision
+	if err := d.download(ctx, modelPath, filename, filter, destDir); err != nil {
+		return "", err
+	}
+	return "sha-" + revision, nil
+}
+
 type fakeDownloaderFactory struct {
-	d *fakeDownloader
+	d         ModelDownloader
+	supported []v1.SourceRepository
 }
 
 func (f *fakeDownloaderFactory) Create(context.Context, v1.SourceRepository) (ModelDownloader, error) {
 	return f.d, nil
 }
+
+func (f *fakeDownloaderFactory) SupportedSourceRepositories() []v1.SourceRepository {
+	return f.supported
+}
@@ -0,0 +1,100 @@
+package loader
+
+import (
+	"fmt"
+	"mime"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/llmariner/model-manager/api/v1"
+)
+
+const defaultContentType = "application/octet-stream"
+
+// toModelFiles builds the manifest of the uploaded files from the upload manifest. The paths of the files are
+// relative to the path prefix.
+func toModelFiles(uploader *resumableUploader, pathPrefix string, keys []string) ([]*v1.ModelFile, error) {
+	var files []*v1.ModelFile
+	for _, key := range keys {
+		f, ok := uploader.getFile(key)
+		if !ok {
+			return nil, fmt.Errorf("no upload record of %q", key)
+		}
+		rel, err := filepath.Rel(pathPrefix, key)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &v1.ModelFile{
+			Path:        filepath.ToSlash(rel),
+			SizeBytes:   f.Size,
+			Sha256:      f.SHA256,
+			ContentType: contentTypeOf(key),
+		})
+	}
+	return files, nil
+}
+
+// contentTypeOf returns the content type of the file inferred from its extension.
+func contentTypeOf(path string) string {
+	if t := mime.TypeByExtension(filepath.Ext(path)); t != "" {
+		return t
+	}
+	return defaultContentType
+}
+
+// filterGGUFModelFiles returns the files excluding the GGUF files other than the given one.
+func filterGGUFModelFiles(files []*v1.ModelFile, ggufPath string) []*v1.ModelFile {
+	var fs []*v1.ModelFile
+	for _, f := range files {
+		if strings.HasSuffix(f.Path, ".gguf") && f.Path != ggufPath {
+			continue
+		}
+		fs = append(fs, f)
+	}
+	return fs
+}
+
+// modelSize returns the size of the model computed from its files. It returns nil if the files are unknown.
+func (mi *modelInfo) modelSize() *v1.ModelSize {
+	if len(mi.files) == 0 {
+		return nil
+	}
+
+	var total, gguf, nonGGUF int64
+	ggufPath := strings.TrimPrefix(mi.ggufModelPath, mi.filesBasePath+"/")
+	for _, f := range mi.files {
+		total += f.SizeBytes
+		switch {
+		case f.Path == ggufPath:
+			gguf += f.SizeBytes
+		case !strings.HasSuffix(f.Path, ".gguf"):
+			nonGGUF += f.SizeBytes
+		}
+	}
+
+	size := &v1.ModelSize{TotalBytes: total}
+	for _, format := range mi.formats {
+		var n int64
+		switch format {
+		case v1.ModelFormat_MODEL_FORMAT_GGUF:
+			// Only the GGUF file is required.
+			n = gguf
+		case v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE,
+			v1.ModelFormat_MODEL_FORMAT_NVIDIA_TRITON,
+			v1.ModelFormat_MODEL_FORMAT_ONNX,
+			v1.ModelFormat_MODEL_FORMAT_MLX,
+			v1.ModelFormat_MODEL_FORMAT_GPTQ,
+			v1.ModelFormat_MODEL_FORMAT_AWQ,
+			v1.ModelFormat_MODEL_FORMAT_VLLM:
+			// The GGUF files are not used in the formats.
+			n = nonGGUF
+		default:
+			n = total
+		}
+		size.FormatSizes = append(size.FormatSizes, &v1.ModelSize_FormatSize{
+			Format:    format,
+			SizeBytes: n,
+		})
+	}
+	return size
+}
@@ -0,0 +1,61 @@
+package loader
+
+import (
+	"testing"
+
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentTypeOf(t *testing.T) {
+	assert.Equal(t, "application/json", contentTypeOf("models/m0/config.json"))
+	assert.Equal(t, "application/octet-stream", contentTypeOf("models/m0/model.safetensors"))
+	assert.Equal(t, "application/octet-stream", contentTypeOf("models/m0/blobs/sha256-0123"))
+}
+
+func TestFilterGGUFModelFiles(t *testing.T) {
+	files := []*v1.ModelFile{
+		{Path: "README.md"},
+		{Path: "m-q4.gguf"},
+		{Path: "m-q8.gguf"},
+	}
+	got := filterGGUFModelFiles(files, "m-q8.gguf")
+	var paths []string
+	for _, f := range got {
+		paths = append(paths, f.Path)
+	}
+	assert.Equal(t, []string{"README.md", "m-q8.gguf"}, paths)
+}
+
+func TestModelSize(t *testing.T) {
+	files := []*v1.ModelFile{
+		{Path: "config.json", SizeBytes: 1},
+		{Path: "model.safetensors", SizeBytes: 10},
+		{Path: "model-q4.gguf", SizeBytes: 100},
+		{Path: "model-q8.gguf", SizeBytes: 200},
+	}
+	mi := &modelInfo{
+		formats: []v1.ModelFormat{
+			v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE,
+			v1.ModelFormat_MODEL_FORMAT_GGUF,
+		},
+		ggufModelPath: "models/m0/model-q8.gguf",
+		files:         files,
+		filesBasePath: "models/m0",
+	}
+	got := mi.modelSize()
+	assert.Equal(t, int64(311), got.TotalBytes)
+	assert.Len(t, got.FormatSizes, 2)
+	assert.Equal(t, int64(11), got.FormatSizes[0].SizeBytes)
+	assert.Equal(t, int64(200), got.FormatSizes[1].SizeBytes)
+
+	mi = &modelInfo{
+		formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_OLLAMA},
+		files:         files,
+		filesBasePath: "models/m0",
+	}
+	got = mi.modelSize()
+	assert.Equal(t, int64(311), got.FormatSizes[0].SizeBytes)
+
+	assert.Nil(t, (&modelInfo{}).modelSize())
+}
@@ -0,0 +1,102 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
+	"github.com/llmariner/model-manager/loader/internal/oci"
+)
+
+// NewOCIDownloader creates a new OCIDownloader. Registries are accessed over HTTP if insecure is true.
+// Anonymous access is used if creds is nil.
+func NewOCIDownloader(insecure bool, creds *oci.Credentials, concurrency int, log logr.Logger) *OCIDownloader {
+	log = log.WithName("oci")
+	return &OCIDownloader{
+		client:      oci.NewClient(insecure, creds, log),
+		concurrency: concurrency,
+		log:         log,
+	}
+}
+
+// OCIDownloader downloads models stored as OCI artifacts (e.g., pushed with ORAS).
+type OCIDownloader struct {
+	client *oci.Client
+	// concurrency is the maximum number of layers downloaded in parallel.
+	concurrency int
+	log         logr.Logger
+}
+
+// download pulls the layers of the manifest that modelPath refers to. Each layer is stored at the path in its
+// title annotation. Only the layers whose paths match the filter are downloaded.
+func (d *OCIDownloader) download(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter, destDir string) error {
+	ref, layers, err := d.listLayers(ctx, modelPath, filter)
+	if err != nil {
+		return err
+	}
+	d.log.Info("Downloading the model", "modelPath", modelPath, "layers", len(layers))
+
+	return runInParallel(ctx, d.concurrency, len(layers), func(ctx context.Context, i int) error {
+		l := layers[i]
+		destPath := filepath.Join(destDir, filepath.FromSlash(l.Title()))
+		incompletePath := filepath.Join(destDir, ociDownloadCache, strings.Replace(l.Digest, ":", "-", 1)+".incomplete")
+		return d.client.DownloadBlob(ctx, ref, l, destPath, incompletePath)
+	})
+}
+
+// modelSize returns the total size of the layers that match the filter.
+func (d *OCIDownloader) modelSize(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter) (int64, error) {
+	_, layers, err := d.listLayers(ctx, modelPath, filter)
+	if err != nil {
+		return 0, err
+	}
+	var size int64
+	for _, l := range layers {
+		size += l.Size
+	}
+	return size, nil
+}
+
+// listLayers fetches the manifest and returns the layers that match the filter.
+func (d *OCIDownloader) listLayers(ctx context.Context, modelPath string, filter *pathfilter.Filter) (oci.Reference, []oci.Descriptor, error) {
+	ref, err := oci.ParseReference(modelPath)
+	if err != nil {
+		return oci.Reference{}, nil, err
+	}
+	m, err := d.client.GetManifest(ctx, ref)
+	if err != nil {
+		return oci.Reference{}, nil, err
+	}
+	d.log.Info("Fetched the manifest", "modelPath", modelPath, "digest", m.Digest)
+
+	var layers []oci.Descriptor
+	seen := map[string]bool{}
+	for _, l := range m.Layers {
+		title := l.Title()
+		if title == "" {
+			return oci.Reference{}, nil, fmt.Errorf("layer %s does not have the %s annotation", l.Digest, oci.TitleAnnotation)
+		}
+		// The title is used as a path under the destination directory.
+		if p := path.Clean(title); p != title || path.IsAbs(p) || p == ".." || strings.HasPrefix(p, "../") {
+			return oci.Reference{}, nil, fmt.Errorf("invalid file name %q in layer %s", title, l.Digest)
+		}
+		if seen[title] {
+			return oci.Reference{}, nil, fmt.Errorf("duplicate file name %q in the manifest", title)
+		}
+		seen[title] = true
+		if filter.Match(title) {
+			layers = append(layers, l)
+		}
+	}
+	if len(layers) == 0 {
+		if !filter.IsEmpty() {
+			return oci.Reference{}, nil, fmt.Errorf("no layers in %q match the file patterns", modelPath)
+		}
+		return oci.Reference{}, nil, fmt.Errorf("no layers found in %q", modelPath)
+	}
+	return ref, layers, nil
+}
@@ -0,0 +1,156 @@
+package loader
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
+	"github.com/stretchr/testify/assert"
+)
+
+// newFakeOCIRegistry returns a fake registry that serves "org/llama:v1" with a layer per file. The keys of
+// files are the titles of the layers.
+func newFakeOCIRegistry(t *testing.T, files map[string]string) *httptest.Server {
+	blobs := map[string]string{}
+	type descriptor struct {
+		MediaType   string            `json:"mediaType"`
+		Digest      string            `json:"digest"`
+		Size        int               `json:"size"`
+		Annotations map[string]string `json:"annotations,omitempty"`
+	}
+	toDescriptor := func(b string) descriptor {
+		sum := sha256.Sum256([]byte(b))
+		d := "sha256:" + hex.EncodeToString(sum[:])
+		blobs[d] = b
+		return descriptor{
+			MediaType: "application/octet-stream",
+			Digest:    d,
+			Size:      len(b),
+		}
+	}
+	var layers []descriptor
+	for title, content := range files {
+		d := toDescriptor(content)
+		d.Annotations = map[string]string{"org.opencontainers.image.title": title}
+		layers = append(layers, d)
+	}
+	manifest, err := json.Marshal(map[string]any{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"config":        toDescriptor("{}"),
+		"layers":        layers,
+	})
+	assert.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/org/llama/manifests/v1", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(manifest)
+	})
+	mux.HandleFunc("/v2/org/llama/blobs/{digest}", func(w http.ResponseWriter, r *http.Request) {
+		b, ok := blobs[r.PathValue("digest")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, "blob", time.Time{}, bytes.NewReader([]byte(b)))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestOCIDownload(t *testing.T) {
+	tcs := []struct {
+		name     string
+		files    map[string]string
+		include  []string
+		want     []string
+		wantSize int64
+		wantErr  bool
+	}{
+		{
+			name: "all files",
+			files: map[string]string{
+				"config.json":          "{}",
+				"weights/model.gguf":   "weights",
+				"weights/model-2.gguf": "weights2",
+			},
+			want:     []string{"config.json", "weights/model.gguf", "weights/model-2.gguf"},
+			wantSize: 17,
+		},
+		{
+			name: "file patterns",
+			files: map[string]string{
+				"config.json":        "{}",
+				"weights/model.gguf": "weights",
+			},
+			include:  []string{"*.gguf"},
+			want:     []string{"weights/model.gguf"},
+			wantSize: 7,
+		},
+		{
+			name: "no matching file",
+			files: map[string]string{
+				"config.json": "{}",
+			},
+			include: []string{"*.gguf"},
+			wantErr: true,
+		},
+		{
+			name: "path outside the destination directory",
+			files: map[string]string{
+				"../model.gguf": "weights",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := newFakeOCIRegistry(t, tc.files)
+			ref := strings.TrimPrefix(srv.URL, "http://") + "/org/llama:v1"
+			d := NewOCIDownloader(true, nil, 2, testr.New(t))
+			destDir := t.TempDir()
+			filter, err := pathfilter.New(tc.include, nil)
+			assert.NoError(t, err)
+
+			size, err := d.modelSize(context.Background(), ref, "", filter)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantSize, size)
+
+			err = d.download(context.Background(), ref, "", filter, destDir)
+			assert.NoError(t, err)
+
+			var got []string
+			err = filepath.Walk(destDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !info.IsDir() && !isInDownloadCache(path) {
+					rel, err := filepath.Rel(destDir, path)
+					if err != nil {
+						return err
+					}
+					got = append(got, filepath.ToSlash(rel))
+				}
+				return nil
+			})
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
@@ -1,88 +1,106 @@
 package loader
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"os"
-	"os/exec"
-	"time"
+	"path/filepath"
 
 	"github.com/go-logr/logr"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
+	"github.com/llmariner/model-manager/loader/internal/ollama"
 )
 
-// NewOllamaDownloader creates a new OllamaDownloader.
-func NewOllamaDownloader(port int, log logr.Logger) *OllamaDownloader {
+// NewOllamaDownloader creates a new OllamaDownloader. Registries are accessed over HTTP if insecure is true.
+func NewOllamaDownloader(insecure bool, concurrency int, log logr.Logger) *OllamaDownloader {
+	log = log.WithName("ollama")
 	return &OllamaDownloader{
-		log:  log.WithName("ollama"),
-		port: port,
+		client:      ollama.NewClient(insecure, log),
+		concurrency: concurrency,
+		log:         log,
 	}
 }
 
-// OllamaDownloader downloads models from Ollama.
+// OllamaDownloader downloads models from Ollama registries.
 type OllamaDownloader struct {
-	port int
-	log  logr.Logger
+	client *ollama.Client
+	// concurrency is the maximum number of blobs downloaded in parallel.
+	concurrency int
+	log         logr.Logger
 }
 
-func (o *OllamaDownloader) serveServer(ctx context.Context) error {
-	return o.runCommand(ctx, "serve")
-}
-
-func (o *OllamaDownloader) waitForReady(ctx context.Context) error {
-	const (
-		timeout = 30 * time.Second
-		tick    = 1 * time.Second
-	)
-	o.log.Info("Waiting for Ollama to be ready")
-	ticker := time.NewTicker(tick)
-	defer ticker.Stop()
-	for {
-		select {
-		case <-ticker.C:
-			if err := o.runCommand(ctx, "list"); err == nil {
-				return nil
-			}
-		case <-time.After(timeout):
-			return fmt.Errorf("timeout waiting for Ollama to be ready")
-		case <-ctx.Done():
-			return ctx.Err()
-		}
+// download pulls the model from the registry. The manifest and the blobs are stored under destDir in the same
+// layout as Ollama (e.g., "manifests/registry.ollama.ai/library/gemma/2b" and "blobs/sha256-<digest>")
+// so that Ollama can serve the model from the uploaded files.
+//
+// Only the files whose relative paths match the filter are downloaded.
+func (o *OllamaDownloader) download(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter, destDir string) error {
+	name, manifest, blobs, err := o.listBlobs(ctx, modelPath, filter)
+	if err != nil {
+		return err
 	}
-}
+	o.log.Info("Downloading the model", "modelPath", modelPath, "blobs", len(blobs))
 
-func (o *OllamaDownloader) download(ctx context.Context, modelPath, filename, destDir string) error {
-	if err := os.Setenv("OLLAMA_HOST", fmt.Sprintf("0.0.0.0:%d", o.port)); err != nil {
+	if err := runInParallel(ctx, o.concurrency, len(blobs), func(ctx context.Context, i int) error {
+		l := blobs[i]
+		return o.client.DownloadBlob(ctx, name, l, filepath.Join(destDir, ollama.BlobPath(l.Digest)))
+	}); err != nil {
 		return err
 	}
-	if err := os.Setenv("OLLAMA_MODELS", destDir); err != nil {
-		return err
+
+	// Write the manifest last so that the model is not visible to Ollama until all the blobs are downloaded.
+	p := name.ManifestPath()
+	if !filter.Match(filepath.ToSlash(p)) {
+		return nil
+	}
+	p = filepath.Join(destDir, p)
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return fmt.Errorf("create directory: %s", err)
+	}
+	if err := os.WriteFile(p, manifest.Raw, 0644); err != nil {
+		return fmt.Errorf("write manifest: %s", err)
 	}
+	return nil
+}
 
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-	go func() {
-		if err := o.serveServer(ctx); err != nil {
-			o.log.V(2).Info("stopped serving server", "reason", err)
-			cancel()
-		}
-	}()
-	if err := o.waitForReady(ctx); err != nil {
-		return err
+// modelSize returns the total size of the manifest and the blobs that match the filter.
+func (o *OllamaDownloader) modelSize(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter) (int64, error) {
+	name, manifest, blobs, err := o.listBlobs(ctx, modelPath, filter)
+	if err != nil {
+		return 0, err
+	}
+	var size int64
+	if filter.Match(filepath.ToSlash(name.ManifestPath())) {
+		size += int64(len(manifest.Raw))
 	}
-	return o.runCommand(ctx, "pull", modelPath)
+	for _, l := range blobs {
+		size += l.Size
+	}
+	return size, nil
 }
 
-func (o *OllamaDownloader) runCommand(ctx context.Context, args ...string) error {
-	cmd := exec.CommandContext(ctx, "ollama", args...)
-	var errb bytes.Buffer
-	cmd.Stderr = &errb
+// listBlobs fetches the manifest of the model and returns the blobs that match the filter.
+func (o *OllamaDownloader) listBlobs(ctx context.Context, modelPath string, filter *pathfilter.Filter) (ollama.Name, *ollama.Manifest, []ollama.Layer, error) {
+	name, err := ollama.ParseName(modelPath)
+	if err != nil {
+		return ollama.Name{}, nil, nil, err
+	}
+	manifest, err := o.client.GetManifest(ctx, name)
+	if err != nil {
+		return ollama.Name{}, nil, nil, err
+	}
 
-	o.log.Info("Running Ollama command", "args", args)
-	if err := cmd.Run(); err != nil {
-		o.log.Error(err, "Failed to run ollama command", "args", args, "stderr", errb.String())
-		return err
+	var blobs []ollama.Layer
+	seen := map[string]bool{}
+	for _, l := range manifest.Blobs() {
+		// Layers can share the same blob.
+		if seen[l.Digest] {
+			continue
+		}
+		seen[l.Digest] = true
+		if filter.Match(filepath.ToSlash(ollama.BlobPath(l.Digest))) {
+			blobs = append(blobs, l)
+		}
 	}
-	o.log.Info("Ollama command completed", "args", args)
-	return nil
+	return name, manifest, blobs, nil
 }
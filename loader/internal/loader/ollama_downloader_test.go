@@ -0,0 +1,120 @@
+package loader
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeOllamaModel is the model served by a fake Ollama registry.
+type fakeOllamaModel struct {
+	manifest     string
+	configDigest string
+	modelDigest  string
+}
+
+// newFakeOllamaRegistry returns a fake registry that serves "library/gemma:2b".
+func newFakeOllamaRegistry(t *testing.T) (*httptest.Server, *fakeOllamaModel) {
+	digest := func(b string) string {
+		sum := sha256.Sum256([]byte(b))
+		return "sha256:" + hex.EncodeToString(sum[:])
+	}
+	blobs := map[string]string{}
+	config, model := "{}", "weights"
+	configDigest, modelDigest := digest(config), digest(model)
+	blobs[configDigest] = config
+	blobs[modelDigest] = model
+	manifest := fmt.Sprintf(`{"schemaVersion":2,"config":{"digest":%q,"size":2},"layers":[{"mediaType":"application/vnd.ollama.image.model","digest":%q,"size":7}]}`, configDigest, modelDigest)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/library/gemma/manifests/2b", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(manifest))
+	})
+	mux.HandleFunc("/v2/library/gemma/blobs/{digest}", func(w http.ResponseWriter, r *http.Request) {
+		b, ok := blobs[r.PathValue("digest")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, "blob", time.Time{}, bytes.NewReader([]byte(b)))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv, &fakeOllamaModel{
+		manifest:     manifest,
+		configDigest: configDigest,
+		modelDigest:  modelDigest,
+	}
+}
+
+func TestOllamaDownload(t *testing.T) {
+	srv, m := newFakeOllamaRegistry(t)
+	host := strings.TrimPrefix(srv.URL, "http://")
+	manifestPath := filepath.Join("manifests", host, "library", "gemma", "2b")
+	configPath := "blobs/" + strings.Replace(m.configDigest, ":", "-", 1)
+	modelBlobPath := "blobs/" + strings.Replace(m.modelDigest, ":", "-", 1)
+
+	tcs := []struct {
+		name     string
+		exclude  []string
+		want     []string
+		wantSize int64
+	}{
+		{
+			name:     "all files",
+			want:     []string{manifestPath, configPath, modelBlobPath},
+			wantSize: int64(len(m.manifest)) + 9,
+		},
+		{
+			name:     "file patterns",
+			exclude:  []string{"manifests/", modelBlobPath},
+			want:     []string{configPath},
+			wantSize: 2,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewOllamaDownloader(true, 2, testr.New(t))
+			destDir := t.TempDir()
+			filter, err := pathfilter.New(nil, tc.exclude)
+			assert.NoError(t, err)
+
+			size, err := d.modelSize(context.Background(), host+"/library/gemma:2b", "", filter)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantSize, size)
+
+			err = d.download(context.Background(), host+"/library/gemma:2b", "", filter, destDir)
+			assert.NoError(t, err)
+
+			var got []string
+			err = filepath.Walk(destDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if !info.IsDir() {
+					rel, err := filepath.Rel(destDir, path)
+					if err != nil {
+						return err
+					}
+					got = append(got, rel)
+				}
+				return nil
+			})
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reconcile checks that the files of the loaded models exist in the object store. Models whose files are
+// missing or partially present are marked as degraded, and degraded models whose files are found again are
+// marked as loaded. Objects that no model references are reported.
+func (l *L) reconcile(ctx context.Context) error {
+	actx := auth.AppendWorkerAuthorization(ctx)
+
+	// List the models before the objects so that the objects of every listed model have been uploaded
+	// by the time they are listed.
+	resp, err := l.modelClient.ListLoadedModelPaths(actx, &v1.ListLoadedModelPathsRequest{})
+	if err != nil {
+		if isUnimplementedError(err) {
+			// The server does not support reconciliation yet.
+			l.log.V(1).Info("Skipping reconciliation", "error", err)
+			return nil
+		}
+		return err
+	}
+
+	objs, err := l.listObjects(ctx)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		keys = append(keys, aws.ToString(obj.Key))
+	}
+	slices.Sort(keys)
+
+	var numDegraded int
+	for _, m := range resp.Models {
+		log := l.log.WithValues("modelID", m.Id, "path", m.Path)
+		if !strings.HasPrefix(m.Path, l.objectStorePathPrefix+"/") {
+			// The model is stored in another location.
+			log.V(1).Info("Skipping the model outside of the object store path prefix")
+			continue
+		}
+
+		reason, err := l.findMissingModelObjects(ctx, m, keys)
+		if err != nil {
+			return err
+		}
+		degraded := reason != ""
+		if degraded {
+			numDegraded++
+		}
+		isDegraded := m.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED
+		if degraded == isDegraded && reason == m.DegradedReason {
+			continue
+		}
+
+		if degraded {
+			log.Info("Model files are missing", "reason", reason)
+		} else {
+			log.Info("Model files have been found again")
+		}
+		if _, err := l.modelClient.UpdateModelDegradation(actx, &v1.UpdateModelDegradationRequest{
+			Id:          m.Id,
+			ProjectId:   m.ProjectId,
+			IsBaseModel: m.IsBaseModel,
+			Degraded:    degraded,
+			Reason:      reason,
+		}); err != nil {
+			if c := status.Code(err); c == codes.NotFound || c == codes.FailedPrecondition {
+				// The model has been deleted or reloaded since it was listed.
+				log.Info("Skipping the model updated concurrently", "error", err)
+				continue
+			}
+			return err
+		}
+	}
+
+	// List the model paths after the objects so that the objects of the models loaded meanwhile are not
+	// reported.
+	var orphans []OrphanObject
+	presp, err := l.modelClient.ListModelPaths(actx, &v1.ListModelPathsRequest{})
+	if err == nil {
+		orphans = findOrphanObjects(objs, presp.Paths)
+	} else if isUnimplementedError(err) {
+		// The server does not support listing the model paths yet.
+		l.log.V(1).Info("Skipping finding orphan objects", "error", err)
+	} else {
+		return err
+	}
+	var orphanBytes int64
+	for _, o := range orphans {
+		l.log.V(1).Info("Found an orphan object", "key", o.Key, "size", o.Size)
+		orphanBytes += o.Size
+	}
+
+	l.log.Info("Reconciled the models with the object store",
+		"models", len(resp.Models),
+		"degradedModels", numDegraded,
+		"orphanObjects", len(orphans),
+		"orphanBytes", orphanBytes,
+	)
+	return nil
+}
+
+// findMissingModelObjects returns the reason why the model is degraded. It returns an empty string if all files
+// of the model are present. The keys of the objects must be sorted.
+func (l *L) findMissingModelObjects(ctx context.Context, m *v1.LoadedModelPath, keys []string) (string, error) {
+	if p := m.GgufModelPath; p != "" {
+		// The GGUF file of a per-file base model is not stored under the model path.
+		if !hasObject(keys, p) {
+			return fmt.Sprintf("GGUF model file %q is missing", p), nil
+		}
+	} else if !hasObjectUnder(keys, m.Path) {
+		return fmt.Sprintf("no model file found at %q", m.Path), nil
+	}
+
+	// The upload manifest records the files that have been uploaded. It does not exist for models loaded
+	// before resumable uploads were introduced.
+	if !hasObject(keys, uploadManifestKey(m.Path)) {
+		return "", nil
+	}
+	uploader := newResumableUploader(l.s3Client, l.objectStoreBucket, uploadManifestKey(m.Path), l.uploadPartSize, l.log)
+	if err := uploader.loadManifest(ctx); err != nil {
+		return "", err
+	}
+	var uploaded, missing []string
+	for key, f := range uploader.manifest.Files {
+		if !f.Completed {
+			continue
+		}
+		uploaded = append(uploaded, key)
+		if !hasObject(keys, key) {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return "", nil
+	}
+	slices.Sort(missing)
+	return fmt.Sprintf("%d of %d model files are missing (e.g., %q)", len(missing), len(uploaded), missing[0]), nil
+}
+
+// hasObject returns true if the sorted keys contain the key.
+func hasObject(keys []string, key string) bool {
+	_, found := slices.BinarySearch(keys, key)
+	return found
+}
+
+// hasObjectUnder returns true if the sorted keys contain the path or a key under the path.
+func hasObjectUnder(keys []string, path string) bool {
+	if hasObject(keys, path) {
+		return true
+	}
+	i, _ := slices.BinarySearch(keys, path+"/")
+	return i < len(keys) && strings.HasPrefix(keys[i], path+"/")
+}
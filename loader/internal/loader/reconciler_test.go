@@ -0,0 +1,124 @@
+package loader
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile(t *testing.T) {
+	const manifest = `{"files":{
+		"models/base-models/global/m2/config.json":{"completed":true},
+		"models/base-models/global/m2/model.safetensors":{"completed":true}
+	}}`
+	s3Client := &mockS3Client{
+		objects: map[string]*mockS3Object{
+			"models/base-models/global/m0/config.json": {data: []byte("{}")},
+			"models/base-models/global/m0/model.gguf":  {data: []byte("data")},
+			// The GGUF file of m1 is missing.
+			"models/base-models/global/m1/config.json": {data: []byte("{}")},
+			// One of the files recorded in the upload manifest of m2 is missing.
+			"models/base-models/global/m2/config.json":          {data: []byte("{}")},
+			"models/base-models/global/m2.upload-manifest.json": {data: []byte(manifest)},
+			// The GGUF file of per-file r0/m6 is not stored under the model path.
+			"models/base-models/global/r0/m6.gguf": {data: []byte("data")},
+			// The files of degraded m3 have been restored.
+			"models/t0/p0/ft:m3/adapter.bin": {data: []byte("data")},
+			"models/orphan/file":             {data: []byte("data")},
+		},
+	}
+	mc := NewFakeModelClient()
+	succeeded := v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED
+	mc.loadedModels = []*v1.LoadedModelPath{
+		{
+			Id:            "m0",
+			IsBaseModel:   true,
+			Path:          "models/base-models/global/m0",
+			GgufModelPath: "models/base-models/global/m0/model.gguf",
+			LoadingStatus: succeeded,
+		},
+		{
+			Id:            "m1",
+			IsBaseModel:   true,
+			Path:          "models/base-models/global/m1",
+			GgufModelPath: "models/base-models/global/m1/model.gguf",
+			LoadingStatus: succeeded,
+		},
+		{
+			Id:            "m2",
+			IsBaseModel:   true,
+			Path:          "models/base-models/global/m2",
+			LoadingStatus: succeeded,
+		},
+		{
+			Id:             "ft:m3",
+			Path:           "models/t0/p0/ft:m3",
+			LoadingStatus:  v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED,
+			DegradedReason: "no model file found",
+		},
+		{
+			Id:            "ft:m4",
+			Path:          "models/t0/p0/ft:m4",
+			LoadingStatus: succeeded,
+		},
+		{
+			Id:            "r0/m6",
+			IsBaseModel:   true,
+			Path:          "models/base-models/global/r0/m6",
+			GgufModelPath: "models/base-models/global/r0/m6.gguf",
+			LoadingStatus: succeeded,
+		},
+		// Models outside of the path prefix are not checked.
+		{
+			Id:            "ft:m5",
+			Path:          "other/ft:m5",
+			LoadingStatus: succeeded,
+		},
+	}
+	for _, m := range mc.loadedModels {
+		mc.pathsByID[m.Id] = m.Path
+	}
+
+	ld := New("bucket", "models", "base-models", &fakeDownloaderFactory{}, s3Client, mc, "loader0", "cluster0", 1, testr.New(t))
+	err := ld.reconcile(context.Background())
+	assert.NoError(t, err)
+
+	got := map[string]*v1.UpdateModelDegradationRequest{}
+	for _, u := range mc.degradationUpdates {
+		got[u.Id] = u
+	}
+	assert.Len(t, got, 4)
+	assert.True(t, got["m1"].Degraded)
+	assert.Equal(t, `GGUF model file "models/base-models/global/m1/model.gguf" is missing`, got["m1"].Reason)
+	assert.True(t, got["m2"].Degraded)
+	assert.Equal(t, `1 of 2 model files are missing (e.g., "models/base-models/global/m2/model.safetensors")`, got["m2"].Reason)
+	assert.False(t, got["ft:m3"].Degraded)
+	assert.True(t, got["ft:m4"].Degraded)
+	assert.Equal(t, `no model file found at "models/t0/p0/ft:m4"`, got["ft:m4"].Reason)
+
+	// The models whose state does not change are not updated again.
+	mc.loadedModels = []*v1.LoadedModelPath{
+		{
+			Id:             "ft:m4",
+			Path:           "models/t0/p0/ft:m4",
+			LoadingStatus:  v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED,
+			DegradedReason: got["ft:m4"].Reason,
+		},
+	}
+	mc.degradationUpdates = nil
+	err = ld.reconcile(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, mc.degradationUpdates)
+}
+
+func TestHasObjectUnder(t *testing.T) {
+	keys := []string{"a/b", "a/bc/d", "a/c/d"}
+	assert.True(t, hasObjectUnder(keys, "a/b"))
+	assert.True(t, hasObjectUnder(keys, "a/c"))
+	assert.True(t, hasObjectUnder(keys, "a"))
+	assert.False(t, hasObjectUnder(keys, "a/bc/d/e"))
+	assert.False(t, hasObjectUnder(keys, "a/d"))
+}
@@ -8,8 +8,11 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/go-logr/logr"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
 )
 
 type s3Client interface {
@@ -18,12 +21,13 @@ type s3Client interface {
 }
 
 // NewS3Downloader returns a new S3Downloader.
-func NewS3Downloader(s3Client s3Client, bucket, pathPrefix string, log logr.Logger) *S3Downloader {
+func NewS3Downloader(s3Client s3Client, bucket, pathPrefix string, concurrency int, log logr.Logger) *S3Downloader {
 	return &S3Downloader{
-		s3Client:   s3Client,
-		bucket:     bucket,
-		pathPrefix: pathPrefix,
-		log:        log.WithName("s3"),
+		s3Client:    s3Client,
+		bucket:      bucket,
+		pathPrefix:  pathPrefix,
+		concurrency: concurrency,
+		log:         log.WithName("s3"),
 	}
 }
 
@@ -32,12 +36,42 @@ type S3Downloader struct {
 	s3Client   s3Client
 	bucket     string
 	pathPrefix string
-	log        logr.Logger
+	// concurrency is the maximum number of objects downloaded in parallel.
+	concurrency int
+	log         logr.Logger
 }
 
-func (d *S3Downloader) download(ctx context.Context, modelPath, filename, destDir string) error {
+func (d *S3Downloader) download(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter, destDir string) error {
 	d.log.Info("Downloading the model", "modelPath", modelPath)
 
+	bucket, prefix, objs, err := d.listObjects(ctx, modelPath, filename, filter)
+	if err != nil {
+		return err
+	}
+
+	return runInParallel(ctx, d.concurrency, len(objs), func(ctx context.Context, i int) error {
+		return d.downloadOneObject(ctx, bucket, *objs[i].Key, prefix, destDir)
+	})
+}
+
+// modelSize returns the total size of the objects to be downloaded.
+func (d *S3Downloader) modelSize(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter) (int64, error) {
+	_, prefix, objs, err := d.listObjects(ctx, modelPath, filename, filter)
+	if err != nil {
+		return 0, err
+	}
+	var size int64
+	for _, obj := range objs {
+		if isModelPathObject(*obj.Key, prefix) {
+			continue
+		}
+		size += aws.ToInt64(obj.Size)
+	}
+	return size, nil
+}
+
+// listObjects returns the bucket, the prefix, and the objects of the model that match the filter.
+func (d *S3Downloader) listObjects(ctx context.Context, modelPath, filename string, filter *pathfilter.Filter) (string, string, []types.Object, error) {
 	var (
 		bucket string
 		prefix string
@@ -46,7 +80,7 @@ func (d *S3Downloader) download(ctx context.Context, modelPath, filename, destDi
 		var err error
 		bucket, prefix, err = splitS3Path(modelPath)
 		if err != nil {
-			return fmt.Errorf("invalid model path %q: %s", modelPath, err)
+			return "", "", nil, fmt.Errorf("invalid model path %q: %s", modelPath, err)
 		}
 	} else {
 		// Use the default bucket and path prefix.
@@ -55,15 +89,19 @@ func (d *S3Downloader) download(ctx context.Context, modelPath, filename, destDi
 	}
 
 	d.log.Info("Listing objects", "bucket", bucket, "prefix", prefix)
-	var keys []string
+	var objs []types.Object
 	f := func(page *s3.ListObjectsV2Output, lastPage bool) bool {
 		for _, obj := range page.Contents {
 			if filename != "" && *obj.Key != filepath.Join(prefix, filename) {
 				// Exclude objects that do not match the specified filename.
 				continue
 			}
+			if !isModelPathObject(*obj.Key, prefix) && !filter.Match(strings.TrimPrefix(*obj.Key, prefix+"/")) {
+				// Exclude objects that do not match the file patterns.
+				continue
+			}
 
-			keys = append(keys, *obj.Key)
+			objs = append(objs, obj)
 		}
 		return lastPage
 	}
@@ -71,29 +109,25 @@ func (d *S3Downloader) download(ctx context.Context, modelPath, filename, destDi
 	// We need to append "/". Otherwise, we will download all objects with the same prefix
 	// (e.g., "google/gemma-2b" will download "google/gemma-2b" and "google/gemma-2b-it").
 	if err := d.s3Client.ListObjectsPages(ctx, bucket, prefix+"/", f); err != nil {
-		return err
+		return "", "", nil, err
 	}
-	if len(keys) == 0 {
-		return fmt.Errorf("no objects found under %s", prefix)
-	}
-
-	for _, key := range keys {
-		if err := d.downloadOneObject(ctx, bucket, key, prefix, destDir); err != nil {
-			return err
+	if len(objs) == 0 {
+		if !filter.IsEmpty() {
+			return "", "", nil, fmt.Errorf("no objects matching the file patterns found under %s", prefix)
 		}
+		return "", "", nil, fmt.Errorf("no objects found under %s", prefix)
 	}
-
-	return nil
+	return bucket, prefix, objs, nil
 }
 
 func (d *S3Downloader) downloadOneObject(ctx context.Context, bucket, key, prefix, destDir string) error {
-	p := strings.TrimPrefix(key, prefix)
-	if p == "" || p == "/" {
+	if isModelPathObject(key, prefix) {
 		// Do nothing if there is an object whose key exactly matches with the model path. We don't need to copy that one.
 		// For example, if the model path is 'google/gemma-2b', we would like to copy object under 'google/gemme-2b', but
 		// not the object at 'google/gemma-2b'.
 		return nil
 	}
+	p := strings.TrimPrefix(key, prefix)
 
 	filePath := filepath.Join(destDir, p)
 	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
@@ -109,12 +143,18 @@ func (d *S3Downloader) downloadOneObject(ctx context.Context, bucket, key, prefi
 
 	d.log.Info("Downloading S3 object", "key", key, "filePath", filePath)
 	if err := d.s3Client.Download(ctx, f, bucket, key); err != nil {
-		return fmt.Errorf("download key %q: %s", key, err)
+		return fmt.Errorf("download key %q: %w", key, err)
 	}
 
 	return nil
 }
 
+// isModelPathObject returns true if the key exactly matches with the model path.
+func isModelPathObject(key, prefix string) bool {
+	p := strings.TrimPrefix(key, prefix)
+	return p == "" || p == "/"
+}
+
 // splitS3Path splits the S3 path into bucket and prefix.
 //
 // For example, "s3://bucket/path/to/model" will be converted to bucket="bucket" and
@@ -12,17 +12,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/go-logr/logr/testr"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestS3Download(t *testing.T) {
-	destDir, err := os.MkdirTemp("", "test")
-	assert.NoError(t, err)
-	defer func() {
-		err := os.RemoveAll(destDir)
-		assert.NoError(t, err)
-	}()
-
 	client := &fakeS3Client{
 		objs: map[string][]byte{
 			"v1/base-models/google/gemma-2b/key0":      []byte("object0"),
@@ -35,6 +29,8 @@ func TestS3Download(t *testing.T) {
 		name      string
 		modelName string
 		filename  string
+		include   []string
+		exclude   []string
 		want      map[string]string
 	}{
 		{
@@ -55,20 +51,46 @@ func TestS3Download(t *testing.T) {
 				"key0": "object0",
 			},
 		},
+		{
+			name:      "download files matching patterns",
+			modelName: "google/gemma-2b",
+			include:   []string{"key0", "key2/"},
+			exclude:   []string{"key0"},
+			want: map[string]string{
+				"key2/key3": "object2",
+			},
+		},
 	}
 
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
+			destDir := t.TempDir()
+			filter, err := pathfilter.New(tc.include, tc.exclude)
+			assert.NoError(t, err)
+
 			ctx := context.Background()
-			d := NewS3Downloader(client, "bucket", "v1/base-models", testr.New(t))
-			err = d.download(ctx, tc.modelName, tc.filename, destDir)
+			d := NewS3Downloader(client, "bucket", "v1/base-models", 2, testr.New(t))
+			err = d.download(ctx, tc.modelName, tc.filename, filter, destDir)
 			assert.NoError(t, err)
 
-			for key, object := range tc.want {
-				b, err := os.ReadFile(filepath.Join(destDir, key))
-				assert.NoError(t, err)
-				assert.Equal(t, object, string(b))
-			}
+			got := map[string]string{}
+			err = filepath.Walk(destDir, func(path string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+				b, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				rel, err := filepath.Rel(destDir, path)
+				if err != nil {
+					return err
+				}
+				got[rel] = string(b)
+				return nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
 		})
 	}
 }
@@ -92,8 +114,8 @@ func TestS3Download_IgnoreExactMatch(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	d := NewS3Downloader(client, "bucket", "v1/base-models", testr.New(t))
-	err = d.download(ctx, "google/gemma-2b", "", destDir)
+	d := NewS3Downloader(client, "bucket", "v1/base-models", 2, testr.New(t))
+	err = d.download(ctx, "google/gemma-2b", "", nil, destDir)
 	assert.NoError(t, err)
 
 	b, err := os.ReadFile(filepath.Join(destDir, "key0"))
@@ -101,6 +123,40 @@ func TestS3Download_IgnoreExactMatch(t *testing.T) {
 	assert.Equal(t, "object2", string(b))
 }
 
+func TestS3ModelSize(t *testing.T) {
+	client := &fakeS3Client{
+		objs: map[string][]byte{
+			"v1/base-models/google/gemma-2b":           []byte("ignored"),
+			"v1/base-models/google/gemma-2b/key0":      []byte("object0"),
+			"v1/base-models/google/gemma-2b/key2/key3": []byte("object02"),
+		},
+	}
+
+	tcs := []struct {
+		name     string
+		filename string
+		want     int64
+	}{
+		{
+			name: "all objects",
+			want: 15,
+		},
+		{
+			name:     "specific file",
+			filename: "key0",
+			want:     7,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			d := NewS3Downloader(client, "bucket", "v1/base-models", 2, testr.New(t))
+			got, err := d.modelSize(context.Background(), "google/gemma-2b", tc.filename, nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestSplitS3Path(t *testing.T) {
 	tcs := []struct {
 		path       string
@@ -159,7 +215,10 @@ func (c *fakeS3Client) Download(ctx context.Context, w io.WriterAt, bucket, key
 func (c *fakeS3Client) ListObjectsPages(ctx context.Context, bucket, prefix string, f func(page *s3.ListObjectsV2Output, lastPage bool) bool) error {
 	var objs []types.Object
 	for key := range c.objs {
-		objs = append(objs, types.Object{Key: aws.String(key)})
+		objs = append(objs, types.Object{
+			Key:  aws.String(key),
+			Size: aws.Int64(int64(len(c.objs[key]))),
+		})
 	}
 	f(&s3.ListObjectsV2Output{Contents: objs}, true)
 	return nil
@@ -2,31 +2,40 @@ package loader
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 	"sync"
 	"time"
 
 	v1 "github.com/llmariner/model-manager/api/v1"
 )
 
+// defaultStatusSenderInterval is the interval of reporting the loading status. The server requires the lease
+// duration to be at least three times this interval.
 const defaultStatusSenderInterval = 10 * time.Second
 
+// errLoadingCancelled is returned when the cancellation of the loading has been requested.
+var errLoadingCancelled = errors.New("loading has been cancelled")
+
 type statusUpdateClient interface {
-	updateStatus(ctx context.Context, msg string) error
+	// updateStatus updates the loading status. uploadPath is the object store path that the model files
+	// are uploaded to. It returns errLoadingCancelled if the cancellation of the loading has been requested.
+	updateStatus(ctx context.Context, msg, uploadPath string, progress *v1.ModelLoadingProgress) error
 }
 
 func newBaseModelStatusUpdateClient(
 	modelClient ModelClient,
 	modelID string,
 	projectID string,
+	loaderID string,
 ) *baseModelStatusUpdateClient {
 	return &baseModelStatusUpdateClient{
 		modelClient: modelClient,
 		modelID:     modelID,
 		projectID:   projectID,
+		loaderID:    loaderID,
 	}
 }
 
@@ -34,54 +43,80 @@ type baseModelStatusUpdateClient struct {
 	modelClient ModelClient
 	modelID     string
 	projectID   string
+	loaderID    string
 }
 
-func (c *baseModelStatusUpdateClient) updateStatus(ctx context.Context, msg string) error {
-	_, err := c.modelClient.UpdateBaseModelLoadingStatus(
+func (c *baseModelStatusUpdateClient) updateStatus(ctx context.Context, msg, uploadPath string, progress *v1.ModelLoadingProgress) error {
+	resp, err := c.modelClient.UpdateBaseModelLoadingStatus(
 		ctx,
 		&v1.UpdateBaseModelLoadingStatusRequest{
 			Id:            c.modelID,
 			ProjectId:     c.projectID,
 			StatusMessage: msg,
+			LoaderId:      c.loaderID,
+			Progress:      progress,
+			UploadPath:    uploadPath,
 		},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	if resp.Cancelled {
+		return errLoadingCancelled
+	}
+	return nil
 }
 
 func newFineTunedModelStatusUpdateClient(
 	modelClient ModelClient,
 	modelID string,
+	loaderID string,
 ) *fineTunedModelStatusUpdateClient {
 	return &fineTunedModelStatusUpdateClient{
 		modelClient: modelClient,
 		modelID:     modelID,
+		loaderID:    loaderID,
 	}
 }
 
 type fineTunedModelStatusUpdateClient struct {
 	modelClient ModelClient
 	modelID     string
+	loaderID    string
 }
 
-func (c *fineTunedModelStatusUpdateClient) updateStatus(ctx context.Context, msg string) error {
-	_, err := c.modelClient.UpdateModelLoadingStatus(
+// updateStatus updates the loading status. The upload path is not sent as the server assigns
+// the paths of fine-tuned models.
+func (c *fineTunedModelStatusUpdateClient) updateStatus(ctx context.Context, msg, uploadPath string, progress *v1.ModelLoadingProgress) error {
+	resp, err := c.modelClient.UpdateModelLoadingStatus(
 		ctx,
 		&v1.UpdateModelLoadingStatusRequest{
 			Id:            c.modelID,
 			StatusMessage: msg,
+			LoaderId:      c.loaderID,
+			Progress:      progress,
 		},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	if resp.Cancelled {
+		return errLoadingCancelled
+	}
+	return nil
 }
 
 // newStatusSender returns a new statusSender instance.
 func newStatusSender(
 	statusUpdateClient statusUpdateClient,
 	downloadDir string,
+	uploadPath string,
 ) *statusSender {
 	return &statusSender{
 		statusUpdateClient: statusUpdateClient,
 		downloadDir:        downloadDir,
+		uploadPath:         uploadPath,
+		lastSentAt:         time.Now(),
 	}
 }
 
@@ -89,9 +124,18 @@ func newStatusSender(
 type statusSender struct {
 	statusUpdateClient statusUpdateClient
 	downloadDir        string
+	// uploadPath is the object store path that the model files are uploaded to.
+	uploadPath string
 
 	numUploadedFiles int
-	mu               sync.Mutex
+	// totalBytes is the total size of the model files. It is zero if unknown.
+	totalBytes    int64
+	uploadedBytes int64
+	mu            sync.Mutex
+
+	// lastTransferredBytes and lastSentAt are used to compute the throughput.
+	lastTransferredBytes int64
+	lastSentAt           time.Time
 }
 
 func (s *statusSender) run(
@@ -110,10 +154,10 @@ func (s *statusSender) run(
 	}
 }
 
-func (s *statusSender) setNumUploadedFiles(n int) {
+func (s *statusSender) incNumUploadedFiles() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	s.numUploadedFiles = n
+	s.numUploadedFiles++
 }
 
 func (s *statusSender) getNumUploadedFiles() int {
@@ -122,8 +166,32 @@ func (s *statusSender) getNumUploadedFiles() int {
 	return s.numUploadedFiles
 }
 
-func (s *statusSender) getNumDownloadedFiles() (int, error) {
-	var n int
+// setTotalBytes sets the total size of the model files.
+func (s *statusSender) setTotalBytes(n int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.totalBytes = n
+}
+
+// addUploadedBytes adds the number of bytes that have been uploaded to the object store.
+func (s *statusSender) addUploadedBytes(n int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.uploadedBytes += n
+}
+
+func (s *statusSender) getBytes() (int64, int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.totalBytes, s.uploadedBytes
+}
+
+// getDownloadedFiles returns the number of the downloaded files and their total size.
+func (s *statusSender) getDownloadedFiles() (int, int64, error) {
+	var (
+		n    int
+		size int64
+	)
 	if err := filepath.Walk(s.downloadDir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -133,31 +201,71 @@ func (s *statusSender) getNumDownloadedFiles() (int, error) {
 			return nil
 		}
 
-		// Ignore the HuggingFace cache directory as we don't upload these files.
-		if strings.Contains(path, huggingFaceDownloadCache) {
+		// Only count the size of regular files. A symlink points to a file whose bytes are counted
+		// where the file is. Files in the download cache directories hold the bytes of files that are
+		// still being downloaded.
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+
+		// Ignore the download cache directories as we don't upload these files.
+		if isInDownloadCache(path) {
 			return nil
 		}
 
 		n++
 		return nil
 	}); err != nil {
-		return 0, fmt.Errorf("scan download directory: %s", err)
+		return 0, 0, fmt.Errorf("scan download directory: %s", err)
 	}
 
-	return n, nil
+	return n, size, nil
 }
 
 func (s *statusSender) sendStatus(ctx context.Context) error {
 	up := s.getNumUploadedFiles()
-	down, err := s.getNumDownloadedFiles()
+	down, downBytes, err := s.getDownloadedFiles()
 	if err != nil {
 		return fmt.Errorf("get number of downloaded files: %w", err)
 	}
 
 	statusMsg := fmt.Sprintf("downloaded files: %d, uploaded files: %d", down, up)
-	if err := s.statusUpdateClient.updateStatus(ctx, statusMsg); err != nil {
+	progress := s.buildProgress(downBytes, time.Now())
+	if err := s.statusUpdateClient.updateStatus(ctx, statusMsg, s.uploadPath, progress); err != nil {
 		return fmt.Errorf("update status: %w", err)
 	}
 
 	return nil
 }
+
+// buildProgress builds the loading progress from the number of downloaded bytes. The throughput is
+// computed from the bytes transferred since the previous call.
+func (s *statusSender) buildProgress(downloadedBytes int64, now time.Time) *v1.ModelLoadingProgress {
+	total, uploaded := s.getBytes()
+	// The size of the download directory can exceed the total as it includes temporary files.
+	if total > 0 {
+		downloadedBytes = min(downloadedBytes, total)
+	}
+	transferred := downloadedBytes + uploaded
+
+	var bytesPerSec int64
+	if elapsed := now.Sub(s.lastSentAt).Seconds(); elapsed > 0 {
+		bytesPerSec = int64(float64(transferred-s.lastTransferredBytes) / elapsed)
+	}
+	s.lastTransferredBytes = transferred
+	s.lastSentAt = now
+
+	var eta int64
+	// Every byte is downloaded and then uploaded.
+	if remaining := 2*total - transferred; total > 0 && bytesPerSec > 0 && remaining > 0 {
+		eta = remaining / bytesPerSec
+	}
+
+	return &v1.ModelLoadingProgress{
+		TotalBytes:      total,
+		DownloadedBytes: downloadedBytes,
+		UploadedBytes:   uploaded,
+		BytesPerSecond:  max(bytesPerSec, 0),
+		EtaSeconds:      eta,
+	}
+}
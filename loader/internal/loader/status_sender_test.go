@@ -3,9 +3,13 @@ package loader
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	v1 "github.com/llmariner/model-manager/api/v1"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestStatusSender(t *testing.T) {
@@ -25,19 +29,81 @@ func TestStatusSender(t *testing.T) {
 	err = f.Close()
 	assert.NoError(t, err)
 
-	s := newStatusSender(client, tmpDir)
-	s.setNumUploadedFiles(2)
+	s := newStatusSender(client, tmpDir, "models/base-models/default/model0")
+	s.incNumUploadedFiles()
+	s.incNumUploadedFiles()
 	err = s.sendStatus(context.Background())
 	assert.NoError(t, err)
 
 	assert.Equal(t, "downloaded files: 1, uploaded files: 2", client.capturedMsg)
+	assert.Equal(t, "models/base-models/default/model0", client.capturedUploadPath)
+	assert.Equal(t, int64(26), client.capturedProgress.DownloadedBytes)
+}
+
+func TestStatusSender_Symlink(t *testing.T) {
+	client := &fakeStatusUpdateClient{}
+	tmpDir := t.TempDir()
+
+	// A file in the download cache and a symlink to it are counted only once.
+	blobDir := filepath.Join(tmpDir, huggingFaceDownloadCache)
+	err := os.MkdirAll(blobDir, 0755)
+	assert.NoError(t, err)
+	blobPath := filepath.Join(blobDir, "blob")
+	err = os.WriteFile(blobPath, []byte("This is a test model file."), 0644)
+	assert.NoError(t, err)
+	err = os.Symlink(blobPath, filepath.Join(tmpDir, "modelfile.txt"))
+	assert.NoError(t, err)
+
+	s := newStatusSender(client, tmpDir, "")
+	err = s.sendStatus(context.Background())
+	assert.NoError(t, err)
+
+	assert.Equal(t, "downloaded files: 1, uploaded files: 0", client.capturedMsg)
+	assert.Equal(t, int64(26), client.capturedProgress.DownloadedBytes)
+}
+
+func TestStatusSender_BuildProgress(t *testing.T) {
+	now := time.Now()
+	s := newStatusSender(&fakeStatusUpdateClient{}, "", "")
+	s.lastSentAt = now
+	s.setTotalBytes(1000)
+
+	s.addUploadedBytes(100)
+	got := s.buildProgress(500, now.Add(10*time.Second))
+	want := &v1.ModelLoadingProgress{
+		TotalBytes:      1000,
+		DownloadedBytes: 500,
+		UploadedBytes:   100,
+		BytesPerSecond:  60,
+		// (2 * 1000 - 600) / 60
+		EtaSeconds: 23,
+	}
+	assert.True(t, proto.Equal(want, got), "want %v, got %v", want, got)
+
+	// The throughput is computed from the bytes transferred since the previous call.
+	// The downloaded bytes are capped by the total.
+	s.addUploadedBytes(300)
+	got = s.buildProgress(1200, now.Add(20*time.Second))
+	want = &v1.ModelLoadingProgress{
+		TotalBytes:      1000,
+		DownloadedBytes: 1000,
+		UploadedBytes:   400,
+		BytesPerSecond:  80,
+		// (2 * 1000 - 1400) / 80
+		EtaSeconds: 7,
+	}
+	assert.True(t, proto.Equal(want, got), "want %v, got %v", want, got)
 }
 
 type fakeStatusUpdateClient struct {
-	capturedMsg string
+	capturedMsg        string
+	capturedUploadPath string
+	capturedProgress   *v1.ModelLoadingProgress
 }
 
-func (c *fakeStatusUpdateClient) updateStatus(ctx context.Context, msg string) error {
+func (c *fakeStatusUpdateClient) updateStatus(ctx context.Context, msg, uploadPath string, progress *v1.ModelLoadingProgress) error {
 	c.capturedMsg = msg
+	c.capturedUploadPath = uploadPath
+	c.capturedProgress = progress
 	return nil
 }
@@ -0,0 +1,446 @@
+package loader
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/go-logr/logr"
+)
+
+const (
+	// uploadManifestSuffix is appended to the path prefix of a model to build the key of its upload manifest.
+	// The manifest is stored next to the model directory so that it is not treated as a model file.
+	uploadManifestSuffix = ".upload-manifest.json"
+
+	defaultUploadPartSize int64 = 128 * 1024 * 1024
+
+	// sha256MetadataKey is the key of the user-defined object metadata that holds the SHA-256 of the file.
+	sha256MetadataKey = "sha256"
+)
+
+func uploadManifestKey(pathPrefix string) string {
+	return pathPrefix + uploadManifestSuffix
+}
+
+// uploadManifest records the progress of the uploads of model files so that a retried load
+// only transfers missing or changed objects.
+type uploadManifest struct {
+	// Files is keyed by the object key.
+	Files map[string]*uploadedFile `json:"files"`
+}
+
+// uploadedFile is the upload state of a single file.
+type uploadedFile struct {
+	Size     int64  `json:"size"`
+	SHA256   string `json:"sha256"`
+	PartSize int64  `json:"partSize"`
+	// ChecksumSHA256 is the composite SHA-256 checksum that the object store computes from the checksums
+	// of the parts. It is compared with the checksum of the stored object to verify the upload.
+	ChecksumSHA256 string `json:"checksumSha256"`
+
+	// UploadID is the ID of the in-progress multipart upload. It is empty once the upload completes.
+	UploadID string         `json:"uploadId,omitempty"`
+	Parts    []uploadedPart `json:"parts,omitempty"`
+
+	Completed bool `json:"completed"`
+}
+
+// uploadedPart is a part of a multipart upload that has been uploaded.
+type uploadedPart struct {
+	Number int32  `json:"number"`
+	ETag   string `json:"etag"`
+}
+
+func newResumableUploader(
+	s3Client S3Client,
+	bucket string,
+	manifestKey string,
+	partSize int64,
+	log logr.Logger,
+) *resumableUploader {
+	return &resumableUploader{
+		s3Client:    s3Client,
+		bucket:      bucket,
+		manifestKey: manifestKey,
+		partSize:    partSize,
+		log:         log.WithName("uploader"),
+	}
+}
+
+// resumableUploader uploads files with multipart uploads and persists the progress in an upload manifest.
+// Multiple files can be uploaded in parallel.
+type resumableUploader struct {
+	s3Client    S3Client
+	bucket      string
+	manifestKey string
+	partSize    int64
+
+	// onProgress is called with the number of bytes that have been uploaded or found to be already uploaded.
+	// It can be nil.
+	onProgress func(n int64)
+
+	// manifest and its entries are guarded by mu. version is incremented every time the manifest is updated.
+	manifest *uploadManifest
+	version  int64
+	mu       sync.Mutex
+
+	// savedVersion is the version of the manifest that has been saved last. It is guarded by saveMu, which
+	// serializes the saves so that an older manifest never overwrites a newer one.
+	savedVersion int64
+	saveMu       sync.Mutex
+
+	log logr.Logger
+}
+
+// loadManifest loads the upload manifest from the object store. An empty manifest is used
+// if no manifest exists.
+func (u *resumableUploader) loadManifest(ctx context.Context) error {
+	u.manifest = &uploadManifest{
+		Files: map[string]*uploadedFile{},
+	}
+
+	if _, err := u.s3Client.HeadObject(ctx, u.bucket, u.manifestKey); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("head upload manifest: %s", err)
+	}
+
+	buf := manager.NewWriteAtBuffer(nil)
+	if err := u.s3Client.Download(ctx, buf, u.bucket, u.manifestKey); err != nil {
+		return fmt.Errorf("download upload manifest: %s", err)
+	}
+	var m uploadManifest
+	if err := json.Unmarshal(buf.Bytes(), &m); err != nil {
+		// Start over instead of failing the load as the manifest is only used to skip uploads.
+		u.log.Error(err, "Ignoring the invalid upload manifest", "key", u.manifestKey)
+		return nil
+	}
+	if m.Files != nil {
+		u.manifest = &m
+	}
+	u.log.Info("Loaded the upload manifest", "key", u.manifestKey, "files", len(u.manifest.Files))
+	return nil
+}
+
+// updateManifest applies the given update to the manifest and saves the manifest.
+func (u *resumableUploader) updateManifest(ctx context.Context, update func()) error {
+	u.mu.Lock()
+	update()
+	u.version++
+	v := u.version
+	u.mu.Unlock()
+	return u.saveManifest(ctx, v)
+}
+
+// saveManifest saves the manifest unless a manifest that includes the update of the given version has already
+// been saved. The manifest is uploaded without holding mu so that other files continue to be uploaded, and
+// the updates made while another save is in progress are saved together.
+func (u *resumableUploader) saveManifest(ctx context.Context, version int64) error {
+	u.saveMu.Lock()
+	defer u.saveMu.Unlock()
+	if u.savedVersion >= version {
+		return nil
+	}
+
+	u.mu.Lock()
+	b, err := json.Marshal(u.manifest)
+	v := u.version
+	u.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("marshal upload manifest: %s", err)
+	}
+	if err := u.s3Client.Upload(ctx, bytes.NewReader(b), u.bucket, u.manifestKey); err != nil {
+		return fmt.Errorf("upload upload manifest: %w", err)
+	}
+	u.savedVersion = v
+	return nil
+}
+
+// upload uploads the file at the given path to the object key. The upload is skipped if the manifest shows that
+// the same content has already been uploaded, and it is resumed if a previous multipart upload was interrupted.
+func (u *resumableUploader) upload(ctx context.Context, path, key string) error {
+	cs, err := computeFileChecksums(path, u.partSize)
+	if err != nil {
+		return err
+	}
+	size := cs.size
+	log := u.log.WithValues("key", key)
+
+	f, ok := u.getFile(key)
+	if ok && (f.Size != size || f.SHA256 != cs.sha256 || f.PartSize != u.partSize) {
+		log.Info("File changed since the previous upload")
+		f = nil
+	}
+	if f != nil && f.ChecksumSHA256 == "" {
+		// The file was uploaded without checksums and cannot be verified.
+		log.Info("Uploading the file again as the previous upload has no checksum")
+		f = nil
+	}
+
+	if f != nil && f.Completed {
+		uploaded, err := u.isUploaded(ctx, key, f)
+		if err != nil {
+			return err
+		}
+		if uploaded {
+			log.Info("Skipping the already uploaded file")
+			u.reportProgress(size)
+			return nil
+		}
+		f = nil
+	}
+
+	if f != nil && f.UploadID != "" {
+		parts, err := u.s3Client.ListParts(ctx, u.bucket, key, f.UploadID)
+		if err != nil {
+			// The upload might have been aborted or expired.
+			log.Error(err, "Failed to list uploaded parts. Starting a new upload", "uploadID", f.UploadID)
+			f = nil
+		} else {
+			u.mu.Lock()
+			f.Parts = reconcileUploadedParts(f.Parts, parts)
+			u.mu.Unlock()
+			log.Info("Resuming the upload", "uploadID", f.UploadID, "uploadedParts", len(f.Parts))
+		}
+	}
+
+	if f == nil || f.UploadID == "" {
+		uploadID, err := u.s3Client.CreateMultipartUpload(ctx, u.bucket, key, map[string]string{
+			sha256MetadataKey: cs.sha256,
+		})
+		if err != nil {
+			return fmt.Errorf("create multipart upload: %w", err)
+		}
+		f = &uploadedFile{
+			Size:           size,
+			SHA256:         cs.sha256,
+			PartSize:       u.partSize,
+			ChecksumSHA256: cs.composite(),
+			UploadID:       uploadID,
+		}
+		if err := u.updateManifest(ctx, func() { u.manifest.Files[key] = f }); err != nil {
+			return err
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	uploaded := map[int32]bool{}
+	for _, p := range f.Parts {
+		uploaded[p.Number] = true
+		off := int64(p.Number-1) * u.partSize
+		u.reportProgress(max(min(u.partSize, size-off), 0))
+	}
+
+	for i, partSum := range cs.parts {
+		n := int32(i + 1)
+		if uploaded[n] {
+			continue
+		}
+
+		off := int64(i) * u.partSize
+		r := io.NewSectionReader(file, off, min(u.partSize, size-off))
+		etag, err := u.s3Client.UploadPart(ctx, r, u.bucket, key, f.UploadID, n, base64.StdEncoding.EncodeToString(partSum))
+		if err != nil {
+			return fmt.Errorf("upload part %d of %q: %w", n, key, err)
+		}
+		if err := u.updateManifest(ctx, func() {
+			f.Parts = append(f.Parts, uploadedPart{Number: n, ETag: etag})
+		}); err != nil {
+			return err
+		}
+		u.reportProgress(r.Size())
+	}
+
+	var cparts []types.CompletedPart
+	for _, p := range f.Parts {
+		cparts = append(cparts, types.CompletedPart{
+			PartNumber:     aws.Int32(p.Number),
+			ETag:           aws.String(p.ETag),
+			ChecksumSHA256: aws.String(base64.StdEncoding.EncodeToString(cs.parts[p.Number-1])),
+		})
+	}
+	sort.Slice(cparts, func(i, j int) bool {
+		return aws.ToInt32(cparts[i].PartNumber) < aws.ToInt32(cparts[j].PartNumber)
+	})
+	if err := u.s3Client.CompleteMultipartUpload(ctx, u.bucket, key, f.UploadID, cparts); err != nil {
+		return fmt.Errorf("complete multipart upload of %q: %w", key, err)
+	}
+
+	return u.updateManifest(ctx, func() {
+		f.UploadID = ""
+		f.Parts = nil
+		f.Completed = true
+	})
+}
+
+// verify checks that the objects of the given keys exist in the object store and match the manifest.
+func (u *resumableUploader) verify(ctx context.Context, keys []string) error {
+	for _, key := range keys {
+		f, ok := u.getFile(key)
+		if !ok || !f.Completed {
+			return fmt.Errorf("object %q has not been uploaded", key)
+		}
+		uploaded, err := u.isUploaded(ctx, key, f)
+		if err != nil {
+			return err
+		}
+		if !uploaded {
+			return fmt.Errorf("object %q does not match the checksum in the upload manifest", key)
+		}
+	}
+	return nil
+}
+
+// deleteUploads aborts the in-progress multipart uploads and deletes the uploaded objects that the manifest
+// records. The manifest is deleted as well so that a later load starts over.
+func (u *resumableUploader) deleteUploads(ctx context.Context) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for key, f := range u.manifest.Files {
+		if f.UploadID != "" {
+			if err := u.s3Client.AbortMultipartUpload(ctx, u.bucket, key, f.UploadID); err != nil {
+				// The upload might have been aborted or expired.
+				u.log.Error(err, "Failed to abort the multipart upload", "key", key, "uploadID", f.UploadID)
+			}
+		}
+		if f.Completed {
+			if err := u.s3Client.DeleteObject(ctx, u.bucket, key); err != nil {
+				return fmt.Errorf("delete object %q: %s", key, err)
+			}
+		}
+		delete(u.manifest.Files, key)
+	}
+	if err := u.s3Client.DeleteObject(ctx, u.bucket, u.manifestKey); err != nil {
+		return fmt.Errorf("delete upload manifest: %s", err)
+	}
+	return nil
+}
+
+func (u *resumableUploader) reportProgress(n int64) {
+	if u.onProgress != nil && n > 0 {
+		u.onProgress(n)
+	}
+}
+
+func (u *resumableUploader) getFile(key string) (*uploadedFile, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	f, ok := u.manifest.Files[key]
+	return f, ok
+}
+
+// isUploaded returns true if the object exists and has the size and the checksum recorded in the manifest.
+// The checksum is computed by the object store from the uploaded content.
+func (u *resumableUploader) isUploaded(ctx context.Context, key string, f *uploadedFile) (bool, error) {
+	resp, err := u.s3Client.HeadObject(ctx, u.bucket, key)
+	if err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("head object %q: %w", key, err)
+	}
+	return aws.ToInt64(resp.ContentLength) == f.Size && f.ChecksumSHA256 != "" && aws.ToString(resp.ChecksumSHA256) == f.ChecksumSHA256, nil
+}
+
+// reconcileUploadedParts returns the parts in the manifest that the object store also has.
+func reconcileUploadedParts(parts []uploadedPart, stored []types.Part) []uploadedPart {
+	etags := map[int32]string{}
+	for _, p := range stored {
+		etags[aws.ToInt32(p.PartNumber)] = aws.ToString(p.ETag)
+	}
+	var rs []uploadedPart
+	for _, p := range parts {
+		if etag, ok := etags[p.Number]; ok && etag == p.ETag {
+			rs = append(rs, p)
+		}
+	}
+	return rs
+}
+
+// fileChecksums is the checksums of a file that is uploaded in parts.
+type fileChecksums struct {
+	size int64
+	// sha256 is the hex-encoded SHA-256 of the whole file.
+	sha256 string
+	// parts is the SHA-256 digests of the parts.
+	parts [][]byte
+}
+
+// composite returns the composite checksum of the parts in the format that S3 reports for objects uploaded
+// with multipart uploads, i.e., the base64-encoded SHA-256 of the concatenated part digests followed by
+// the number of the parts.
+func (c *fileChecksums) composite() string {
+	return compositeSHA256(c.parts)
+}
+
+// compositeSHA256 returns the composite checksum of the given part digests.
+func compositeSHA256(parts [][]byte) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(h.Sum(nil)), len(parts))
+}
+
+// computeFileChecksums computes the SHA-256 of the file and the SHA-256 of each part of the given size.
+// An empty file has a single empty part.
+func computeFileChecksums(path string, partSize int64) (*fileChecksums, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	h := sha256.New()
+	cs := &fileChecksums{}
+	for {
+		ph := sha256.New()
+		n, err := io.Copy(io.MultiWriter(h, ph), io.LimitReader(f, partSize))
+		if err != nil {
+			return nil, fmt.Errorf("compute sha256 of %q: %s", path, err)
+		}
+		if n == 0 && len(cs.parts) > 0 {
+			break
+		}
+		cs.size += n
+		cs.parts = append(cs.parts, ph.Sum(nil))
+		if n < partSize {
+			break
+		}
+	}
+	cs.sha256 = hex.EncodeToString(h.Sum(nil))
+	return cs, nil
+}
+
+func isNotFoundError(err error) bool {
+	var nf *types.NotFound
+	if errors.As(err, &nf) {
+		return true
+	}
+	var re *awshttp.ResponseError
+	return errors.As(err, &re) && re.HTTPStatusCode() == 404
+}
@@ -0,0 +1,354 @@
+package loader
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumableUploader(t *testing.T) {
+	const (
+		bucket      = "bucket"
+		key         = "models/file0"
+		manifestKey = "models" + uploadManifestSuffix
+	)
+
+	path := filepath.Join(t.TempDir(), "file0")
+	err := os.WriteFile(path, []byte("0123456789"), 0644)
+	assert.NoError(t, err)
+
+	s3Client := &mockS3Client{
+		failPartUploadAfter: 2,
+	}
+	ctx := context.Background()
+
+	var progress int64
+	onProgress := func(n int64) { progress += n }
+
+	// The first attempt fails after uploading two out of four parts.
+	u := newResumableUploader(s3Client, bucket, manifestKey, 3, testr.New(t))
+	u.onProgress = onProgress
+	err = u.loadManifest(ctx)
+	assert.NoError(t, err)
+	err = u.upload(ctx, path, key)
+	assert.Error(t, err)
+	assert.Empty(t, s3Client.uploadedKeys)
+	assert.Equal(t, 2, s3Client.numUploadedParts)
+	assert.Equal(t, int64(6), progress)
+
+	// The second attempt only uploads the remaining parts.
+	s3Client.failPartUploadAfter = 0
+	progress = 0
+	u = newResumableUploader(s3Client, bucket, manifestKey, 3, testr.New(t))
+	u.onProgress = onProgress
+	err = u.loadManifest(ctx)
+	assert.NoError(t, err)
+	err = u.upload(ctx, path, key)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{key}, s3Client.uploadedKeys)
+	assert.Equal(t, 4, s3Client.numUploadedParts)
+	assert.Equal(t, "0123456789", string(s3Client.objects[key].data))
+	// The progress includes the parts uploaded in the first attempt.
+	assert.Equal(t, int64(10), progress)
+	err = u.verify(ctx, []string{key})
+	assert.NoError(t, err)
+
+	// The third attempt skips the upload as the file has not changed.
+	progress = 0
+	u = newResumableUploader(s3Client, bucket, manifestKey, 3, testr.New(t))
+	u.onProgress = onProgress
+	err = u.loadManifest(ctx)
+	assert.NoError(t, err)
+	err = u.upload(ctx, path, key)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{key}, s3Client.uploadedKeys)
+	assert.Equal(t, 4, s3Client.numUploadedParts)
+	assert.Equal(t, int64(10), progress)
+
+	// The file is uploaded again once its content changes.
+	err = os.WriteFile(path, []byte("abcdefghij"), 0644)
+	assert.NoError(t, err)
+	err = u.upload(ctx, path, key)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{key, key}, s3Client.uploadedKeys)
+	assert.Equal(t, "abcdefghij", string(s3Client.objects[key].data))
+	err = u.verify(ctx, []string{key})
+	assert.NoError(t, err)
+}
+
+func TestResumableUploader_Verify(t *testing.T) {
+	const (
+		bucket      = "bucket"
+		key         = "models/file0"
+		manifestKey = "models" + uploadManifestSuffix
+	)
+
+	path := filepath.Join(t.TempDir(), "file0")
+	err := os.WriteFile(path, []byte("0123456789"), 0644)
+	assert.NoError(t, err)
+
+	s3Client := &mockS3Client{}
+	ctx := context.Background()
+
+	u := newResumableUploader(s3Client, bucket, manifestKey, defaultUploadPartSize, testr.New(t))
+	err = u.loadManifest(ctx)
+	assert.NoError(t, err)
+	err = u.upload(ctx, path, key)
+	assert.NoError(t, err)
+	err = u.verify(ctx, []string{key})
+	assert.NoError(t, err)
+
+	// Corrupt the uploaded object.
+	s3Client.objects[key].checksumSHA256 = "invalid"
+	err = u.verify(ctx, []string{key})
+	assert.Error(t, err)
+
+	// Remove the uploaded object.
+	delete(s3Client.objects, key)
+	err = u.verify(ctx, []string{key})
+	assert.Error(t, err)
+
+	// A file that has not been uploaded fails the verification.
+	err = u.verify(ctx, []string{"models/file1"})
+	assert.Error(t, err)
+}
+
+func TestResumableUploader_DeleteUploads(t *testing.T) {
+	const (
+		bucket      = "bucket"
+		manifestKey = "models" + uploadManifestSuffix
+	)
+
+	dir := t.TempDir()
+	path0 := filepath.Join(dir, "file0")
+	err := os.WriteFile(path0, []byte("0123"), 0644)
+	assert.NoError(t, err)
+	path1 := filepath.Join(dir, "file1")
+	err = os.WriteFile(path1, []byte("0123456789"), 0644)
+	assert.NoError(t, err)
+
+	// file0 is uploaded while the upload of file1 fails in the middle.
+	s3Client := &mockS3Client{
+		failPartUploadAfter: 3,
+	}
+	ctx := context.Background()
+	u := newResumableUploader(s3Client, bucket, manifestKey, 3, testr.New(t))
+	err = u.loadManifest(ctx)
+	assert.NoError(t, err)
+	err = u.upload(ctx, path0, "models/file0")
+	assert.NoError(t, err)
+	err = u.upload(ctx, path1, "models/file1")
+	assert.Error(t, err)
+	assert.Len(t, s3Client.uploads, 1)
+
+	err = u.deleteUploads(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, s3Client.objects)
+	assert.Empty(t, s3Client.uploads)
+}
+
+func TestResumableUploader_SaveManifest(t *testing.T) {
+	const (
+		bucket      = "bucket"
+		manifestKey = "models" + uploadManifestSuffix
+	)
+
+	s3Client := &blockingManifestS3Client{
+		mockS3Client: &mockS3Client{},
+		manifestKey:  manifestKey,
+		started:      make(chan struct{}),
+		release:      make(chan struct{}),
+	}
+	ctx := context.Background()
+	u := newResumableUploader(s3Client, bucket, manifestKey, 3, testr.New(t))
+	err := u.loadManifest(ctx)
+	assert.NoError(t, err)
+
+	var wg sync.WaitGroup
+	update := func(key string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := u.updateManifest(ctx, func() { u.manifest.Files[key] = &uploadedFile{} })
+			assert.NoError(t, err)
+		}()
+	}
+
+	update("models/file0")
+	<-s3Client.started
+
+	// The manifest can be updated while it is being saved.
+	_, ok := u.getFile("models/file0")
+	assert.True(t, ok)
+	update("models/file1")
+	update("models/file2")
+	assert.Eventually(t, func() bool {
+		u.mu.Lock()
+		defer u.mu.Unlock()
+		return u.version == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	close(s3Client.release)
+	wg.Wait()
+
+	// The updates made during the first save are saved together.
+	assert.Equal(t, 2, s3Client.numManifestUploads)
+	var m uploadManifest
+	err = json.Unmarshal(s3Client.objects[manifestKey].data, &m)
+	assert.NoError(t, err)
+	assert.Len(t, m.Files, 3)
+}
+
+// blockingManifestS3Client blocks the first upload of the manifest until release is closed.
+type blockingManifestS3Client struct {
+	*mockS3Client
+
+	manifestKey        string
+	started            chan struct{}
+	release            chan struct{}
+	numManifestUploads int
+}
+
+func (c *blockingManifestS3Client) Upload(ctx context.Context, r io.Reader, bucket, key string) error {
+	if key == c.manifestKey {
+		c.numManifestUploads++
+		if c.numManifestUploads == 1 {
+			close(c.started)
+			<-c.release
+		}
+	}
+	return c.mockS3Client.Upload(ctx, r, bucket, key)
+}
+
+func TestResumableUploader_NoChecksum(t *testing.T) {
+	const (
+		bucket      = "bucket"
+		key         = "models/file0"
+		manifestKey = "models" + uploadManifestSuffix
+	)
+
+	path := filepath.Join(t.TempDir(), "file0")
+	err := os.WriteFile(path, []byte("0123456789"), 0644)
+	assert.NoError(t, err)
+	cs, err := computeFileChecksums(path, 3)
+	assert.NoError(t, err)
+
+	// The manifest and the object were written before checksums were recorded.
+	s3Client := &mockS3Client{}
+	ctx := context.Background()
+	b, err := json.Marshal(&uploadManifest{
+		Files: map[string]*uploadedFile{
+			key: {Size: cs.size, SHA256: cs.sha256, PartSize: 3, Completed: true},
+		},
+	})
+	assert.NoError(t, err)
+	err = s3Client.Upload(ctx, bytes.NewReader(b), bucket, manifestKey)
+	assert.NoError(t, err)
+	err = s3Client.Upload(ctx, strings.NewReader("0123456789"), bucket, key)
+	assert.NoError(t, err)
+
+	// The file is uploaded again as the object cannot be verified.
+	u := newResumableUploader(s3Client, bucket, manifestKey, 3, testr.New(t))
+	err = u.loadManifest(ctx)
+	assert.NoError(t, err)
+	err = u.upload(ctx, path, key)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{key}, s3Client.uploadedKeys)
+	assert.Equal(t, cs.composite(), s3Client.objects[key].checksumSHA256)
+	err = u.verify(ctx, []string{key})
+	assert.NoError(t, err)
+}
+
+func TestResumableUploader_NoopS3Client(t *testing.T) {
+	const (
+		bucket      = "bucket"
+		key         = "models/file0"
+		manifestKey = "models" + uploadManifestSuffix
+	)
+
+	path := filepath.Join(t.TempDir(), "file0")
+	err := os.WriteFile(path, []byte("0123456789"), 0644)
+	assert.NoError(t, err)
+
+	s3Client := &NoopS3Client{}
+	ctx := context.Background()
+
+	u := newResumableUploader(s3Client, bucket, manifestKey, 3, testr.New(t))
+	err = u.loadManifest(ctx)
+	assert.NoError(t, err)
+	err = u.upload(ctx, path, key)
+	assert.NoError(t, err)
+	err = u.verify(ctx, []string{key})
+	assert.NoError(t, err)
+
+	// The manifest is loaded again and the upload is skipped.
+	var progress int64
+	u = newResumableUploader(s3Client, bucket, manifestKey, 3, testr.New(t))
+	u.onProgress = func(n int64) { progress += n }
+	err = u.loadManifest(ctx)
+	assert.NoError(t, err)
+	err = u.upload(ctx, path, key)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), progress)
+	assert.Empty(t, s3Client.uploads)
+}
+
+func TestComputeFileChecksums(t *testing.T) {
+	tcs := []struct {
+		name      string
+		content   string
+		wantParts []string
+	}{
+		{
+			name:      "empty",
+			content:   "",
+			wantParts: []string{""},
+		},
+		{
+			name:      "single part",
+			content:   "01",
+			wantParts: []string{"01"},
+		},
+		{
+			name:      "exact multiple of the part size",
+			content:   "012345",
+			wantParts: []string{"012", "345"},
+		},
+		{
+			name:      "last part is smaller",
+			content:   "0123456",
+			wantParts: []string{"012", "345", "6"},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "file")
+			err := os.WriteFile(path, []byte(tc.content), 0644)
+			assert.NoError(t, err)
+
+			got, err := computeFileChecksums(path, 3)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(len(tc.content)), got.size)
+			sum := sha256.Sum256([]byte(tc.content))
+			assert.Equal(t, hex.EncodeToString(sum[:]), got.sha256)
+			var want [][]byte
+			for _, p := range tc.wantParts {
+				sum := sha256.Sum256([]byte(p))
+				want = append(want, sum[:])
+			}
+			assert.Equal(t, want, got.parts)
+		})
+	}
+}
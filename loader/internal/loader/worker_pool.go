@@ -0,0 +1,59 @@
+package loader
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// runInParallel calls f for each index in [0, n) with at most the given number of goroutines.
+//
+// The context passed to f is cancelled when f returns an error so that the remaining work stops early.
+// All errors are aggregated into a single error except for the ones caused by the cancellation.
+func runInParallel(ctx context.Context, concurrency, n int, f func(ctx context.Context, i int) error) error {
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, concurrency)
+loop:
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			break loop
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(ctx, i); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				if len(errs) == 0 || !errors.Is(err, context.Canceled) {
+					errs = append(errs, err)
+				}
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	// The parent context might have been cancelled before all the work started.
+	return ctx.Err()
+}
@@ -0,0 +1,100 @@
+package loader
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunInParallel(t *testing.T) {
+	tcs := []struct {
+		name        string
+		concurrency int
+		n           int
+		failAt      int
+		wantErr     bool
+	}{
+		{
+			name:        "sequential",
+			concurrency: 1,
+			n:           10,
+			failAt:      -1,
+		},
+		{
+			name:        "parallel",
+			concurrency: 4,
+			n:           10,
+			failAt:      -1,
+		},
+		{
+			name:        "default concurrency",
+			concurrency: 0,
+			n:           3,
+			failAt:      -1,
+		},
+		{
+			name:        "failure",
+			concurrency: 1,
+			n:           10,
+			failAt:      3,
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				mu      sync.Mutex
+				done    []int
+				running int
+				maxRun  int
+			)
+			err := runInParallel(context.Background(), tc.concurrency, tc.n, func(ctx context.Context, i int) error {
+				mu.Lock()
+				running++
+				maxRun = max(maxRun, running)
+				mu.Unlock()
+
+				defer func() {
+					mu.Lock()
+					running--
+					mu.Unlock()
+				}()
+
+				if i == tc.failAt {
+					return fmt.Errorf("failure at %d", i)
+				}
+
+				mu.Lock()
+				done = append(done, i)
+				mu.Unlock()
+				return nil
+			})
+			if tc.wantErr {
+				assert.Error(t, err)
+				// No more work starts after the failure.
+				assert.Len(t, done, tc.failAt)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, done, tc.n)
+			assert.LessOrEqual(t, maxRun, max(tc.concurrency, 1))
+		})
+	}
+}
+
+func TestRunInParallel_AggregateErrors(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	err := runInParallel(context.Background(), 2, 2, func(ctx context.Context, i int) error {
+		// Make sure that both calls fail before the context is cancelled.
+		wg.Done()
+		wg.Wait()
+		return fmt.Errorf("failure at %d", i)
+	})
+	assert.ErrorContains(t, err, "failure at 0")
+	assert.ErrorContains(t, err, "failure at 1")
+}
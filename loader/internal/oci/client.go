@@ -0,0 +1,432 @@
+package oci
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go"
+	"github.com/go-logr/logr"
+	"github.com/llmariner/model-manager/loader/internal/download"
+)
+
+const (
+	// TitleAnnotation is the annotation of a layer that has the file name of the layer. ORAS sets it
+	// when files are pushed.
+	TitleAnnotation = "org.opencontainers.image.title"
+
+	mediaTypeOCIManifest    = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeOCIIndex       = "application/vnd.oci.image.index.v1+json"
+	mediaTypeDockerList     = "application/vnd.docker.distribution.manifest.list.v2+json"
+
+	// maxManifestSize is the maximum size of a manifest. Registries reject manifests larger than 4 MiB.
+	maxManifestSize = 4 << 20
+)
+
+// Reference is a reference to a manifest in an OCI registry.
+type Reference struct {
+	Registry   string
+	Repository string
+	// Tag is the tag of the manifest. It is empty if Digest is set.
+	Tag string
+	// Digest is the digest of the manifest (e.g., "sha256:<hex>"). It is empty if Tag is set.
+	Digest string
+}
+
+// ParseReference parses a reference of the form "<registry>/<repository>:<tag>" or
+// "<registry>/<repository>@<digest>" (e.g., "ghcr.io/org/models/llama:v1").
+func ParseReference(s string) (Reference, error) {
+	var r Reference
+	p := s
+	if i := strings.Index(p, "@"); i >= 0 {
+		r.Digest = p[i+1:]
+		p = p[:i]
+		if _, err := sha256Hex(r.Digest); err != nil {
+			return Reference{}, fmt.Errorf("invalid reference %q: %s", s, err)
+		}
+	} else if i := strings.LastIndex(p, ":"); i > strings.LastIndex(p, "/") {
+		// The registry can have a port. Only look for the tag after the last slash.
+		r.Tag = p[i+1:]
+		p = p[:i]
+		if r.Tag == "" {
+			return Reference{}, fmt.Errorf("invalid reference %q: empty tag", s)
+		}
+	} else {
+		return Reference{}, fmt.Errorf("invalid reference %q: a tag or a digest must be specified", s)
+	}
+
+	i := strings.Index(p, "/")
+	if i < 0 {
+		return Reference{}, fmt.Errorf("invalid reference %q: a registry must be specified", s)
+	}
+	r.Registry, r.Repository = p[:i], p[i+1:]
+	if r.Registry == "" || r.Repository == "" {
+		return Reference{}, fmt.Errorf("invalid reference %q", s)
+	}
+	for _, c := range strings.Split(r.Repository, "/") {
+		if c == "" || c == "." || c == ".." {
+			return Reference{}, fmt.Errorf("invalid reference %q: invalid repository", s)
+		}
+	}
+	return r, nil
+}
+
+// String returns the reference in the form that ParseReference accepts.
+func (r Reference) String() string {
+	if r.Digest != "" {
+		return r.Registry + "/" + r.Repository + "@" + r.Digest
+	}
+	return r.Registry + "/" + r.Repository + ":" + r.Tag
+}
+
+// Descriptor describes a blob referenced by a manifest.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// Title returns the file name of the layer. It is empty if the layer does not have the title annotation.
+func (d *Descriptor) Title() string {
+	return d.Annotations[TitleAnnotation]
+}
+
+// Manifest is an image manifest.
+type Manifest struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType"`
+	ArtifactType  string       `json:"artifactType,omitempty"`
+	Config        Descriptor   `json:"config"`
+	Layers        []Descriptor `json:"layers"`
+
+	// Digest is the digest of the manifest.
+	Digest string `json:"-"`
+}
+
+// Credentials are the credentials used to log in to registries.
+type Credentials struct {
+	Username string
+	// Password is the password or the access token.
+	Password string
+}
+
+// NewClient returns a new client of OCI registries. Registries are accessed over HTTP instead of HTTPS if
+// insecure is true. Anonymous access is used if creds is nil.
+func NewClient(insecure bool, creds *Credentials, log logr.Logger) *Client {
+	scheme := "https"
+	if insecure {
+		scheme = "http"
+	}
+	return &Client{
+		scheme:     scheme,
+		creds:      creds,
+		httpClient: http.DefaultClient,
+		retryDelay: time.Second,
+		log:        log,
+
+		authorizations: map[string]string{},
+	}
+}
+
+// Client is a client of OCI registries. It implements the pull part of the OCI distribution specification.
+type Client struct {
+	scheme     string
+	creds      *Credentials
+	httpClient *http.Client
+	// retryDelay is the initial delay before retrying a failed download.
+	retryDelay time.Duration
+	log        logr.Logger
+
+	// authorizations holds the values of the Authorization header obtained by logging in to registries.
+	// The key is the registry and the repository.
+	authorizations map[string]string
+	mu             sync.Mutex
+}
+
+// GetManifest returns the manifest that the reference points to. The digest of the manifest is verified
+// if the reference has a digest.
+func (c *Client) GetManifest(ctx context.Context, r Reference) (*Manifest, error) {
+	ref := r.Tag
+	if r.Digest != "" {
+		ref = r.Digest
+	}
+	resp, err := c.get(ctx, r, c.url(r, "manifests", ref), strings.Join([]string{
+		mediaTypeOCIManifest,
+		mediaTypeDockerManifest,
+		mediaTypeOCIIndex,
+		mediaTypeDockerList,
+	}, ", "))
+	if err != nil {
+		return nil, fmt.Errorf("get manifest: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("manifest %q is not found", r)
+	}
+	if err := download.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("get manifest: %w", err)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %s", err)
+	}
+	if len(b) > maxManifestSize {
+		return nil, fmt.Errorf("manifest %q is too large", r)
+	}
+	sum := sha256.Sum256(b)
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+	if r.Digest != "" && r.Digest != digest {
+		return nil, fmt.Errorf("manifest digest mismatch: got %s, want %s", digest, r.Digest)
+	}
+
+	var m Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("decode manifest: %s", err)
+	}
+	mediaType := m.MediaType
+	if mediaType == "" {
+		mediaType, _, _ = mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	}
+	switch mediaType {
+	case mediaTypeOCIIndex, mediaTypeDockerList:
+		return nil, fmt.Errorf("%q is an index of manifests, which is not supported", r)
+	}
+	for _, l := range m.Layers {
+		if _, err := sha256Hex(l.Digest); err != nil {
+			return nil, err
+		}
+	}
+	m.Digest = digest
+	return &m, nil
+}
+
+// DownloadBlob downloads the blob to destPath. The content is first written to incompletePath, and
+// the download resumes from there if it already exists. The digest is verified before the file is moved
+// to destPath.
+//
+// Like GetManifest, the client logs in to the registry if the registry requires authentication. This also
+// happens when the token has expired during the download, in which case the download resumes with a new token.
+func (c *Client) DownloadBlob(ctx context.Context, r Reference, d Descriptor, destPath, incompletePath string) error {
+	h, err := sha256Hex(d.Digest)
+	if err != nil {
+		return err
+	}
+	err = c.downloadBlob(ctx, r, d, h, destPath, incompletePath)
+	var uerr *unauthorizedError
+	if errors.As(err, &uerr) {
+		if err := c.login(ctx, r, uerr.challenge); err != nil {
+			return fmt.Errorf("download blob %s: %w", d.Digest, err)
+		}
+		err = c.downloadBlob(ctx, r, d, h, destPath, incompletePath)
+	}
+	if err != nil {
+		return fmt.Errorf("download blob %s: %w", d.Digest, err)
+	}
+	return nil
+}
+
+func (c *Client) downloadBlob(ctx context.Context, r Reference, d Descriptor, sha, destPath, incompletePath string) error {
+	header := http.Header{}
+	if auth := c.authorization(r); auth != "" {
+		header.Set("Authorization", auth)
+	}
+	dl := download.New(c.httpClient, c.log)
+	dl.RetryDelay = c.retryDelay
+	dl.CheckResponse = checkBlobResponse
+	return dl.Download(ctx, download.File{
+		URL:    c.url(r, "blobs", d.Digest),
+		Header: header,
+		Size:   d.Size,
+		SHA256: sha,
+	}, destPath, incompletePath)
+}
+
+// unauthorizedError is returned when the registry responds with 401 (Unauthorized) to a blob request.
+type unauthorizedError struct {
+	// challenge is the value of the WWW-Authenticate header.
+	challenge string
+}
+
+func (e *unauthorizedError) Error() string {
+	return "unauthorized"
+}
+
+// checkBlobResponse is the same as download.CheckResponse except that it returns an unauthorizedError
+// for 401 (Unauthorized) so that the client can log in to the registry.
+func checkBlobResponse(resp *http.Response) error {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return retry.Unrecoverable(&unauthorizedError{challenge: resp.Header.Get("WWW-Authenticate")})
+	}
+	return download.CheckResponse(resp)
+}
+
+// get sends a GET request. If the registry requires authentication, the client logs in to the registry
+// and resends the request.
+func (c *Client) get(ctx context.Context, r Reference, u, accept string) (*http.Response, error) {
+	do := func() (*http.Response, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", accept)
+		if auth := c.authorization(r); auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+		return c.httpClient.Do(req)
+	}
+
+	resp, err := do()
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resp, nil
+	}
+	challenge := resp.Header.Get("WWW-Authenticate")
+	_ = resp.Body.Close()
+	if err := c.login(ctx, r, challenge); err != nil {
+		return nil, err
+	}
+	return do()
+}
+
+// login obtains the credential for the repository from the authentication challenge in a
+// 401 (Unauthorized) response.
+func (c *Client) login(ctx context.Context, r Reference, challenge string) error {
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if c.creds == nil {
+			return fmt.Errorf("registry %s requires credentials", r.Registry)
+		}
+		c.setAuthorization(r, "Basic "+base64.StdEncoding.EncodeToString([]byte(c.creds.Username+":"+c.creds.Password)))
+		return nil
+	case "bearer":
+	default:
+		return fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	realm := params["realm"]
+	if realm == "" {
+		return fmt.Errorf("no realm in the authentication challenge %q", challenge)
+	}
+	q := url.Values{}
+	if s := params["service"]; s != "" {
+		q.Set("service", s)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", r.Repository)
+	}
+	q.Set("scope", scope)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm+"?"+q.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("create token request: %s", err)
+	}
+	if c.creds != nil {
+		req.SetBasicAuth(c.creds.Username, c.creds.Password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("get token: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get token: unexpected status %q", resp.Status)
+	}
+	var t struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&t); err != nil {
+		return fmt.Errorf("decode token: %s", err)
+	}
+	token := t.Token
+	if token == "" {
+		token = t.AccessToken
+	}
+	if token == "" {
+		return fmt.Errorf("no token in the response from %s", realm)
+	}
+	c.setAuthorization(r, "Bearer "+token)
+	return nil
+}
+
+// authorization returns the value of the Authorization header for the repository. It is empty
+// if the client has not logged in to the repository.
+func (c *Client) authorization(r Reference) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.authorizations[r.Registry+"/"+r.Repository]
+}
+
+func (c *Client) setAuthorization(r Reference, auth string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.authorizations[r.Registry+"/"+r.Repository] = auth
+}
+
+func (c *Client) url(r Reference, kind, ref string) string {
+	return fmt.Sprintf("%s://%s/%s", c.scheme, r.Registry, path.Join("v2", r.Repository, kind, ref))
+}
+
+// parseChallenge parses the value of a WWW-Authenticate header
+// (e.g., `Bearer realm="https://auth.example.com/token",service="registry.example.com"`).
+func parseChallenge(s string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(s), " ")
+	params := map[string]string{}
+	for rest = strings.TrimSpace(rest); rest != ""; {
+		k, v, ok := strings.Cut(rest, "=")
+		if !ok {
+			break
+		}
+		k = strings.ToLower(strings.TrimSpace(k))
+		v = strings.TrimSpace(v)
+		if strings.HasPrefix(v, `"`) {
+			end := strings.Index(v[1:], `"`)
+			if end < 0 {
+				break
+			}
+			params[k] = v[1 : end+1]
+			rest = v[end+2:]
+		} else {
+			val, r, _ := strings.Cut(v, ",")
+			params[k] = strings.TrimSpace(val)
+			rest = r
+		}
+		rest = strings.TrimPrefix(strings.TrimSpace(rest), ",")
+		rest = strings.TrimSpace(rest)
+	}
+	return scheme, params
+}
+
+// sha256Hex returns the hex-encoded SHA-256 of the digest.
+func sha256Hex(digest string) (string, error) {
+	h, ok := strings.CutPrefix(digest, "sha256:")
+	if !ok || len(h) != 64 {
+		return "", fmt.Errorf("unsupported digest %q", digest)
+	}
+	if _, err := hex.DecodeString(h); err != nil {
+		return "", fmt.Errorf("unsupported digest %q", digest)
+	}
+	return h, nil
+}
@@ -0,0 +1,344 @@
+package oci
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+)
+
+const testToken = "token"
+
+// fakeRegistry is an in-process OCI registry that serves a single repository. Requests must have a bearer
+// token obtained from the token endpoint with the credentials "user:password".
+type fakeRegistry struct {
+	repository string
+	tag        string
+	manifest   []byte
+	blobs      map[string][]byte
+
+	// corrupt is set to true to send blobs that do not match the digests.
+	corrupt bool
+
+	// token is the token that the token endpoint issues and the registry accepts.
+	token string
+	mu    sync.Mutex
+
+	srv *httptest.Server
+}
+
+func newFakeRegistry(t *testing.T, repository, tag string, files map[string]string) *fakeRegistry {
+	r := &fakeRegistry{
+		repository: repository,
+		tag:        tag,
+		blobs:      map[string][]byte{},
+		token:      testToken,
+	}
+	toDescriptor := func(mediaType string, b []byte) Descriptor {
+		sum := sha256.Sum256(b)
+		d := "sha256:" + hex.EncodeToString(sum[:])
+		r.blobs[d] = b
+		return Descriptor{
+			MediaType: mediaType,
+			Digest:    d,
+			Size:      int64(len(b)),
+		}
+	}
+	m := Manifest{
+		SchemaVersion: 2,
+		MediaType:     mediaTypeOCIManifest,
+		ArtifactType:  "application/vnd.example.model",
+		Config:        toDescriptor("application/vnd.oci.empty.v1+json", []byte("{}")),
+	}
+	for name, content := range files {
+		d := toDescriptor("application/octet-stream", []byte(content))
+		d.Annotations = map[string]string{TitleAnnotation: name}
+		m.Layers = append(m.Layers, d)
+	}
+	b, err := json.Marshal(m)
+	assert.NoError(t, err)
+	r.manifest = b
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", r.serveToken)
+	mux.HandleFunc("/v2/", r.serveRegistry)
+	r.srv = httptest.NewServer(mux)
+	t.Cleanup(r.srv.Close)
+	return r
+}
+
+func (r *fakeRegistry) host() string {
+	return strings.TrimPrefix(r.srv.URL, "http://")
+}
+
+func (r *fakeRegistry) manifestDigest() string {
+	sum := sha256.Sum256(r.manifest)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// rotateToken invalidates the issued token as if it has expired.
+func (r *fakeRegistry) rotateToken(token string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.token = token
+}
+
+func (r *fakeRegistry) currentToken() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.token
+}
+
+func (r *fakeRegistry) serveToken(w http.ResponseWriter, req *http.Request) {
+	if u, p, ok := req.BasicAuth(); !ok || u != "user" || p != "password" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if req.URL.Query().Get("scope") != "repository:"+r.repository+":pull" {
+		http.Error(w, "unexpected scope", http.StatusBadRequest)
+		return
+	}
+	_, _ = w.Write([]byte(`{"token":"` + r.currentToken() + `"}`))
+}
+
+func (r *fakeRegistry) serveRegistry(w http.ResponseWriter, req *http.Request) {
+	if req.Header.Get("Authorization") != "Bearer "+r.currentToken() {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake",scope="repository:%s:pull"`, r.srv.URL, r.repository))
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	p, ok := strings.CutPrefix(req.URL.Path, "/v2/"+r.repository+"/")
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	switch {
+	case p == "manifests/"+r.tag, p == "manifests/"+r.manifestDigest():
+		w.Header().Set("Content-Type", mediaTypeOCIManifest)
+		_, _ = w.Write(r.manifest)
+	case strings.HasPrefix(p, "blobs/"):
+		b, ok := r.blobs[strings.TrimPrefix(p, "blobs/")]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		if r.corrupt {
+			b = bytes.ToUpper(b)
+		}
+		http.ServeContent(w, req, p, time.Time{}, bytes.NewReader(b))
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func newTestClient(t *testing.T, creds *Credentials) *Client {
+	c := NewClient(true, creds, testr.New(t))
+	c.retryDelay = time.Millisecond
+	return c
+}
+
+func TestParseReference(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+	tcs := []struct {
+		ref     string
+		want    Reference
+		wantErr bool
+	}{
+		{
+			ref:  "ghcr.io/org/models/llama:v1",
+			want: Reference{Registry: "ghcr.io", Repository: "org/models/llama", Tag: "v1"},
+		},
+		{
+			ref:  "localhost:5000/llama:v1",
+			want: Reference{Registry: "localhost:5000", Repository: "llama", Tag: "v1"},
+		},
+		{
+			ref:  "localhost:5000/llama@" + digest,
+			want: Reference{Registry: "localhost:5000", Repository: "llama", Digest: digest},
+		},
+		{
+			ref:     "ghcr.io/org/llama",
+			wantErr: true,
+		},
+		{
+			ref:     "llama:v1",
+			wantErr: true,
+		},
+		{
+			ref:     "ghcr.io/org/llama:",
+			wantErr: true,
+		},
+		{
+			ref:     "ghcr.io/org/llama@sha256:1234",
+			wantErr: true,
+		},
+		{
+			ref:     "ghcr.io/org/../llama:v1",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.ref, func(t *testing.T) {
+			got, err := ParseReference(tc.ref)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.ref, got.String())
+		})
+	}
+}
+
+func TestPull(t *testing.T) {
+	files := map[string]string{
+		"config.json": `{"architectures":["LlamaForCausalLM"]}`,
+		"model.gguf":  "weights",
+	}
+	r := newFakeRegistry(t, "org/llama", "v1", files)
+
+	tcs := []struct {
+		name    string
+		ref     string
+		creds   *Credentials
+		corrupt bool
+		wantErr bool
+	}{
+		{
+			name:  "tag",
+			ref:   r.host() + "/org/llama:v1",
+			creds: &Credentials{Username: "user", Password: "password"},
+		},
+		{
+			name:  "digest",
+			ref:   r.host() + "/org/llama@" + r.manifestDigest(),
+			creds: &Credentials{Username: "user", Password: "password"},
+		},
+		{
+			name:    "digest mismatch",
+			ref:     r.host() + "/org/llama:v1",
+			creds:   &Credentials{Username: "user", Password: "password"},
+			corrupt: true,
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			r.corrupt = tc.corrupt
+			c := newTestClient(t, tc.creds)
+			ref, err := ParseReference(tc.ref)
+			assert.NoError(t, err)
+
+			ctx := context.Background()
+			m, err := c.GetManifest(ctx, ref)
+			assert.NoError(t, err)
+			assert.Equal(t, r.manifestDigest(), m.Digest)
+			assert.Len(t, m.Layers, len(files))
+
+			dir := t.TempDir()
+			for _, l := range m.Layers {
+				p := filepath.Join(dir, l.Title())
+				err := c.DownloadBlob(ctx, ref, l, p, p+".incomplete")
+				if tc.wantErr {
+					assert.Error(t, err)
+					continue
+				}
+				assert.NoError(t, err)
+				got, err := os.ReadFile(p)
+				assert.NoError(t, err)
+				assert.Equal(t, files[l.Title()], string(got))
+			}
+		})
+	}
+}
+
+func TestDownloadBlob_ExpiredToken(t *testing.T) {
+	r := newFakeRegistry(t, "org/llama", "v1", map[string]string{"model.gguf": "weights"})
+	c := newTestClient(t, &Credentials{Username: "user", Password: "password"})
+	ref, err := ParseReference(r.host() + "/org/llama:v1")
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	m, err := c.GetManifest(ctx, ref)
+	assert.NoError(t, err)
+	assert.Len(t, m.Layers, 1)
+
+	// The token obtained for the manifest expires before the blob is downloaded.
+	r.rotateToken("token2")
+	p := filepath.Join(t.TempDir(), "model.gguf")
+	err = c.DownloadBlob(ctx, ref, m.Layers[0], p, p+".incomplete")
+	assert.NoError(t, err)
+	got, err := os.ReadFile(p)
+	assert.NoError(t, err)
+	assert.Equal(t, "weights", string(got))
+
+	// A client without credentials cannot log in.
+	c = newTestClient(t, nil)
+	err = c.DownloadBlob(ctx, ref, m.Layers[0], p, p+".incomplete")
+	assert.Error(t, err)
+}
+
+func TestGetManifest_Errors(t *testing.T) {
+	r := newFakeRegistry(t, "org/llama", "v1", map[string]string{"model.gguf": "weights"})
+	creds := &Credentials{Username: "user", Password: "password"}
+
+	tcs := []struct {
+		name  string
+		ref   string
+		creds *Credentials
+	}{
+		{
+			name: "no credentials",
+			ref:  r.host() + "/org/llama:v1",
+		},
+		{
+			name:  "wrong credentials",
+			ref:   r.host() + "/org/llama:v1",
+			creds: &Credentials{Username: "user", Password: "wrong"},
+		},
+		{
+			name:  "unknown tag",
+			ref:   r.host() + "/org/llama:v2",
+			creds: creds,
+		},
+		{
+			name:  "unknown digest",
+			ref:   r.host() + "/org/llama@sha256:" + strings.Repeat("0", 64),
+			creds: creds,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t, tc.creds)
+			ref, err := ParseReference(tc.ref)
+			assert.NoError(t, err)
+			_, err = c.GetManifest(context.Background(), ref)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:org/llama:pull,push"`)
+	assert.Equal(t, "Bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:org/llama:pull,push",
+	}, params)
+}
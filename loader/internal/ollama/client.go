@@ -0,0 +1,211 @@
+package ollama
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/model-manager/loader/internal/download"
+)
+
+const (
+	// DefaultHost is the host of the public Ollama registry.
+	DefaultHost = "registry.ollama.ai"
+	// DefaultNamespace is the namespace used when a model name does not have one.
+	DefaultNamespace = "library"
+	// DefaultTag is the tag used when a model name does not have one.
+	DefaultTag = "latest"
+
+	manifestMediaType = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// maxManifestSize is the maximum size of a manifest. Manifests are small JSON documents.
+	maxManifestSize = 4 << 20
+)
+
+// Name is the fully qualified name of a model in an Ollama registry.
+type Name struct {
+	Host      string
+	Namespace string
+	Model     string
+	Tag       string
+}
+
+// ParseName parses a model name of the form "[host/][namespace/]model[:tag]" (e.g., "gemma:2b").
+func ParseName(s string) (Name, error) {
+	n := Name{
+		Host:      DefaultHost,
+		Namespace: DefaultNamespace,
+		Tag:       DefaultTag,
+	}
+	p := s
+	// The host can have a port. Only look for the tag after the last slash.
+	if i := strings.LastIndex(p, ":"); i > strings.LastIndex(p, "/") {
+		n.Tag = p[i+1:]
+		p = p[:i]
+	}
+	switch l := strings.Split(p, "/"); len(l) {
+	case 1:
+		n.Model = l[0]
+	case 2:
+		n.Namespace, n.Model = l[0], l[1]
+	case 3:
+		n.Host, n.Namespace, n.Model = l[0], l[1], l[2]
+	default:
+		return Name{}, fmt.Errorf("invalid model name %q", s)
+	}
+	if n.Host == "" || n.Namespace == "" || n.Model == "" || n.Tag == "" {
+		return Name{}, fmt.Errorf("invalid model name %q", s)
+	}
+	return n, nil
+}
+
+// ManifestPath returns the path of the manifest relative to the models directory in the same
+// layout as Ollama (e.g., "manifests/registry.ollama.ai/library/gemma/2b").
+func (n Name) ManifestPath() string {
+	return filepath.Join("manifests", n.Host, n.Namespace, n.Model, n.Tag)
+}
+
+// BlobPath returns the path of the blob relative to the models directory in the same layout
+// as Ollama (e.g., "blobs/sha256-<hex>").
+func BlobPath(digest string) string {
+	return filepath.Join("blobs", strings.Replace(digest, ":", "-", 1))
+}
+
+// Layer is a blob referenced by a manifest.
+type Layer struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+// sha256 returns the hex-encoded SHA-256 of the digest.
+func (l *Layer) sha256() (string, error) {
+	h, ok := strings.CutPrefix(l.Digest, "sha256:")
+	if !ok || len(h) != 64 {
+		return "", fmt.Errorf("unsupported digest %q", l.Digest)
+	}
+	return h, nil
+}
+
+// Manifest is the manifest of a model.
+type Manifest struct {
+	SchemaVersion int     `json:"schemaVersion"`
+	MediaType     string  `json:"mediaType"`
+	Config        Layer   `json:"config"`
+	Layers        []Layer `json:"layers"`
+
+	// Raw is the manifest returned by the registry. It is stored as is so that Ollama can read it.
+	Raw []byte `json:"-"`
+}
+
+// Blobs returns the config and the layers.
+func (m *Manifest) Blobs() []Layer {
+	return append([]Layer{m.Config}, m.Layers...)
+}
+
+// TotalSize returns the total size of the manifest and the blobs.
+func (m *Manifest) TotalSize() int64 {
+	size := int64(len(m.Raw))
+	for _, l := range m.Blobs() {
+		size += l.Size
+	}
+	return size
+}
+
+// NewClient returns a new client of Ollama registries. Registries are accessed over HTTP instead of
+// HTTPS if insecure is true.
+func NewClient(insecure bool, log logr.Logger) *Client {
+	scheme := "https"
+	if insecure {
+		scheme = "http"
+	}
+	return &Client{
+		scheme:     scheme,
+		httpClient: http.DefaultClient,
+		retryDelay: time.Second,
+		log:        log,
+	}
+}
+
+// Client is a client of Ollama registries. It implements the pull protocol of the registry API
+// without running an Ollama server.
+type Client struct {
+	scheme     string
+	httpClient *http.Client
+	// retryDelay is the initial delay before retrying a failed download.
+	retryDelay time.Duration
+	log        logr.Logger
+}
+
+// GetManifest returns the manifest of the model.
+func (c *Client) GetManifest(ctx context.Context, n Name) (*Manifest, error) {
+	u := c.url(n, "manifests", n.Tag)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %s", err)
+	}
+	req.Header.Set("Accept", manifestMediaType)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get manifest: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("model %q is not found in %s", n.Namespace+"/"+n.Model+":"+n.Tag, n.Host)
+	}
+	if err := download.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("get manifest: %w", err)
+	}
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize))
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %s", err)
+	}
+	var m Manifest
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("decode manifest: %s", err)
+	}
+	if m.Config.Digest == "" {
+		return nil, fmt.Errorf("manifest of %q has no config", n.Model)
+	}
+	for _, l := range m.Blobs() {
+		if _, err := l.sha256(); err != nil {
+			return nil, err
+		}
+	}
+	m.Raw = b
+	return &m, nil
+}
+
+// DownloadBlob downloads the blob to destPath. The content is first written to destPath with the "-partial"
+// suffix, and the download resumes from there if it already exists. The digest is verified before the
+// file is moved to destPath.
+func (c *Client) DownloadBlob(ctx context.Context, n Name, l Layer, destPath string) error {
+	h, err := l.sha256()
+	if err != nil {
+		return err
+	}
+	d := download.New(c.httpClient, c.log)
+	d.RetryDelay = c.retryDelay
+	if err := d.Download(ctx, download.File{
+		URL:    c.url(n, "blobs", l.Digest),
+		Size:   l.Size,
+		SHA256: h,
+	}, destPath, destPath+"-partial"); err != nil {
+		return fmt.Errorf("download blob %s: %w", l.Digest, err)
+	}
+	return nil
+}
+
+func (c *Client) url(n Name, kind, ref string) string {
+	return fmt.Sprintf("%s://%s/%s", c.scheme, n.Host, path.Join("v2", n.Namespace, n.Model, kind, ref))
+}
@@ -0,0 +1,206 @@
+package ollama
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRegistry is a stand-in of an Ollama registry that serves a single model.
+type fakeRegistry struct {
+	model    string
+	tag      string
+	manifest []byte
+	blobs    map[string][]byte
+	// corrupt is set to true to send blobs that do not match the digests.
+	corrupt bool
+}
+
+func newFakeRegistry(t *testing.T, model, tag string, config []byte, layers ...[]byte) *fakeRegistry {
+	r := &fakeRegistry{
+		model: model,
+		tag:   tag,
+		blobs: map[string][]byte{},
+	}
+	toLayer := func(mediaType string, b []byte) Layer {
+		sum := sha256.Sum256(b)
+		d := "sha256:" + hex.EncodeToString(sum[:])
+		r.blobs[d] = b
+		return Layer{
+			MediaType: mediaType,
+			Digest:    d,
+			Size:      int64(len(b)),
+		}
+	}
+	m := Manifest{
+		SchemaVersion: 2,
+		MediaType:     manifestMediaType,
+		Config:        toLayer("application/vnd.docker.container.image.v1+json", config),
+	}
+	for _, l := range layers {
+		m.Layers = append(m.Layers, toLayer("application/vnd.ollama.image.model", l))
+	}
+	b, err := json.Marshal(m)
+	assert.NoError(t, err)
+	r.manifest = b
+	return r
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	prefix := "/v2/library/" + r.model + "/"
+	p, ok := strings.CutPrefix(req.URL.Path, prefix)
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	switch {
+	case p == "manifests/"+r.tag:
+		if req.Header.Get("Accept") != manifestMediaType {
+			http.Error(w, "unsupported media type", http.StatusNotAcceptable)
+			return
+		}
+		_, _ = w.Write(r.manifest)
+	case strings.HasPrefix(p, "blobs/"):
+		b, ok := r.blobs[strings.TrimPrefix(p, "blobs/")]
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		if r.corrupt {
+			b = bytes.ToUpper(b)
+		}
+		http.ServeContent(w, req, p, time.Time{}, bytes.NewReader(b))
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func newTestClient(t *testing.T, r *fakeRegistry) (*Client, string) {
+	srv := httptest.NewServer(r)
+	t.Cleanup(srv.Close)
+	c := NewClient(true, testr.New(t))
+	c.retryDelay = time.Millisecond
+	return c, strings.TrimPrefix(srv.URL, "http://")
+}
+
+func TestParseName(t *testing.T) {
+	tcs := []struct {
+		name    string
+		want    Name
+		wantErr bool
+	}{
+		{
+			name: "gemma:2b",
+			want: Name{Host: DefaultHost, Namespace: DefaultNamespace, Model: "gemma", Tag: "2b"},
+		},
+		{
+			name: "gemma",
+			want: Name{Host: DefaultHost, Namespace: DefaultNamespace, Model: "gemma", Tag: DefaultTag},
+		},
+		{
+			name: "user/gemma:2b",
+			want: Name{Host: DefaultHost, Namespace: "user", Model: "gemma", Tag: "2b"},
+		},
+		{
+			name: "localhost:8080/user/gemma:2b",
+			want: Name{Host: "localhost:8080", Namespace: "user", Model: "gemma", Tag: "2b"},
+		},
+		{
+			name: "localhost:8080/user/gemma",
+			want: Name{Host: "localhost:8080", Namespace: "user", Model: "gemma", Tag: DefaultTag},
+		},
+		{
+			name:    "gemma:",
+			wantErr: true,
+		},
+		{
+			name:    "a/b/c/d",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseName(tc.name)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGetManifestAndDownloadBlob(t *testing.T) {
+	r := newFakeRegistry(t, "gemma", "2b", []byte(`{"model_format":"gguf"}`), []byte("weights"), []byte("template"))
+
+	tcs := []struct {
+		name    string
+		tag     string
+		corrupt bool
+		wantErr bool
+	}{
+		{
+			name: "success",
+			tag:  "2b",
+		},
+		{
+			name:    "digest mismatch",
+			tag:     "2b",
+			corrupt: true,
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			r.corrupt = tc.corrupt
+			c, host := newTestClient(t, r)
+			n, err := ParseName(host + "/library/gemma:" + tc.tag)
+			assert.NoError(t, err)
+
+			ctx := context.Background()
+			m, err := c.GetManifest(ctx, n)
+			assert.NoError(t, err)
+			assert.Equal(t, r.manifest, m.Raw)
+			assert.Len(t, m.Blobs(), 3)
+			assert.Equal(t, int64(len(r.manifest))+23+7+8, m.TotalSize())
+
+			dir := t.TempDir()
+			for _, l := range m.Blobs() {
+				p := filepath.Join(dir, BlobPath(l.Digest))
+				err := c.DownloadBlob(ctx, n, l, p)
+				if tc.wantErr {
+					assert.Error(t, err)
+					continue
+				}
+				assert.NoError(t, err)
+				got, err := os.ReadFile(p)
+				assert.NoError(t, err)
+				assert.Equal(t, r.blobs[l.Digest], got)
+				_, err = os.Stat(p + "-partial")
+				assert.True(t, os.IsNotExist(err))
+			}
+		})
+	}
+}
+
+func TestGetManifest_NotFound(t *testing.T) {
+	r := newFakeRegistry(t, "gemma", "2b", []byte(`{}`))
+	c, host := newTestClient(t, r)
+	n, err := ParseName(host + "/library/gemma:7b")
+	assert.NoError(t, err)
+	_, err = c.GetManifest(context.Background(), n)
+	assert.ErrorContains(t, err, "not found")
+}
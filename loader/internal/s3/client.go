@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	laws "github.com/llmariner/common/pkg/aws"
 )
 
@@ -64,6 +65,98 @@ func (c *Client) Download(ctx context.Context, w io.WriterAt, bucket, key string
 	return nil
 }
 
+// HeadObject returns the metadata of an object, including its checksum.
+func (c *Client) HeadObject(ctx context.Context, bucket, key string) (*s3.HeadObjectOutput, error) {
+	return c.svc.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket:       aws.String(bucket),
+		Key:          aws.String(key),
+		ChecksumMode: types.ChecksumModeEnabled,
+	})
+}
+
+// CreateMultipartUpload initiates a multipart upload and returns its upload ID. The object has a composite
+// SHA-256 checksum of the SHA-256 checksums of its parts.
+func (c *Client) CreateMultipartUpload(ctx context.Context, bucket, key string, metadata map[string]string) (string, error) {
+	resp, err := c.svc.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:            aws.String(bucket),
+		Key:               aws.String(key),
+		Metadata:          metadata,
+		ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+		ChecksumType:      types.ChecksumTypeComposite,
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(resp.UploadId), nil
+}
+
+// UploadPart uploads a part of a multipart upload and returns its ETag. checksumSHA256 is the base64-encoded
+// SHA-256 checksum of the part. S3 rejects the part if its content does not match the checksum.
+func (c *Client) UploadPart(ctx context.Context, r io.ReadSeeker, bucket, key, uploadID string, partNumber int32, checksumSHA256 string) (string, error) {
+	resp, err := c.svc.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:         aws.String(bucket),
+		Key:            aws.String(key),
+		UploadId:       aws.String(uploadID),
+		PartNumber:     aws.Int32(partNumber),
+		Body:           r,
+		ChecksumSHA256: aws.String(checksumSHA256),
+	})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(resp.ETag), nil
+}
+
+// ListParts returns the parts that have been uploaded for a multipart upload.
+func (c *Client) ListParts(ctx context.Context, bucket, key, uploadID string) ([]types.Part, error) {
+	var parts []types.Part
+	p := s3.NewListPartsPaginator(c.svc, &s3.ListPartsInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, page.Parts...)
+	}
+	return parts, nil
+}
+
+// CompleteMultipartUpload completes a multipart upload by assembling the uploaded parts.
+func (c *Client) CompleteMultipartUpload(ctx context.Context, bucket, key, uploadID string, parts []types.CompletedPart) error {
+	_, err := c.svc.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: parts,
+		},
+	})
+	return err
+}
+
+// AbortMultipartUpload aborts a multipart upload and deletes its uploaded parts.
+func (c *Client) AbortMultipartUpload(ctx context.Context, bucket, key, uploadID string) error {
+	_, err := c.svc.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	return err
+}
+
+// DeleteObject deletes an object.
+func (c *Client) DeleteObject(ctx context.Context, bucket, key string) error {
+	_, err := c.svc.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
 // ListObjectsPages returns S3 objects with pagination.
 func (c *Client) ListObjectsPages(
 	ctx context.Context,
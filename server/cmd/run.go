@@ -131,12 +131,27 @@ func run(ctx context.Context, c *config.Config) error {
 		errCh <- http.ListenAndServe(fmt.Sprintf(":%d", c.HTTPPort), mux)
 	}()
 
-	s := server.New(st, pcache, logger)
+	reaper := server.NewLeaseReaper(st, logger)
+	go func() {
+		errCh <- reaper.Run(ctx, c.ModelLoading.LeaseReapInterval)
+	}()
+
+	retrier := server.NewLoadRetrier(st, logger)
+	go func() {
+		errCh <- retrier.Run(ctx, c.ModelLoading.Retry.RequeueInterval)
+	}()
+
+	pruner := server.NewEventPruner(st, logger)
+	go func() {
+		errCh <- pruner.Run(ctx, c.ModelWatch.PruneInterval, c.ModelWatch.EventRetention)
+	}()
+
+	s := server.New(st, pcache, c.ModelLoading, c.ModelWatch, logger)
 	go func() {
 		errCh <- s.Run(ctx, c.GRPCPort, c.AuthConfig, usageSetter)
 	}()
 
-	ws := server.NewWorkerServiceServer(st, pcache, logger)
+	ws := server.NewWorkerServiceServer(st, pcache, c.ModelLoading, c.ModelWatch, logger)
 	go func() {
 		errCh <- ws.Run(ctx, c.WorkerServiceGRPCPort, c.AuthConfig)
 	}()
@@ -28,6 +28,172 @@ func (c *ProjectCacheConfig) validate() error {
 	return nil
 }
 
+// loaderStatusInterval is the interval at which loaders report the loading status and renew their leases.
+// It must be kept in sync with the interval of the loader.
+const loaderStatusInterval = 10 * time.Second
+
+// minLeaseDuration is the minimum lease duration. A lease must outlive a few missed status reports so that
+// a transient error does not make another loader start loading the same model.
+const minLeaseDuration = 3 * loaderStatusInterval
+
+// ModelLoadingConfig is the configuration of the model loading by loaders.
+type ModelLoadingConfig struct {
+	// LeaseDuration is the duration of a lease that a loader holds while loading a model.
+	// The loader renews the lease every time it reports the loading status.
+	LeaseDuration time.Duration `yaml:"leaseDuration"`
+	// LeaseReapInterval is the interval of returning models with expired leases to the requested status.
+	LeaseReapInterval time.Duration `yaml:"leaseReapInterval"`
+
+	Retry LoadingRetryConfig `yaml:"retry"`
+
+	Priority LoadingPriorityConfig `yaml:"priority"`
+}
+
+// setDefaults sets the default values of the fields that are not set.
+func (c *ModelLoadingConfig) setDefaults() {
+	if c.LeaseDuration == 0 {
+		c.LeaseDuration = 5 * time.Minute
+	}
+	if c.LeaseReapInterval == 0 {
+		c.LeaseReapInterval = time.Minute
+	}
+	c.Retry.setDefaults()
+}
+
+// validate validates the model loading configuration.
+func (c *ModelLoadingConfig) validate() error {
+	if c.LeaseDuration < minLeaseDuration {
+		return fmt.Errorf("leaseDuration must be at least %s as loaders renew their leases every %s", minLeaseDuration, loaderStatusInterval)
+	}
+	if c.LeaseReapInterval <= 0 {
+		return fmt.Errorf("leaseReapInterval must be greater than 0")
+	}
+	if err := c.Retry.validate(); err != nil {
+		return fmt.Errorf("retry: %s", err)
+	}
+	if err := c.Priority.validate(); err != nil {
+		return fmt.Errorf("priority: %s", err)
+	}
+	return nil
+}
+
+// LoadingPriorityConfig is the configuration of the priorities that users can specify for loading models.
+type LoadingPriorityConfig struct {
+	// MaxPriority is the maximum absolute value of a priority.
+	MaxPriority int32 `yaml:"maxPriority"`
+	// MaxRaisedModelsPerProject is the maximum number of models with positive priorities that a project can
+	// have waiting to be loaded at a time. Base models that are not project-scoped are counted for the tenant.
+	// Positive priorities are not allowed if it is zero.
+	MaxRaisedModelsPerProject int `yaml:"maxRaisedModelsPerProject"`
+}
+
+// validate validates the loading priority configuration.
+func (c *LoadingPriorityConfig) validate() error {
+	if c.MaxPriority < 0 {
+		return fmt.Errorf("maxPriority must be non-negative")
+	}
+	if c.MaxRaisedModelsPerProject < 0 {
+		return fmt.Errorf("maxRaisedModelsPerProject must be non-negative")
+	}
+	return nil
+}
+
+// LoadingRetryConfig is the configuration of retrying failed model loads.
+type LoadingRetryConfig struct {
+	// MaxAttempts is the maximum number of attempts to load a model, including the first attempt.
+	MaxAttempts int `yaml:"maxAttempts"`
+	// InitialBackoff is the delay before the first retry. The delay doubles for every subsequent retry.
+	InitialBackoff time.Duration `yaml:"initialBackoff"`
+	// MaxBackoff is the maximum delay between retries.
+	MaxBackoff time.Duration `yaml:"maxBackoff"`
+	// RequeueInterval is the interval of requeuing failed models whose next attempt time has come.
+	RequeueInterval time.Duration `yaml:"requeueInterval"`
+}
+
+// setDefaults sets the default values of the fields that are not set.
+func (c *LoadingRetryConfig) setDefaults() {
+	if c.MaxAttempts == 0 {
+		c.MaxAttempts = 5
+	}
+	if c.InitialBackoff == 0 {
+		c.InitialBackoff = time.Minute
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = time.Hour
+	}
+	if c.RequeueInterval == 0 {
+		c.RequeueInterval = 30 * time.Second
+	}
+}
+
+// validate validates the loading retry configuration.
+func (c *LoadingRetryConfig) validate() error {
+	if c.MaxAttempts <= 0 {
+		return fmt.Errorf("maxAttempts must be greater than 0")
+	}
+	if c.InitialBackoff <= 0 {
+		return fmt.Errorf("initialBackoff must be greater than 0")
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		return fmt.Errorf("maxBackoff must be greater than or equal to initialBackoff")
+	}
+	if c.RequeueInterval <= 0 {
+		return fmt.Errorf("requeueInterval must be greater than 0")
+	}
+	return nil
+}
+
+// ModelWatchConfig is the configuration of watching model events.
+type ModelWatchConfig struct {
+	// PollInterval is the interval of checking new model events for watchers.
+	PollInterval time.Duration `yaml:"pollInterval"`
+	// EventRetention is the duration for which model events are kept. Watchers cannot resume
+	// from a revision older than the retention.
+	EventRetention time.Duration `yaml:"eventRetention"`
+	// PruneInterval is the interval of deleting model events older than the retention.
+	PruneInterval time.Duration `yaml:"pruneInterval"`
+	// CommitLag is the maximum expected delay between the creation of a model event and the commit of
+	// its transaction. Events can become visible out of the order of their revisions, so watchers read
+	// the events created within this duration again.
+	CommitLag time.Duration `yaml:"commitLag"`
+	// ProgressEventInterval is the minimum interval between the loading progress events of a model.
+	ProgressEventInterval time.Duration `yaml:"progressEventInterval"`
+}
+
+// setDefaults sets the default values of the fields that are not set. The commit lag and the progress
+// event interval can be zero.
+func (c *ModelWatchConfig) setDefaults() {
+	if c.PollInterval == 0 {
+		c.PollInterval = time.Second
+	}
+	if c.EventRetention == 0 {
+		c.EventRetention = 24 * time.Hour
+	}
+	if c.PruneInterval == 0 {
+		c.PruneInterval = 10 * time.Minute
+	}
+}
+
+// validate validates the model watch configuration.
+func (c *ModelWatchConfig) validate() error {
+	if c.PollInterval <= 0 {
+		return fmt.Errorf("pollInterval must be greater than 0")
+	}
+	if c.EventRetention <= 0 {
+		return fmt.Errorf("eventRetention must be greater than 0")
+	}
+	if c.PruneInterval <= 0 {
+		return fmt.Errorf("pruneInterval must be greater than 0")
+	}
+	if c.CommitLag < 0 {
+		return fmt.Errorf("commitLag must not be negative")
+	}
+	if c.ProgressEventInterval < 0 {
+		return fmt.Errorf("progressEventInterval must not be negative")
+	}
+	return nil
+}
+
 // DebugConfig is the debug configuration.
 type DebugConfig struct {
 	Standalone bool   `yaml:"standalone"`
@@ -59,6 +225,10 @@ type Config struct {
 
 	ProjectCache ProjectCacheConfig `yaml:"projectCache"`
 
+	ModelLoading ModelLoadingConfig `yaml:"modelLoading"`
+
+	ModelWatch ModelWatchConfig `yaml:"modelWatch"`
+
 	// GracefulShutdownDelay is the delay before shutting down the server.
 	GracefulShutdownDelay time.Duration `yaml:"gracefulShutdownDelay"`
 
@@ -85,6 +255,14 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("projectCache: %s", err)
 	}
 
+	if err := c.ModelLoading.validate(); err != nil {
+		return fmt.Errorf("modelLoading: %s", err)
+	}
+
+	if err := c.ModelWatch.validate(); err != nil {
+		return fmt.Errorf("modelWatch: %s", err)
+	}
+
 	if c.GracefulShutdownDelay < 0 {
 		return fmt.Errorf("gracefulShutdownDelay must be greater than or equal to 0")
 	}
@@ -121,5 +299,7 @@ func Parse(path string) (Config, error) {
 	if err = yaml.Unmarshal(b, &config); err != nil {
 		return config, fmt.Errorf("config: unmarshal: %s", err)
 	}
+	config.ModelLoading.setDefaults()
+	config.ModelWatch.setDefaults()
 	return config, nil
 }
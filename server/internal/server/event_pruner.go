@@ -0,0 +1,55 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/model-manager/server/internal/store"
+)
+
+// NewEventPruner creates a new EventPruner.
+func NewEventPruner(s *store.S, log logr.Logger) *EventPruner {
+	return &EventPruner{
+		store: s,
+		log:   log.WithName("eventpruner"),
+	}
+}
+
+// EventPruner deletes model events older than the retention so that the event table does not grow unboundedly.
+type EventPruner struct {
+	store *store.S
+	log   logr.Logger
+}
+
+// Run periodically prunes model events.
+func (p *EventPruner) Run(ctx context.Context, interval, retention time.Duration) error {
+	p.log.Info("Starting event pruner...")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			p.log.Info("Stopping event pruner...")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := p.prune(time.Now().Add(-retention)); err != nil {
+				// Gracefully handle the error.
+				p.log.Error(err, "Failed to prune model events")
+			}
+		}
+	}
+}
+
+func (p *EventPruner) prune(before time.Time) error {
+	n, err := p.store.DeleteModelEventsCreatedBefore(before)
+	if err != nil {
+		return fmt.Errorf("delete model events: %s", err)
+	}
+	if n > 0 {
+		p.log.Info("Pruned model events", "count", n)
+	}
+	return nil
+}
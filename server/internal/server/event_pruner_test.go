@@ -0,0 +1,43 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventPruner(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	k := store.ModelKey{
+		ModelID:  "m0",
+		TenantID: defaultTenantID,
+	}
+	for _, typ := range []v1.ModelEventType{
+		v1.ModelEventType_MODEL_EVENT_TYPE_CREATED,
+		v1.ModelEventType_MODEL_EVENT_TYPE_LOADED,
+	} {
+		err := st.CreateModelEvent(newModelEvent(k, true, typ))
+		assert.NoError(t, err)
+	}
+
+	p := NewEventPruner(st, testr.New(t))
+	err := p.prune(time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	es, err := st.ListModelEventsAfterRevision(defaultTenantID, 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, es, 2)
+
+	err = p.prune(time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	es, err = st.ListModelEventsAfterRevision(defaultTenantID, 0, 10)
+	assert.NoError(t, err)
+	// The latest event is kept.
+	assert.Len(t, es, 1)
+	assert.Equal(t, v1.ModelEventType_MODEL_EVENT_TYPE_LOADED, es[0].Type)
+}
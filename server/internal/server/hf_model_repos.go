@@ -33,13 +33,15 @@ func (s *WS) CreateHFModelRepo(
 		ModelID:   modelID,
 		ProjectID: req.ProjectId,
 		TenantID:  clusterInfo.TenantID,
+		Revision:  req.Revision,
 	}
 	if err := s.store.CreateHFModelRepo(r); err != nil {
 		return nil, status.Errorf(codes.Internal, "get hugging-face model repo: %s", err)
 	}
 
 	return &v1.HFModelRepo{
-		Name: r.Name,
+		Name:     r.Name,
+		Revision: r.Revision,
 	}, nil
 }
 
@@ -66,6 +68,7 @@ func (s *WS) GetHFModelRepo(
 	}
 
 	return &v1.HFModelRepo{
-		Name: r.Name,
+		Name:     r.Name,
+		Revision: r.Revision,
 	}, nil
 }
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"gorm.io/gorm"
+)
+
+// NewLeaseReaper creates a new LeaseReaper.
+func NewLeaseReaper(s *store.S, log logr.Logger) *LeaseReaper {
+	return &LeaseReaper{
+		store: s,
+		log:   log.WithName("leasereaper"),
+	}
+}
+
+// LeaseReaper returns models whose loading leases have expired to the REQUESTED status.
+//
+// A lease expires when its loader crashes or loses connectivity in the middle of loading.
+// Without reaping, such models would stay in the LOADING status forever.
+//
+// Models being cancelled are moved to the CANCELLED status instead, and the objects that have been
+// uploaded are deleted by a loader of the cluster.
+type LeaseReaper struct {
+	store *store.S
+	log   logr.Logger
+}
+
+// Run periodically reaps expired leases.
+func (r *LeaseReaper) Run(ctx context.Context, interval time.Duration) error {
+	r.log.Info("Starting lease reaper...")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			r.log.Info("Stopping lease reaper...")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := r.reap(time.Now()); err != nil {
+				// Gracefully handle the error.
+				r.log.Error(err, "Failed to reap expired leases")
+			}
+		}
+	}
+}
+
+func (r *LeaseReaper) reap(now time.Time) error {
+	n, err := r.store.ReleaseExpiredBaseModelLoadingLeases(now)
+	if err != nil {
+		return fmt.Errorf("release expired base model loading leases: %s", err)
+	}
+	if n > 0 {
+		r.log.Info("Released expired leases of base models", "count", n)
+	}
+
+	n, err = r.store.ReleaseExpiredModelLoadingLeases(now)
+	if err != nil {
+		return fmt.Errorf("release expired model loading leases: %s", err)
+	}
+	if n > 0 {
+		r.log.Info("Released expired leases of models", "count", n)
+	}
+
+	return r.cancelExpired(now)
+}
+
+// cancelExpired moves the models being cancelled whose leases have expired to the CANCELLED status. The loader
+// that would have deleted the uploaded objects is gone, so the deletion is enqueued.
+func (r *LeaseReaper) cancelExpired(now time.Time) error {
+	bms, err := r.store.ListBaseModelsWithExpiredCancellingLeases(now)
+	if err != nil {
+		return fmt.Errorf("list base models with expired cancelling leases: %s", err)
+	}
+	for _, bm := range bms {
+		k := store.ModelKey{
+			ModelID:   bm.ModelID,
+			ProjectID: bm.ProjectID,
+			TenantID:  bm.TenantID,
+		}
+		if err := r.store.Transaction(func(tx *gorm.DB) error {
+			if err := store.UpdateBaseModelToCancelledStatusInTransaction(tx, k, bm.LoadingLeaseHolder, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING); err != nil {
+				return err
+			}
+			if err := enqueueUploadDeletionInTransaction(tx, k, leaseHolderClusterID(bm.LoadingLeaseHolder), bm.UploadPath); err != nil {
+				return err
+			}
+			return store.CreateModelEventInTransaction(tx, newModelEvent(k, true, v1.ModelEventType_MODEL_EVENT_TYPE_CANCELLED))
+		}); err != nil {
+			if errors.Is(err, store.ErrConcurrentUpdate) {
+				// The lease has been renewed or the model has been updated since it was listed.
+				continue
+			}
+			return fmt.Errorf("cancel base model %q: %s", bm.ModelID, err)
+		}
+		r.log.Info("Cancelled base model whose lease has expired", "modelID", bm.ModelID)
+	}
+
+	ms, err := r.store.ListModelsWithExpiredCancellingLeases(now)
+	if err != nil {
+		return fmt.Errorf("list models with expired cancelling leases: %s", err)
+	}
+	for _, m := range ms {
+		k := store.ModelKey{
+			ModelID:  m.ModelID,
+			TenantID: m.TenantID,
+		}
+		if err := r.store.Transaction(func(tx *gorm.DB) error {
+			if err := store.UpdateModelToCancelledStatusInTransaction(tx, m.ModelID, m.TenantID, m.LoadingLeaseHolder, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING); err != nil {
+				return err
+			}
+			// Fine-tuned models are uploaded to the paths assigned by the server.
+			if err := enqueueUploadDeletionInTransaction(tx, k, leaseHolderClusterID(m.LoadingLeaseHolder), m.Path); err != nil {
+				return err
+			}
+			return store.CreateModelEventInTransaction(tx, newModelEvent(k, false, v1.ModelEventType_MODEL_EVENT_TYPE_CANCELLED))
+		}); err != nil {
+			if errors.Is(err, store.ErrConcurrentUpdate) {
+				continue
+			}
+			return fmt.Errorf("cancel model %q: %s", m.ModelID, err)
+		}
+		r.log.Info("Cancelled model whose lease has expired", "modelID", m.ModelID)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaseReaper(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	k := store.ModelKey{
+		ModelID:  "bm0",
+		TenantID: defaultTenantID,
+	}
+	_, err := st.CreateBaseModelWithLoadingRequested(k, v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", nil, "", "", 0)
+	assert.NoError(t, err)
+
+	_, err = st.CreateModel(store.ModelSpec{
+		ModelID:       "m0",
+		TenantID:      defaultTenantID,
+		Path:          "models/t0/p0/m0",
+		LoadingStatus: v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+	})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	err = st.UpdateBaseModelToLoadingStatus(k, "c0/l0", now.Add(-time.Second))
+	assert.NoError(t, err)
+	err = st.UpdateModelToLoadingStatus("m0", defaultTenantID, "c0/l0", now.Add(time.Minute))
+	assert.NoError(t, err)
+
+	r := NewLeaseReaper(st, testr.New(t))
+	err = r.reap(now)
+	assert.NoError(t, err)
+
+	bm, err := st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, bm.LoadingStatus)
+
+	// The lease of the fine-tuned model has not expired yet.
+	m, err := st.GetModelByModelIDAndTenantID("m0", defaultTenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING, m.LoadingStatus)
+
+	// The model being cancelled is not requeued once its lease expires.
+	err = st.UpdateModelToCancellingStatus("m0", defaultTenantID)
+	assert.NoError(t, err)
+	err = r.reap(now.Add(2 * time.Minute))
+	assert.NoError(t, err)
+
+	m, err = st.GetModelByModelIDAndTenantID("m0", defaultTenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED, m.LoadingStatus)
+
+	// The uploads of the cancelled model are deleted by a loader of the cluster that held the lease.
+	tasks, err := st.ListAcquirableObjectDeletionTasks(defaultTenantID, "c0", now)
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 1)
+	assert.Equal(t, "models/t0/p0/m0", tasks[0].Path)
+	assert.True(t, tasks[0].UploadsOnly)
+	tasks, err = st.ListAcquirableObjectDeletionTasks(defaultTenantID, "c1", now)
+	assert.NoError(t, err)
+	assert.Empty(t, tasks)
+}
+
+func TestLeaseReaper_CancellingBaseModel(t *testing.T) {
+	tcs := []struct {
+		name       string
+		uploadPath string
+		wantTasks  int
+	}{
+		{
+			name:       "uploads started",
+			uploadPath: "models/base-models/default/bm0",
+			wantTasks:  1,
+		},
+		{
+			name:      "no uploads",
+			wantTasks: 0,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			k := store.ModelKey{
+				ModelID:  "bm0",
+				TenantID: defaultTenantID,
+			}
+			_, err := st.CreateBaseModelWithLoadingRequested(k, v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", nil, "", "", 0)
+			assert.NoError(t, err)
+
+			now := time.Now()
+			err = st.UpdateBaseModelToLoadingStatus(k, "c0/l0", now.Add(time.Minute))
+			assert.NoError(t, err)
+			err = st.UpdateBaseModelLoadingStatusMessage(k, "downloaded files: 1, uploaded files: 0", tc.uploadPath, nil)
+			assert.NoError(t, err)
+			err = st.UpdateBaseModelToCancellingStatus(k)
+			assert.NoError(t, err)
+
+			r := NewLeaseReaper(st, testr.New(t))
+			// The lease has not expired yet.
+			err = r.reap(now)
+			assert.NoError(t, err)
+			bm, err := st.GetBaseModel(k)
+			assert.NoError(t, err)
+			assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING, bm.LoadingStatus)
+
+			err = r.reap(now.Add(2 * time.Minute))
+			assert.NoError(t, err)
+			bm, err = st.GetBaseModel(k)
+			assert.NoError(t, err)
+			assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED, bm.LoadingStatus)
+			assert.Empty(t, bm.LoadingLeaseHolder)
+
+			tasks, err := st.ListAcquirableObjectDeletionTasks(defaultTenantID, "c0", now)
+			assert.NoError(t, err)
+			assert.Len(t, tasks, tc.wantTasks)
+			for _, task := range tasks {
+				assert.Equal(t, tc.uploadPath, task.Path)
+				assert.True(t, task.UploadsOnly)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// loadQueueItem is a model waiting to be loaded or being loaded.
+type loadQueueItem struct {
+	priority int32
+	// group is the project that the model belongs to. Models of the same priority are served
+	// round-robin across groups so that a project that requests many models does not starve others.
+	group string
+	// seq is the creation order of the model.
+	seq uint
+}
+
+// loadOrder returns the indexes of the queued items in the order that loaders acquire them.
+//
+// Items are ordered by their priorities. Among the items of the same priority, the first item of each group
+// comes first, then the second item of each group, and so on. Items being loaded count as the first items of
+// their groups so that the round-robin continues across acquisitions. Ties are broken by the creation order.
+func loadOrder(queued, loading []loadQueueItem) []int {
+	idxs := make([]int, len(queued))
+	for i := range queued {
+		idxs[i] = i
+	}
+	sort.SliceStable(idxs, func(i, j int) bool {
+		a, b := queued[idxs[i]], queued[idxs[j]]
+		if a.priority != b.priority {
+			return a.priority > b.priority
+		}
+		return a.seq < b.seq
+	})
+
+	// rounds[i] is the number of items of the same group and the same priority that precede the i-th item.
+	type groupKey struct {
+		priority int32
+		group    string
+	}
+	counts := map[groupKey]int{}
+	for _, item := range loading {
+		counts[groupKey{priority: item.priority, group: item.group}]++
+	}
+	rounds := make([]int, len(queued))
+	for _, i := range idxs {
+		k := groupKey{priority: queued[i].priority, group: queued[i].group}
+		rounds[i] = counts[k]
+		counts[k]++
+	}
+
+	sort.SliceStable(idxs, func(i, j int) bool {
+		a, b := queued[idxs[i]], queued[idxs[j]]
+		if a.priority != b.priority {
+			return a.priority > b.priority
+		}
+		if ra, rb := rounds[idxs[i]], rounds[idxs[j]]; ra != rb {
+			return ra < rb
+		}
+		return a.seq < b.seq
+	})
+	return idxs
+}
+
+// listUnloadedBaseModelsInLoadOrder returns the unloaded base models of the tenant in the order
+// that loaders acquire them.
+func listUnloadedBaseModelsInLoadOrder(st *store.S, tenantID string) ([]*store.BaseModel, error) {
+	ms, err := st.ListUnloadedBaseModels(tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("list unloaded base models: %s", err)
+	}
+	lms, err := st.ListLoadingBaseModels(tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("list loading base models: %s", err)
+	}
+	toItem := func(m *store.BaseModel) loadQueueItem {
+		return loadQueueItem{
+			priority: m.Priority,
+			group:    m.TenantID + "/" + m.ProjectID,
+			seq:      m.ID,
+		}
+	}
+	var queued, loading []loadQueueItem
+	for _, m := range ms {
+		queued = append(queued, toItem(m))
+	}
+	for _, m := range lms {
+		loading = append(loading, toItem(m))
+	}
+	var ordered []*store.BaseModel
+	for _, i := range loadOrder(queued, loading) {
+		ordered = append(ordered, ms[i])
+	}
+	return ordered, nil
+}
+
+// listUnloadedModelsInLoadOrder returns the unloaded fine-tuned models of the tenant in the order
+// that loaders acquire them.
+func listUnloadedModelsInLoadOrder(st *store.S, tenantID string) ([]*store.Model, error) {
+	ms, err := st.ListUnloadedModels(tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("list unloaded models: %s", err)
+	}
+	lms, err := st.ListLoadingModels(tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("list loading models: %s", err)
+	}
+	toItem := func(m *store.Model) loadQueueItem {
+		return loadQueueItem{
+			priority: m.Priority,
+			group:    m.TenantID + "/" + m.ProjectID,
+			seq:      m.ID,
+		}
+	}
+	var queued, loading []loadQueueItem
+	for _, m := range ms {
+		queued = append(queued, toItem(m))
+	}
+	for _, m := range lms {
+		loading = append(loading, toItem(m))
+	}
+	var ordered []*store.Model
+	for _, i := range loadOrder(queued, loading) {
+		ordered = append(ordered, ms[i])
+	}
+	return ordered, nil
+}
+
+// ListModelLoadQueue lists the models waiting to be loaded.
+func (s *S) ListModelLoadQueue(
+	ctx context.Context,
+	req *v1.ListModelLoadQueueRequest,
+) (*v1.ListModelLoadQueueResponse, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	entries, err := s.visibleLoadQueue(userInfo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list load queue: %s", err)
+	}
+	return &v1.ListModelLoadQueueResponse{
+		Entries: entries,
+	}, nil
+}
+
+// visibleLoadQueue returns the entries of the load queue that are visible to the user.
+//
+// Loaders acquire base models before fine-tuned models, so base models precede fine-tuned models in the queue.
+func (s *S) visibleLoadQueue(userInfo *auth.UserInfo) ([]*v1.ModelLoadQueueEntry, error) {
+	bms, err := listUnloadedBaseModelsInLoadOrder(s.store, userInfo.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	ms, err := listUnloadedModelsInLoadOrder(s.store, userInfo.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		entries []*v1.ModelLoadQueueEntry
+		pos     int32
+	)
+	for _, m := range bms {
+		pos++
+		if m.ProjectID != "" && m.ProjectID != userInfo.ProjectID {
+			continue
+		}
+		entries = append(entries, &v1.ModelLoadQueueEntry{
+			ModelId:          m.ModelID,
+			IsBaseModel:      true,
+			ProjectId:        m.ProjectID,
+			SourceRepository: m.SourceRepository,
+			Priority:         m.Priority,
+			Position:         pos,
+		})
+	}
+	for _, m := range ms {
+		pos++
+		if !m.IsPublished || m.ProjectID != userInfo.ProjectID {
+			continue
+		}
+		entries = append(entries, &v1.ModelLoadQueueEntry{
+			ModelId:          m.ModelID,
+			IsBaseModel:      false,
+			ProjectId:        m.ProjectID,
+			SourceRepository: m.SourceRepository,
+			Priority:         m.Priority,
+			Position:         pos,
+		})
+	}
+	return entries, nil
+}
+
+// setQueuePositions sets the queue positions of the requested models.
+func (s *S) setQueuePositions(userInfo *auth.UserInfo, ms ...*v1.Model) error {
+	var requested bool
+	for _, m := range ms {
+		if m.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED {
+			requested = true
+			break
+		}
+	}
+	if !requested {
+		return nil
+	}
+
+	entries, err := s.visibleLoadQueue(userInfo)
+	if err != nil {
+		return err
+	}
+	type key struct {
+		id          string
+		isBaseModel bool
+	}
+	positions := map[key]int32{}
+	for _, e := range entries {
+		k := key{id: e.ModelId, isBaseModel: e.IsBaseModel}
+		// A project-scoped base model takes precedence over the global one of the same ID as in getVisibleBaseModel.
+		if _, ok := positions[k]; ok && e.ProjectId == "" {
+			continue
+		}
+		positions[k] = e.Position
+	}
+	for _, m := range ms {
+		if m.LoadingStatus != v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED {
+			continue
+		}
+		m.QueuePosition = positions[key{id: m.Id, isBaseModel: m.IsBaseModel}]
+	}
+	return nil
+}
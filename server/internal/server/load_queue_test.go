@@ -0,0 +1,270 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoadOrder(t *testing.T) {
+	tcs := []struct {
+		name    string
+		items   []loadQueueItem
+		loading []loadQueueItem
+		want    []int
+	}{
+		{
+			name: "empty",
+			want: []int{},
+		},
+		{
+			name: "creation order",
+			items: []loadQueueItem{
+				{group: "p0", seq: 2},
+				{group: "p0", seq: 1},
+				{group: "p0", seq: 3},
+			},
+			want: []int{1, 0, 2},
+		},
+		{
+			name: "priority",
+			items: []loadQueueItem{
+				{group: "p0", seq: 1},
+				{group: "p0", seq: 2, priority: 10},
+				{group: "p1", seq: 3, priority: -1},
+				{group: "p1", seq: 4, priority: 5},
+			},
+			want: []int{1, 3, 0, 2},
+		},
+		{
+			name: "round robin across groups",
+			items: []loadQueueItem{
+				{group: "p0", seq: 1},
+				{group: "p0", seq: 2},
+				{group: "p0", seq: 3},
+				{group: "p1", seq: 4},
+				{group: "p1", seq: 5},
+				{group: "p2", seq: 6},
+			},
+			want: []int{0, 3, 5, 1, 4, 2},
+		},
+		{
+			name: "round robin within priority",
+			items: []loadQueueItem{
+				{group: "p0", seq: 1, priority: 1},
+				{group: "p0", seq: 2, priority: 1},
+				{group: "p0", seq: 3},
+				{group: "p1", seq: 4},
+				{group: "p1", seq: 5, priority: 1},
+			},
+			want: []int{0, 4, 1, 2, 3},
+		},
+		{
+			name: "round robin with loading items",
+			items: []loadQueueItem{
+				{group: "p0", seq: 2},
+				{group: "p0", seq: 3},
+				{group: "p1", seq: 4},
+			},
+			loading: []loadQueueItem{
+				{group: "p0", seq: 1},
+				// Items of a different priority do not affect the order.
+				{group: "p1", seq: 0, priority: 1},
+			},
+			want: []int{2, 0, 1},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, loadOrder(tc.items, tc.loading))
+		})
+	}
+}
+
+func TestListModelLoadQueue(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	// The project of the caller requests three models. Another project requests one model.
+	for _, id := range []string{"r0/m0", "r0/m1", "r0/m2"} {
+		_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+			Id:               id,
+			SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		})
+		assert.NoError(t, err)
+	}
+	_, err := st.CreateBaseModelWithLoadingRequested(
+		store.ModelKey{ModelID: "r1/m0", ProjectID: "other", TenantID: defaultTenantID},
+		v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		"",
+		nil,
+		"",
+		"",
+		0,
+	)
+	assert.NoError(t, err)
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "r0/m3",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		Priority:         1,
+	})
+	assert.NoError(t, err)
+
+	resp, err := srv.ListModelLoadQueue(ctx, &v1.ListModelLoadQueueRequest{})
+	assert.NoError(t, err)
+	// The model of the other project is not visible, but it still takes the third position.
+	var ids []string
+	var positions []int32
+	for _, e := range resp.Entries {
+		ids = append(ids, e.ModelId)
+		positions = append(positions, e.Position)
+	}
+	assert.Equal(t, []string{"r0/m3", "r0/m0", "r0/m1", "r0/m2"}, ids)
+	assert.Equal(t, []int32{1, 2, 4, 5}, positions)
+
+	m, err := srv.GetModel(ctx, &v1.GetModelRequest{Id: "r0/m1", IncludeLoadingModel: true})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), m.QueuePosition)
+
+	want := []string{"r0/m3", "r0/m0", "r1/m0", "r0/m1", "r0/m2"}
+	for _, id := range want {
+		aresp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, id, aresp.BaseModelId)
+	}
+
+	resp, err = srv.ListModelLoadQueue(ctx, &v1.ListModelLoadQueueRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Entries)
+
+	m, err = srv.GetModel(ctx, &v1.GetModelRequest{Id: "r0/m1", IncludeLoadingModel: true})
+	assert.NoError(t, err)
+	assert.Zero(t, m.QueuePosition)
+}
+
+func TestCreateModel_Priority(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	tcs := []struct {
+		name            string
+		id              string
+		isProjectScoped bool
+		priority        int32
+		wantCode        codes.Code
+	}{
+		{
+			name:     "too high",
+			id:       "r0/m0",
+			priority: 11,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "too low",
+			id:       "r0/m0",
+			priority: -11,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "raised",
+			id:       "r0/m0",
+			priority: 10,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "raised within the quota",
+			id:       "r0/m1",
+			priority: 1,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "raised over the quota",
+			id:       "r0/m2",
+			priority: 1,
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "lowered over the quota",
+			id:       "r0/m2",
+			priority: -10,
+			wantCode: codes.OK,
+		},
+		{
+			// Project-scoped models are counted separately.
+			name:            "project-scoped",
+			id:              "r0/m3",
+			isProjectScoped: true,
+			priority:        1,
+			wantCode:        codes.OK,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+				Id:               tc.id,
+				SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+				IsProjectScoped:  tc.isProjectScoped,
+				Priority:         tc.priority,
+			})
+			assert.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+
+	// Fine-tuned models share the quota of the project.
+	_, err := wsrv.CreateStorageConfig(ctx, &v1.CreateStorageConfigRequest{
+		PathPrefix: "models",
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		SourceRepository:  v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		IsFineTunedModel:  true,
+		BaseModelId:       "r0/m0",
+		Suffix:            "ft0",
+		ModelFileLocation: "s3://test",
+		Priority:          1,
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		SourceRepository:  v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		IsFineTunedModel:  true,
+		BaseModelId:       "r0/m0",
+		Suffix:            "ft1",
+		ModelFileLocation: "s3://test",
+		Priority:          1,
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The quota is released once the loading fails.
+	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "r0/m0", resp.BaseModelId)
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id: "r0/m0",
+		LoadingResult: &v1.UpdateBaseModelLoadingStatusRequest_Failure_{
+			Failure: &v1.UpdateBaseModelLoadingStatusRequest_Failure{
+				Reason: "error",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "r0/m4",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		Priority:         1,
+	})
+	assert.NoError(t, err)
+}
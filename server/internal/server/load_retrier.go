@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/llmariner/model-manager/server/internal/store"
+)
+
+// NewLoadRetrier creates a new LoadRetrier.
+func NewLoadRetrier(s *store.S, log logr.Logger) *LoadRetrier {
+	return &LoadRetrier{
+		store: s,
+		log:   log.WithName("loadretrier"),
+	}
+}
+
+// LoadRetrier requeues failed models whose next loading attempt time has come so that loaders
+// load them again. The next attempt time is set when a loader reports the failure.
+type LoadRetrier struct {
+	store *store.S
+	log   logr.Logger
+}
+
+// Run periodically requeues failed models.
+func (r *LoadRetrier) Run(ctx context.Context, interval time.Duration) error {
+	r.log.Info("Starting load retrier...")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			r.log.Info("Stopping load retrier...")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := r.requeue(time.Now()); err != nil {
+				// Gracefully handle the error.
+				r.log.Error(err, "Failed to requeue failed models")
+			}
+		}
+	}
+}
+
+func (r *LoadRetrier) requeue(now time.Time) error {
+	n, err := r.store.RequeueFailedBaseModels(now)
+	if err != nil {
+		return fmt.Errorf("requeue failed base models: %s", err)
+	}
+	if n > 0 {
+		r.log.Info("Requeued failed base models", "count", n)
+	}
+
+	n, err = r.store.RequeueFailedModels(now)
+	if err != nil {
+		return fmt.Errorf("requeue failed models: %s", err)
+	}
+	if n > 0 {
+		r.log.Info("Requeued failed models", "count", n)
+	}
+	return nil
+}
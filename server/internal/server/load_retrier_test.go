@@ -0,0 +1,53 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadRetrier(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	k := store.ModelKey{
+		ModelID:  "bm0",
+		TenantID: defaultTenantID,
+	}
+	_, err := st.CreateBaseModelWithLoadingRequested(k, v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", nil, "", "", 0)
+	assert.NoError(t, err)
+
+	_, err = st.CreateModel(store.ModelSpec{
+		ModelID:       "m0",
+		TenantID:      defaultTenantID,
+		LoadingStatus: v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+	})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	err = st.UpdateBaseModelToLoadingStatus(k, "", time.Time{})
+	assert.NoError(t, err)
+	err = st.UpdateBaseModelToFailedStatus(k, "", "error", now.Add(-time.Second), 3)
+	assert.NoError(t, err)
+	err = st.UpdateModelToLoadingStatus("m0", defaultTenantID, "", time.Time{})
+	assert.NoError(t, err)
+	err = st.UpdateModelToFailedStatus("m0", defaultTenantID, "", "error", now.Add(time.Minute), 3)
+	assert.NoError(t, err)
+
+	r := NewLoadRetrier(st, testr.New(t))
+	err = r.requeue(now)
+	assert.NoError(t, err)
+
+	bm, err := st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, bm.LoadingStatus)
+
+	// The next attempt of the fine-tuned model has not come yet.
+	m, err := st.GetModelByModelIDAndTenantID("m0", defaultTenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED, m.LoadingStatus)
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// ListLoadedModelPaths lists the object store paths of the loaded and degraded models in the tenant.
+// Only the models loaded by the cluster are listed as other clusters might use different object stores.
+func (s *WS) ListLoadedModelPaths(
+	ctx context.Context,
+	req *v1.ListLoadedModelPathsRequest,
+) (*v1.ListLoadedModelPathsResponse, error) {
+	clusterInfo, err := s.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bms, err := s.store.ListLoadedOrDegradedBaseModels(clusterInfo.TenantID, clusterInfo.ClusterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list base models: %s", err)
+	}
+	ms, err := s.store.ListLoadedOrDegradedModels(clusterInfo.TenantID, clusterInfo.ClusterID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list models: %s", err)
+	}
+
+	var paths []*v1.LoadedModelPath
+	for _, bm := range bms {
+		paths = append(paths, &v1.LoadedModelPath{
+			Id:             bm.ModelID,
+			ProjectId:      bm.ProjectID,
+			IsBaseModel:    true,
+			Path:           bm.Path,
+			GgufModelPath:  bm.GGUFModelPath,
+			LoadingStatus:  toLoadingStatus(bm.LoadingStatus),
+			DegradedReason: degradedReason(bm.LoadingStatus, bm.LoadingFailureReason),
+		})
+	}
+	for _, m := range ms {
+		paths = append(paths, &v1.LoadedModelPath{
+			Id:             m.ModelID,
+			Path:           m.Path,
+			LoadingStatus:  toLoadingStatus(m.LoadingStatus),
+			DegradedReason: degradedReason(m.LoadingStatus, m.LoadingFailureReason),
+		})
+	}
+	return &v1.ListLoadedModelPathsResponse{
+		Models: paths,
+	}, nil
+}
+
+func degradedReason(s v1.ModelLoadingStatus, reason string) string {
+	if s != v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED {
+		return ""
+	}
+	return reason
+}
+
+// UpdateModelDegradation marks a loaded model as degraded or a degraded model as loaded.
+func (s *WS) UpdateModelDegradation(
+	ctx context.Context,
+	req *v1.UpdateModelDegradationRequest,
+) (*v1.UpdateModelDegradationResponse, error) {
+	clusterInfo, err := s.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if req.Degraded && req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	k := store.ModelKey{
+		ModelID:   req.Id,
+		ProjectID: req.ProjectId,
+		TenantID:  clusterInfo.TenantID,
+	}
+
+	var (
+		curr          v1.ModelLoadingStatus
+		currReason    string
+		clusterID     string
+		degrade       func() error
+		restore       func() error
+		recordedModel = k
+	)
+	if req.IsBaseModel {
+		bm, err := s.store.GetBaseModel(k)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "model %q not found", req.Id)
+			}
+			return nil, status.Errorf(codes.Internal, "get base model: %s", err)
+		}
+		curr, currReason, clusterID = bm.LoadingStatus, bm.LoadingFailureReason, bm.LoadedClusterID
+		degrade = func() error { return s.store.UpdateBaseModelToDegradedStatus(k, req.Reason) }
+		restore = func() error { return s.store.UpdateBaseModelToRestoredStatus(k) }
+	} else {
+		m, err := s.store.GetModelByModelIDAndTenantID(req.Id, clusterInfo.TenantID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, "model %q not found", req.Id)
+			}
+			return nil, status.Errorf(codes.Internal, "get model: %s", err)
+		}
+		curr, currReason, clusterID = m.LoadingStatus, m.LoadingFailureReason, m.LoadedClusterID
+		degrade = func() error { return s.store.UpdateModelToDegradedStatus(req.Id, clusterInfo.TenantID, req.Reason) }
+		restore = func() error { return s.store.UpdateModelToRestoredStatus(req.Id, clusterInfo.TenantID) }
+		// Events of fine-tuned models are not scoped by projects.
+		recordedModel.ProjectID = ""
+	}
+
+	isDegraded := curr == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED
+	if (req.Degraded || isDegraded) && clusterID != clusterInfo.ClusterID {
+		// The cluster might not have access to the object store that has the model.
+		return nil, status.Errorf(codes.FailedPrecondition, "model %q is not loaded by the cluster", req.Id)
+	}
+	var eventType v1.ModelEventType
+	switch {
+	case req.Degraded && isDegraded && req.Reason == currReason:
+		// Nothing to update.
+		return &v1.UpdateModelDegradationResponse{}, nil
+	case req.Degraded:
+		if err := degrade(); err != nil {
+			if errors.Is(err, store.ErrConcurrentUpdate) {
+				return nil, status.Errorf(codes.FailedPrecondition, "model %q is not loaded", req.Id)
+			}
+			return nil, status.Errorf(codes.Internal, "update model loading status: %s", err)
+		}
+		if isDegraded {
+			// Only the reason has been updated.
+			return &v1.UpdateModelDegradationResponse{}, nil
+		}
+		s.log.Info("Marked a model as degraded", "modelID", req.Id, "reason", req.Reason)
+		eventType = v1.ModelEventType_MODEL_EVENT_TYPE_DEGRADED
+	case !isDegraded:
+		// The model is not degraded.
+		return &v1.UpdateModelDegradationResponse{}, nil
+	default:
+		if err := restore(); err != nil {
+			if errors.Is(err, store.ErrConcurrentUpdate) {
+				return nil, status.Errorf(codes.FailedPrecondition, "model %q is not degraded", req.Id)
+			}
+			return nil, status.Errorf(codes.Internal, "update model loading status: %s", err)
+		}
+		s.log.Info("Marked a degraded model as loaded", "modelID", req.Id)
+		eventType = v1.ModelEventType_MODEL_EVENT_TYPE_LOADED
+	}
+	recordModelEvent(s.store, s.log, recordedModel, req.IsBaseModel, eventType)
+
+	return &v1.UpdateModelDegradationResponse{}, nil
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateModelDegradation(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	_, err := wsrv.CreateBaseModel(ctx, &v1.CreateBaseModelRequest{
+		Id:               "bm0",
+		Path:             "models/base-models/global/bm0",
+		Formats:          []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+		GgufModelPath:    "models/base-models/global/bm0/model.gguf",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+	_, err = st.CreateModel(store.ModelSpec{
+		ModelID:         "ft:m0",
+		OrganizationID:  "o0",
+		ProjectID:       defaultProjectID,
+		TenantID:        defaultTenantID,
+		Path:            "models/t0/p0/ft:m0",
+		IsPublished:     true,
+		LoadingStatus:   v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
+		LoadedClusterID: defaultClusterID,
+	})
+	assert.NoError(t, err)
+	// A model that is being loaded is not listed.
+	_, err = st.CreateModel(store.ModelSpec{
+		ModelID:        "ft:m1",
+		OrganizationID: "o0",
+		ProjectID:      defaultProjectID,
+		TenantID:       defaultTenantID,
+		IsPublished:    true,
+		LoadingStatus:  v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+	})
+	assert.NoError(t, err)
+	// A model that has been loaded by another cluster is not listed.
+	_, err = st.CreateModel(store.ModelSpec{
+		ModelID:         "ft:m2",
+		OrganizationID:  "o0",
+		ProjectID:       defaultProjectID,
+		TenantID:        defaultTenantID,
+		Path:            "models/t0/p0/ft:m2",
+		IsPublished:     true,
+		LoadingStatus:   v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
+		LoadedClusterID: "other",
+	})
+	assert.NoError(t, err)
+
+	lresp, err := wsrv.ListLoadedModelPaths(ctx, &v1.ListLoadedModelPathsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, lresp.Models, 2)
+	assert.Equal(t, "bm0", lresp.Models[0].Id)
+	assert.Equal(t, "models/base-models/global/bm0/model.gguf", lresp.Models[0].GgufModelPath)
+	assert.Equal(t, "ft:m0", lresp.Models[1].Id)
+	assert.Equal(t, "models/t0/p0/ft:m0", lresp.Models[1].Path)
+
+	_, err = wsrv.UpdateModelDegradation(ctx, &v1.UpdateModelDegradationRequest{Id: "bm0", IsBaseModel: true, Degraded: true})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = wsrv.UpdateModelDegradation(ctx, &v1.UpdateModelDegradationRequest{Id: "ft:m2", Degraded: true, Reason: "file missing"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	for _, req := range []*v1.UpdateModelDegradationRequest{
+		{Id: "bm0", IsBaseModel: true, Degraded: true, Reason: "file missing"},
+		// Repeated reports do not record events.
+		{Id: "bm0", IsBaseModel: true, Degraded: true, Reason: "file missing"},
+		{Id: "ft:m0", Degraded: true, Reason: "file missing"},
+		// Restoring a model that is not degraded is a no-op.
+		{Id: "ft:m1"},
+	} {
+		_, err = wsrv.UpdateModelDegradation(ctx, req)
+		assert.NoError(t, err)
+	}
+
+	m, err := srv.GetModel(ctx, &v1.GetModelRequest{Id: "bm0", IncludeLoadingModel: true})
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED, m.LoadingStatus)
+	assert.Equal(t, "file missing", m.LoadingFailureReason)
+
+	// Degraded models are not listed as loaded models.
+	resp, err := srv.ListModels(ctx, &v1.ListModelsRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Data)
+
+	lresp, err = wsrv.ListLoadedModelPaths(ctx, &v1.ListLoadedModelPathsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, lresp.Models, 2)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED, lresp.Models[0].LoadingStatus)
+	assert.Equal(t, "file missing", lresp.Models[0].DegradedReason)
+
+	// The base model is restored once its files are found again.
+	_, err = wsrv.UpdateModelDegradation(ctx, &v1.UpdateModelDegradationRequest{Id: "bm0", IsBaseModel: true})
+	assert.NoError(t, err)
+	m, err = srv.GetModel(ctx, &v1.GetModelRequest{Id: "bm0"})
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED, m.LoadingStatus)
+	assert.Empty(t, m.LoadingFailureReason)
+
+	// The degraded fine-tuned model can be loaded again.
+	_, err = srv.RetryModelLoad(ctx, &v1.RetryModelLoadRequest{Id: "ft:m0"})
+	assert.NoError(t, err)
+	fm, err := st.GetModelByModelIDAndTenantID("ft:m0", defaultTenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, fm.LoadingStatus)
+
+	es, err := st.ListModelEventsAfterRevision(defaultTenantID, 0, 10)
+	assert.NoError(t, err)
+	var types []v1.ModelEventType
+	for _, e := range es {
+		types = append(types, e.Type)
+	}
+	assert.Equal(t, []v1.ModelEventType{
+		v1.ModelEventType_MODEL_EVENT_TYPE_CREATED,
+		v1.ModelEventType_MODEL_EVENT_TYPE_LOADED,
+		v1.ModelEventType_MODEL_EVENT_TYPE_DEGRADED,
+		v1.ModelEventType_MODEL_EVENT_TYPE_DEGRADED,
+		v1.ModelEventType_MODEL_EVENT_TYPE_LOADED,
+	}, types)
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// GetModelFiles returns the files of a loaded model.
+func (s *S) GetModelFiles(
+	ctx context.Context,
+	req *v1.GetModelFilesRequest,
+) (*v1.GetModelFilesResponse, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	k, _, err := getKeyForVisibleModel(s.store, req.Id, userInfo, false /* includeLoadingModel */)
+	if err != nil {
+		return nil, err
+	}
+	return getModelFiles(s.store, k)
+}
+
+// GetModelFiles returns the files of a loaded model.
+func (s *WS) GetModelFiles(
+	ctx context.Context,
+	req *v1.GetModelFilesRequest,
+) (*v1.GetModelFilesResponse, error) {
+	clusterInfo, err := s.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	bm, found, err := s.getLoadedBaseModel(req.Id, clusterInfo.TenantID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get loaded base model: %s", err)
+	}
+	if found {
+		return getModelFiles(s.store, store.ModelKey{
+			ModelID:   bm.ModelID,
+			ProjectID: bm.ProjectID,
+			TenantID:  bm.TenantID,
+		})
+	}
+
+	// Try a fine-tuned model next.
+	fm, err := s.store.GetPublishedModelByModelIDAndTenantID(req.Id, clusterInfo.TenantID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.Internal, "get model by model ID: %s", err)
+		}
+		return nil, status.Errorf(codes.NotFound, "model %q not found", req.Id)
+	}
+	if !isModelLoaded(fm) {
+		return nil, status.Errorf(codes.NotFound, "model %q not found", req.Id)
+	}
+	return getModelFiles(s.store, store.ModelKey{
+		ModelID:  fm.ModelID,
+		TenantID: fm.TenantID,
+	})
+}
+
+func getModelFiles(st *store.S, k store.ModelKey) (*v1.GetModelFilesResponse, error) {
+	fs, err := st.ListModelFiles(k)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list model files: %s", err)
+	}
+
+	resp := &v1.GetModelFilesResponse{}
+	for _, f := range fs {
+		resp.BasePath = f.BasePath
+		resp.Files = append(resp.Files, &v1.ModelFile{
+			Path:        f.Path,
+			SizeBytes:   f.SizeBytes,
+			Sha256:      f.SHA256,
+			ContentType: f.ContentType,
+		})
+	}
+	return resp, nil
+}
+
+// toModelFileRecords validates the files reported by a loader and converts them to the store records.
+func toModelFileRecords(basePath string, files []*v1.ModelFile) ([]*store.ModelFile, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+	if basePath == "" {
+		return nil, fmt.Errorf("files_base_path is required when files are set")
+	}
+
+	var fs []*store.ModelFile
+	seen := map[string]bool{}
+	for _, f := range files {
+		p := f.Path
+		if p == "" || path.IsAbs(p) || path.Clean(p) != p || p == ".." || strings.HasPrefix(p, "../") {
+			return nil, fmt.Errorf("invalid file path %q", p)
+		}
+		if seen[p] {
+			return nil, fmt.Errorf("duplicate file path %q", p)
+		}
+		seen[p] = true
+		if f.SizeBytes < 0 {
+			return nil, fmt.Errorf("size_bytes of file %q must be non-negative", p)
+		}
+
+		fs = append(fs, &store.ModelFile{
+			BasePath:    basePath,
+			Path:        p,
+			SizeBytes:   f.SizeBytes,
+			SHA256:      f.Sha256,
+			ContentType: f.ContentType,
+		})
+	}
+	return fs, nil
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestModelFiles(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	_, err := st.CreateModel(store.ModelSpec{
+		ModelID:        "ft:m1",
+		OrganizationID: "o0",
+		ProjectID:      defaultProjectID,
+		TenantID:       defaultTenantID,
+		Path:           "models/default-tenant-id/default/ft:m1",
+		IsPublished:    true,
+		LoadingStatus:  v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+	})
+	assert.NoError(t, err)
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	_, err = wsrv.CreateBaseModel(ctx, &v1.CreateBaseModelRequest{
+		Id:               "m0",
+		Path:             "models/base-models/m0",
+		Formats:          []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		FilesBasePath:    "models/base-models/m0",
+		Files: []*v1.ModelFile{
+			{Path: "model.safetensors", SizeBytes: 100, Sha256: "s1", ContentType: "application/octet-stream"},
+			{Path: "config.json", SizeBytes: 10, Sha256: "s0", ContentType: "application/json"},
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = wsrv.UpdateModelLoadingStatus(ctx, &v1.UpdateModelLoadingStatusRequest{
+		Id: "ft:m1",
+		LoadingResult: &v1.UpdateModelLoadingStatusRequest_Success_{
+			Success: &v1.UpdateModelLoadingStatusRequest_Success{
+				FilesBasePath: "models/default-tenant-id/default/ft:m1",
+				Files: []*v1.ModelFile{
+					{Path: "adapter_model.safetensors", SizeBytes: 20, Sha256: "s2"},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	resp, err := srv.GetModelFiles(ctx, &v1.GetModelFilesRequest{Id: "m0"})
+	assert.NoError(t, err)
+	assert.Equal(t, "models/base-models/m0", resp.BasePath)
+	assert.Len(t, resp.Files, 2)
+	assert.Equal(t, "config.json", resp.Files[0].Path)
+	assert.Equal(t, int64(10), resp.Files[0].SizeBytes)
+	assert.Equal(t, "s0", resp.Files[0].Sha256)
+	assert.Equal(t, "application/json", resp.Files[0].ContentType)
+	assert.Equal(t, "model.safetensors", resp.Files[1].Path)
+
+	wresp, err := wsrv.GetModelFiles(ctx, &v1.GetModelFilesRequest{Id: "m0"})
+	assert.NoError(t, err)
+	assert.Equal(t, resp.Files, wresp.Files)
+
+	wresp, err = wsrv.GetModelFiles(ctx, &v1.GetModelFilesRequest{Id: "ft:m1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "models/default-tenant-id/default/ft:m1", wresp.BasePath)
+	assert.Len(t, wresp.Files, 1)
+	assert.Equal(t, "adapter_model.safetensors", wresp.Files[0].Path)
+
+	_, err = wsrv.GetModelFiles(ctx, &v1.GetModelFilesRequest{Id: "m2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// The files are deleted together with the model.
+	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{Id: "m0"})
+	assert.NoError(t, err)
+	fs, err := st.ListModelFiles(store.ModelKey{ModelID: "m0", TenantID: defaultTenantID})
+	assert.NoError(t, err)
+	assert.Empty(t, fs)
+}
+
+func TestToModelFileRecords(t *testing.T) {
+	tcs := []struct {
+		name     string
+		basePath string
+		files    []*v1.ModelFile
+		wantErr  bool
+	}{
+		{
+			name: "no file",
+		},
+		{
+			name:     "valid",
+			basePath: "models/m0",
+			files:    []*v1.ModelFile{{Path: "config.json"}, {Path: "sub/model.gguf"}},
+		},
+		{
+			name:    "no base path",
+			files:   []*v1.ModelFile{{Path: "config.json"}},
+			wantErr: true,
+		},
+		{
+			name:     "empty path",
+			basePath: "models/m0",
+			files:    []*v1.ModelFile{{}},
+			wantErr:  true,
+		},
+		{
+			name:     "absolute path",
+			basePath: "models/m0",
+			files:    []*v1.ModelFile{{Path: "/config.json"}},
+			wantErr:  true,
+		},
+		{
+			name:     "path outside of base path",
+			basePath: "models/m0",
+			files:    []*v1.ModelFile{{Path: "../m1/config.json"}},
+			wantErr:  true,
+		},
+		{
+			name:     "duplicate path",
+			basePath: "models/m0",
+			files:    []*v1.ModelFile{{Path: "config.json"}, {Path: "config.json"}},
+			wantErr:  true,
+		},
+		{
+			name:     "negative size",
+			basePath: "models/m0",
+			files:    []*v1.ModelFile{{Path: "config.json", SizeBytes: -1}},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := toModelFileRecords(tc.basePath, tc.files)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, got, len(tc.files))
+		})
+	}
+}
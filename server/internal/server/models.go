@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"path"
+	"regexp"
 	"strings"
 
 	gerrors "github.com/llmariner/common/pkg/gormlib/errors"
 	v1 "github.com/llmariner/model-manager/api/v1"
 	"github.com/llmariner/model-manager/common/pkg/id"
+	"github.com/llmariner/model-manager/common/pkg/pathfilter"
 	"github.com/llmariner/model-manager/server/internal/store"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	"google.golang.org/grpc/codes"
@@ -57,10 +61,26 @@ func (s *S) createFineTunedModel(
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
 	}
 
+	if req.Revision != "" {
+		return nil, status.Error(codes.InvalidArgument, "revision is not supported for fine-tuned models")
+	}
+
+	if req.Checksum != "" {
+		return nil, status.Error(codes.InvalidArgument, "checksum is not supported for fine-tuned models")
+	}
+
+	if err := validateFilePatterns(req.FilePatterns); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
 	if err := validateModelConfig(req.Config); err != nil {
 		return nil, err
 	}
 
+	if err := s.validatePriority(req.Priority, userInfo.TenantID, userInfo.ProjectID); err != nil {
+		return nil, err
+	}
+
 	if _, found, err := getVisibleBaseModel(s.store, req.BaseModelId, userInfo, true /* includeLoadingModel */); err != nil {
 		return nil, status.Errorf(codes.Internal, "%s", err)
 	} else if !found {
@@ -91,6 +111,8 @@ func (s *S) createFineTunedModel(
 			LoadingStatus:     v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
 			SourceRepository:  req.SourceRepository,
 			ModelFileLocation: req.ModelFileLocation,
+			FilePatterns:      req.FilePatterns,
+			Priority:          req.Priority,
 		})
 		if err != nil {
 			if gerrors.IsUniqueConstraintViolation(err) {
@@ -118,6 +140,13 @@ func (s *S) createFineTunedModel(
 			}
 		}
 
+		if err := store.CreateModelEventInTransaction(tx, newModelEvent(store.ModelKey{
+			ModelID:  id,
+			TenantID: userInfo.TenantID,
+		}, false, v1.ModelEventType_MODEL_EVENT_TYPE_CREATED)); err != nil {
+			return status.Errorf(codes.Internal, "create model event: %s", err)
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
@@ -132,7 +161,11 @@ func (s *S) createFineTunedModel(
 	if config == nil {
 		config = defaultModelConfig()
 	}
-	return toModelProto(m, v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE, config, proj), nil
+	mp, err := toModelProto(m, v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE, config, proj)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "to proto: %s", err)
+	}
+	return mp, nil
 }
 
 func (s *S) createBaseModel(
@@ -154,6 +187,26 @@ func (s *S) createBaseModel(
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
 	}
 
+	if err := validateRevision(req.Revision, req.SourceRepository); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	var modelFileLocation string
+	if req.SourceRepository == v1.SourceRepository_SOURCE_REPOSITORY_HTTP {
+		if err := validateModelFileLocationAndSourceRepository(req.ModelFileLocation, req.SourceRepository); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		modelFileLocation = req.ModelFileLocation
+	}
+
+	if err := validateChecksum(req.Checksum, req.SourceRepository); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	if err := validateFilePatterns(req.FilePatterns); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
 	if err := validateModelConfig(req.Config); err != nil {
 		return nil, err
 	}
@@ -163,6 +216,10 @@ func (s *S) createBaseModel(
 		projectID = userInfo.ProjectID
 	}
 
+	if err := s.validatePriority(req.Priority, userInfo.TenantID, projectID); err != nil {
+		return nil, err
+	}
+
 	// Check if the model already exists (with the original name as well as the converted name).
 	for _, modelID := range []string{req.Id, id.ToLLMarinerModelID(req.Id)} {
 		k := store.ModelKey{
@@ -187,7 +244,7 @@ func (s *S) createBaseModel(
 			TenantID:  userInfo.TenantID,
 		}
 		var err error
-		m, err = store.CreateBaseModelWithLoadingRequestedInTransaction(tx, k, req.SourceRepository)
+		m, err = store.CreateBaseModelWithLoadingRequestedInTransaction(tx, k, req.SourceRepository, req.Revision, req.FilePatterns, modelFileLocation, req.Checksum, req.Priority)
 		if err != nil {
 			return status.Errorf(codes.Internal, "create base model: %s", err)
 		}
@@ -206,6 +263,10 @@ func (s *S) createBaseModel(
 				return status.Errorf(codes.Internal, "create model config: %s", err)
 			}
 		}
+
+		if err := store.CreateModelEventInTransaction(tx, newModelEvent(k, true, v1.ModelEventType_MODEL_EVENT_TYPE_CREATED)); err != nil {
+			return status.Errorf(codes.Internal, "create model event: %s", err)
+		}
 		return nil
 	}); err != nil {
 		return nil, err
@@ -394,6 +455,10 @@ func (s *S) ListModels(
 		return nil, err
 	}
 
+	if err := s.setQueuePositions(userInfo, modelProtos...); err != nil {
+		return nil, status.Errorf(codes.Internal, "set queue positions: %s", err)
+	}
+
 	return &v1.ListModelsResponse{
 		Object:     "list",
 		Data:       modelProtos,
@@ -433,6 +498,9 @@ func (s *S) GetModel(
 	if err != nil {
 		return nil, err
 	}
+	if err := s.setQueuePositions(userInfo, m); err != nil {
+		return nil, status.Errorf(codes.Internal, "set queue positions: %s", err)
+	}
 	return m, nil
 }
 
@@ -464,7 +532,7 @@ func (s *S) UpdateModel(
 		return nil, status.Error(codes.InvalidArgument, "model config is required")
 	}
 
-	k, err := getKeyForVisibleModel(s.store, req.Model.Id, userInfo, true /* includeLoadingModel */)
+	k, isBaseModel, err := getKeyForVisibleModel(s.store, req.Model.Id, userInfo, true /* includeLoadingModel */)
 	if err != nil {
 		return nil, err
 	}
@@ -509,6 +577,7 @@ func (s *S) UpdateModel(
 			return nil, status.Errorf(codes.Internal, "create model config: %s", err)
 		}
 	}
+	recordModelEvent(s.store, s.log, k, isBaseModel, v1.ModelEventType_MODEL_EVENT_TYPE_CONFIG_UPDATED)
 
 	m, err := getVisibleModelProto(s.store, s.pcache, req.Model.Id, userInfo, true /* includeLoadingModel */)
 	if err != nil {
@@ -531,7 +600,7 @@ func (s *S) DeleteModel(
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	k, err := getKeyForVisibleModel(s.store, req.Id, userInfo, true /* includeLoadingModel */)
+	k, _, err := getKeyForVisibleModel(s.store, req.Id, userInfo, true /* includeLoadingModel */)
 	if err != nil {
 		return nil, err
 	}
@@ -559,6 +628,14 @@ func (s *S) DeleteModel(
 
 func (s *S) deleteFineTunedModel(ctx context.Context, k store.ModelKey) (*v1.DeleteModelResponse, error) {
 	if err := s.store.Transaction(func(tx *gorm.DB) error {
+		m, err := store.GetModelByModelIDAndTenantIDInTransaction(tx, k.ModelID, k.TenantID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.NotFound, "model %q not found", k.ModelID)
+			}
+			return status.Errorf(codes.Internal, "get model: %s", err)
+		}
+
 		if err := store.DeleteModelInTransaction(tx, k.ModelID, k.TenantID); err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				return status.Errorf(codes.NotFound, "model %q not found", k.ModelID)
@@ -566,10 +643,22 @@ func (s *S) deleteFineTunedModel(ctx context.Context, k store.ModelKey) (*v1.Del
 			return status.Errorf(codes.Internal, "delete model: %s", err)
 		}
 
+		if err := enqueueObjectDeletionInTransaction(tx, k, m.LoadedClusterID, m.Path); err != nil {
+			return status.Errorf(codes.Internal, "enqueue object deletion: %s", err)
+		}
+
 		if err := deleteModelActivationStatusAndConfig(tx, k); err != nil {
 			return status.Errorf(codes.Internal, "delete model activation status and config: %s", err)
 		}
 
+		if err := store.DeleteModelFilesInTransaction(tx, k); err != nil {
+			return status.Errorf(codes.Internal, "delete model files: %s", err)
+		}
+
+		if err := store.CreateModelEventInTransaction(tx, newModelEvent(k, false, v1.ModelEventType_MODEL_EVENT_TYPE_DELETED)); err != nil {
+			return status.Errorf(codes.Internal, "create model event: %s", err)
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
@@ -586,6 +675,14 @@ func (s *S) deleteBaseModel(ctx context.Context, k store.ModelKey) (*v1.DeleteMo
 	// TODO(kenji): Revisit the permission check. The base model is scoped by a tenant, not project,
 	// so we should have additional check here.
 	if err := s.store.Transaction(func(tx *gorm.DB) error {
+		bm, err := store.GetBaseModelInTransaction(tx, k)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Errorf(codes.Internal, "get model: %s", err)
+			}
+			return status.Errorf(codes.NotFound, "model %q not found", k.ModelID)
+		}
+
 		if err := store.DeleteBaseModelInTransaction(tx, k); err != nil {
 			if !errors.Is(err, gorm.ErrRecordNotFound) {
 				return status.Errorf(codes.Internal, "delete model: %s", err)
@@ -593,6 +690,12 @@ func (s *S) deleteBaseModel(ctx context.Context, k store.ModelKey) (*v1.DeleteMo
 			return status.Errorf(codes.NotFound, "model %q not found", k.ModelID)
 		}
 
+		// Base models of the same repository can share objects, so the objects are deleted only when
+		// no other model references them. The GGUF file of a per-file base model is not stored under its path.
+		if err := enqueueObjectDeletionInTransaction(tx, k, bm.LoadedClusterID, bm.Path, bm.GGUFModelPath); err != nil {
+			return status.Errorf(codes.Internal, "enqueue object deletion: %s", err)
+		}
+
 		// Delete the HFModelRepo if the model is from Hugging Face. Otherwise the same
 		// model cannot be reloaded again.
 		//
@@ -611,6 +714,18 @@ func (s *S) deleteBaseModel(ctx context.Context, k store.ModelKey) (*v1.DeleteMo
 			return status.Errorf(codes.Internal, "delete model activation status and config: %s", err)
 		}
 
+		if err := store.DeleteModelFilesInTransaction(tx, k); err != nil {
+			return status.Errorf(codes.Internal, "delete model files: %s", err)
+		}
+
+		if err := store.DeleteModelFormatArtifactsInTransaction(tx, k); err != nil {
+			return status.Errorf(codes.Internal, "delete model format artifacts: %s", err)
+		}
+
+		if err := store.CreateModelEventInTransaction(tx, newModelEvent(k, true, v1.ModelEventType_MODEL_EVENT_TYPE_DELETED)); err != nil {
+			return status.Errorf(codes.Internal, "create model event: %s", err)
+		}
+
 		return nil
 	}); err != nil {
 		return nil, err
@@ -634,7 +749,7 @@ func (s *S) ActivateModel(ctx context.Context, req *v1.ActivateModelRequest) (*v
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	k, err := getKeyForVisibleModel(s.store, req.Id, userInfo, false /* includeLoadingModel */)
+	k, isBaseModel, err := getKeyForVisibleModel(s.store, req.Id, userInfo, false /* includeLoadingModel */)
 	if err != nil {
 		return nil, err
 	}
@@ -642,6 +757,7 @@ func (s *S) ActivateModel(ctx context.Context, req *v1.ActivateModelRequest) (*v
 	if err := s.store.UpdateModelActivationStatus(k, v1.ActivationStatus_ACTIVATION_STATUS_ACTIVE); err != nil {
 		return nil, status.Errorf(codes.Internal, "update model activation status: %s", err)
 	}
+	recordModelEvent(s.store, s.log, k, isBaseModel, v1.ModelEventType_MODEL_EVENT_TYPE_ACTIVATED)
 
 	return &v1.ActivateModelResponse{}, nil
 }
@@ -657,7 +773,7 @@ func (s *S) DeactivateModel(ctx context.Context, req *v1.DeactivateModelRequest)
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 
-	k, err := getKeyForVisibleModel(s.store, req.Id, userInfo, false /* includeLoadingModel */)
+	k, isBaseModel, err := getKeyForVisibleModel(s.store, req.Id, userInfo, false /* includeLoadingModel */)
 	if err != nil {
 		return nil, err
 	}
@@ -665,10 +781,122 @@ func (s *S) DeactivateModel(ctx context.Context, req *v1.DeactivateModelRequest)
 	if err := s.store.UpdateModelActivationStatus(k, v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE); err != nil {
 		return nil, status.Errorf(codes.Internal, "update model activation status: %s", err)
 	}
+	recordModelEvent(s.store, s.log, k, isBaseModel, v1.ModelEventType_MODEL_EVENT_TYPE_DEACTIVATED)
 
 	return &v1.DeactivateModelResponse{}, nil
 }
 
+// RetryModelLoad requeues a model whose loading has failed.
+func (s *S) RetryModelLoad(ctx context.Context, req *v1.RetryModelLoadRequest) (*v1.RetryModelLoadResponse, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	bm, fm, found, err := getVisibleModel(s.store, req.Id, userInfo, true /* includeLoadingModels */)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "model %q not found", req.Id)
+	}
+
+	if bm != nil {
+		err = s.store.UpdateBaseModelToRetryRequestedStatus(store.ModelKey{
+			ModelID:   bm.ModelID,
+			ProjectID: bm.ProjectID,
+			TenantID:  bm.TenantID,
+		})
+	} else {
+		err = s.store.UpdateModelToRetryRequestedStatus(fm.ModelID, fm.TenantID)
+	}
+	if err != nil {
+		if errors.Is(err, store.ErrConcurrentUpdate) {
+			return nil, status.Errorf(codes.FailedPrecondition, "model %q has neither failed to load, been cancelled, nor been degraded", req.Id)
+		}
+		return nil, status.Errorf(codes.Internal, "update model loading status: %s", err)
+	}
+
+	return &v1.RetryModelLoadResponse{}, nil
+}
+
+// CancelModelLoad cancels the loading of a model.
+func (s *S) CancelModelLoad(ctx context.Context, req *v1.CancelModelLoadRequest) (*v1.CancelModelLoadResponse, error) {
+	userInfo, ok := auth.ExtractUserInfoFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract user info from context")
+	}
+
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	bm, fm, found, err := getVisibleModel(s.store, req.Id, userInfo, true /* includeLoadingModels */)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "model %q not found", req.Id)
+	}
+
+	var (
+		k             store.ModelKey
+		loadingStatus v1.ModelLoadingStatus
+	)
+	if bm != nil {
+		k = store.ModelKey{
+			ModelID:   bm.ModelID,
+			ProjectID: bm.ProjectID,
+			TenantID:  bm.TenantID,
+		}
+		loadingStatus = bm.LoadingStatus
+	} else {
+		k = store.ModelKey{
+			ModelID:  fm.ModelID,
+			TenantID: fm.TenantID,
+		}
+		loadingStatus = fm.LoadingStatus
+	}
+
+	switch loadingStatus {
+	case v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED:
+		// No loader is loading the model. Cancel it right away so that it is not acquired or requeued.
+		if bm != nil {
+			err = s.store.UpdateBaseModelToCancelledStatus(k, "", loadingStatus)
+		} else {
+			err = s.store.UpdateModelToCancelledStatus(k.ModelID, k.TenantID, "", loadingStatus)
+		}
+	case v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING:
+		// The loader observes the cancellation when it reports the status next time.
+		if bm != nil {
+			err = s.store.UpdateBaseModelToCancellingStatus(k)
+		} else {
+			err = s.store.UpdateModelToCancellingStatus(k.ModelID, k.TenantID)
+		}
+	case v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING,
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED:
+		return &v1.CancelModelLoadResponse{}, nil
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "model %q is not being loaded", req.Id)
+	}
+	if err != nil {
+		if errors.Is(err, store.ErrConcurrentUpdate) {
+			return nil, status.Errorf(codes.FailedPrecondition, "concurrent update to model status")
+		}
+		return nil, status.Errorf(codes.Internal, "update model loading status: %s", err)
+	}
+	if loadingStatus != v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING {
+		recordModelEvent(s.store, s.log, k, bm != nil, v1.ModelEventType_MODEL_EVENT_TYPE_CANCELLED)
+	}
+
+	return &v1.CancelModelLoadResponse{}, nil
+}
+
 // listModelsByActivationStatus lists models by activation status with pagination.
 // This is a helper function called by ListModels.
 func listModelsByActivationStatus(
@@ -753,24 +981,29 @@ func listModelsByActivationStatus(
 			return nil, false, fmt.Errorf("get project: %s", err)
 		}
 
-		modelProtos = append(modelProtos, toModelProto(m, activationStatus, mc, proj))
+		mp, err := toModelProto(m, activationStatus, mc, proj)
+		if err != nil {
+			return nil, false, fmt.Errorf("to proto: %s", err)
+		}
+		modelProtos = append(modelProtos, mp)
 	}
 
 	return modelProtos, more, nil
 }
 
+// getKeyForVisibleModel returns the key of the visible model and whether the model is a base model.
 func getKeyForVisibleModel(
 	st *store.S,
 	modelID string,
 	userInfo *auth.UserInfo,
 	includeLoadingModel bool,
-) (store.ModelKey, error) {
+) (store.ModelKey, bool, error) {
 	bm, fm, found, err := getVisibleModel(st, modelID, userInfo, includeLoadingModel)
 	if err != nil {
-		return store.ModelKey{}, status.Errorf(codes.Internal, "%s", err)
+		return store.ModelKey{}, false, status.Errorf(codes.Internal, "%s", err)
 	}
 	if !found {
-		return store.ModelKey{}, status.Errorf(codes.NotFound, "model %q not found", modelID)
+		return store.ModelKey{}, false, status.Errorf(codes.NotFound, "model %q not found", modelID)
 	}
 
 	if bm != nil {
@@ -778,14 +1011,14 @@ func getKeyForVisibleModel(
 			ModelID:   bm.ModelID,
 			ProjectID: bm.ProjectID,
 			TenantID:  bm.TenantID,
-		}, nil
+		}, true, nil
 	}
 
 	return store.ModelKey{
 		ModelID: fm.ModelID,
 		// ProjectID is empty for backward compatibility.
 		TenantID: fm.TenantID,
-	}, nil
+	}, false, nil
 }
 
 func getVisibleModelProto(
@@ -878,7 +1111,11 @@ func convertFineTunedModelToProto(
 		return nil, status.Errorf(codes.Internal, "get project: %s", err)
 	}
 
-	return toModelProto(m, as, mc, proj), nil
+	mp, err := toModelProto(m, as, mc, proj)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "to proto: %s", err)
+	}
+	return mp, nil
 }
 
 func getModelActivationStatus(st *store.S, k store.ModelKey) (v1.ActivationStatus, error) {
@@ -935,6 +1172,29 @@ func getModelConfigInTransaction(tx *gorm.DB, k store.ModelKey) (*v1.ModelConfig
 	return &config, nil
 }
 
+// listModelConfigs returns the model configs of a tenant keyed by the model key.
+// Models without a config are not included.
+func listModelConfigs(st *store.S, tenantID string) (map[store.ModelKey]*v1.ModelConfig, error) {
+	cs, err := st.ListModelConfigsByTenantID(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	configs := make(map[store.ModelKey]*v1.ModelConfig, len(cs))
+	for _, c := range cs {
+		var config v1.ModelConfig
+		if err := proto.Unmarshal(c.EncodedConfig, &config); err != nil {
+			return nil, err
+		}
+		k := store.ModelKey{
+			ModelID:   c.ModelID,
+			ProjectID: c.ProjectID,
+			TenantID:  c.TenantID,
+		}
+		configs[k] = &config
+	}
+	return configs, nil
+}
+
 func defaultModelConfig() *v1.ModelConfig {
 	return &v1.ModelConfig{
 		RuntimeConfig: &v1.ModelConfig_RuntimeConfig{
@@ -1032,12 +1292,29 @@ func getVisibleBaseModel(
 	return nil, false, nil
 }
 
-func toModelProto(m *store.Model, as v1.ActivationStatus, config *v1.ModelConfig, project *v1.Project) *v1.Model {
+func toModelProto(m *store.Model, as v1.ActivationStatus, config *v1.ModelConfig, project *v1.Project) (*v1.Model, error) {
+	filePatterns, err := store.UnmarshalFilePatterns(m.FilePatterns)
+	if err != nil {
+		return nil, err
+	}
+	size, err := store.UnmarshalModelSize(m.Size)
+	if err != nil {
+		return nil, err
+	}
+
 	var statusMsg string
-	if m.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING || m.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED {
+	if isLoadingInProgress(m.LoadingStatus) || m.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED {
 		statusMsg = m.LoadingStatusMessage
 	}
 
+	var progress *v1.ModelLoadingProgress
+	if isLoadingInProgress(m.LoadingStatus) {
+		progress, err = store.UnmarshalLoadingProgress(m.LoadingProgress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &v1.Model{
 		Id:                   m.ModelID,
 		Object:               "model",
@@ -1048,16 +1325,21 @@ func toModelProto(m *store.Model, as v1.ActivationStatus, config *v1.ModelConfig
 		LoadingFailureReason: m.LoadingFailureReason,
 		LoadingStatusMessage: statusMsg,
 		// Fine-tuned models always have the Hugging Face format.
-		Formats:     []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
-		IsBaseModel: false,
-		BaseModelId: m.BaseModelID,
+		Formats:      []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+		IsBaseModel:  false,
+		BaseModelId:  m.BaseModelID,
+		FilePatterns: filePatterns,
 
 		ActivationStatus: as,
 
 		Config: config,
 
 		Project: project,
-	}
+
+		LoadingProgress: progress,
+
+		Size: size,
+	}, nil
 }
 
 func baseToModelProto(m *store.BaseModel, as v1.ActivationStatus, config *v1.ModelConfig, project *v1.Project) (*v1.Model, error) {
@@ -1065,16 +1347,40 @@ func baseToModelProto(m *store.BaseModel, as v1.ActivationStatus, config *v1.Mod
 	if err != nil {
 		return nil, err
 	}
+	filePatterns, err := store.UnmarshalFilePatterns(m.FilePatterns)
+	if err != nil {
+		return nil, err
+	}
+	size, err := store.UnmarshalModelSize(m.Size)
+	if err != nil {
+		return nil, err
+	}
+	ggufMetadata, err := store.UnmarshalGGUFMetadata(m.GGUFMetadata)
+	if err != nil {
+		return nil, err
+	}
+	hfMetadata, err := store.UnmarshalHuggingFaceMetadata(m.HuggingFaceMetadata)
+	if err != nil {
+		return nil, err
+	}
 	if len(formats) == 0 {
 		// For backward compatibility.
 		formats = []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}
 	}
 
 	var statusMsg string
-	if m.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING || m.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED {
+	if isLoadingInProgress(m.LoadingStatus) || m.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED {
 		statusMsg = m.LoadingStatusMessage
 	}
 
+	var progress *v1.ModelLoadingProgress
+	if isLoadingInProgress(m.LoadingStatus) {
+		progress, err = store.UnmarshalLoadingProgress(m.LoadingProgress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &v1.Model{
 		Id:                   m.ModelID,
 		Object:               "model",
@@ -1082,6 +1388,9 @@ func baseToModelProto(m *store.BaseModel, as v1.ActivationStatus, config *v1.Mod
 		OwnedBy:              "system",
 		LoadingStatus:        toLoadingStatus(m.LoadingStatus),
 		SourceRepository:     m.SourceRepository,
+		Revision:             m.Revision,
+		ResolvedRevision:     m.ResolvedRevision,
+		FilePatterns:         filePatterns,
 		LoadingFailureReason: m.LoadingFailureReason,
 		LoadingStatusMessage: statusMsg,
 		Formats:              formats,
@@ -1093,6 +1402,12 @@ func baseToModelProto(m *store.BaseModel, as v1.ActivationStatus, config *v1.Mod
 		Config: config,
 
 		Project: project,
+
+		LoadingProgress: progress,
+
+		Size:                size,
+		GgufMetadata:        ggufMetadata,
+		HuggingFaceMetadata: hfMetadata,
 	}, nil
 }
 
@@ -1104,6 +1419,11 @@ func toLoadingStatus(s v1.ModelLoadingStatus) v1.ModelLoadingStatus {
 	return s
 }
 
+// isLoadingInProgress returns true if a loader holds the model, including the case where the loading is being cancelled.
+func isLoadingInProgress(s v1.ModelLoadingStatus) bool {
+	return s == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING || s == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING
+}
+
 func isBaseModelLoaded(m *store.BaseModel) bool {
 	return toLoadingStatus(m.LoadingStatus) == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED
 }
@@ -1112,6 +1432,14 @@ func isModelLoaded(m *store.Model) bool {
 	return toLoadingStatus(m.LoadingStatus) == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED
 }
 
+// ociReferenceRE matches a reference to a manifest in an OCI registry. The repository and the tag follow
+// the grammar of the OCI distribution specification.
+var ociReferenceRE = regexp.MustCompile(
+	`^[a-zA-Z0-9.-]+(:[0-9]+)?` +
+		`/[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*(/[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*)*` +
+		`(:[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}|@sha256:[a-f0-9]{64})$`,
+)
+
 func validateIDAndSourceRepository(id string, sourceRepository v1.SourceRepository) error {
 	switch sourceRepository {
 	case v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE:
@@ -1132,11 +1460,22 @@ func validateIDAndSourceRepository(id string, sourceRepository v1.SourceReposito
 		if l[0] == "" || l[1] == "" {
 			return fmt.Errorf("unexpected model ID format: %s. The format should be <model>:<tag>", id)
 		}
+	case v1.SourceRepository_SOURCE_REPOSITORY_OCI:
+		if !ociReferenceRE.MatchString(id) {
+			return fmt.Errorf("unexpected model ID format: %s. The format should be <registry>/<repository>:<tag> or <registry>/<repository>@sha256:<digest>", id)
+		}
+	case v1.SourceRepository_SOURCE_REPOSITORY_HTTP:
+		// The model is downloaded from model_file_location. The ID is only used as the name of the model.
+		if strings.ContainsAny(id, " \t\n") {
+			return fmt.Errorf("unexpected model ID format: %s. The ID must not contain whitespace", id)
+		}
 	default:
 		return fmt.Errorf("source_repository must be one of %v", []v1.SourceRepository{
 			v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
 			v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
 			v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA,
+			v1.SourceRepository_SOURCE_REPOSITORY_OCI,
+			v1.SourceRepository_SOURCE_REPOSITORY_HTTP,
 		})
 	}
 	return nil
@@ -1156,16 +1495,96 @@ func validateModelFileLocationAndSourceRepository(modelFileLocation string, sour
 		if err := validateHuggingFaceRepoFile(modelFileLocation); err != nil {
 			return err
 		}
+	case v1.SourceRepository_SOURCE_REPOSITORY_HTTP:
+		u, err := url.Parse(modelFileLocation)
+		if err != nil {
+			return fmt.Errorf("invalid model file location: %s", err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("model file location must be an http:// or https:// URL, but got %s", modelFileLocation)
+		}
+		if b := path.Base(u.Path); b == "/" || b == "." {
+			return fmt.Errorf("model file location must have a file name, but got %s", modelFileLocation)
+		}
+		// Non-public addresses are rejected by the loader after the host name is resolved unless the loader
+		// is configured to allow private networks.
 	default:
 		// Ollama is not currently supported.
 		return fmt.Errorf("source_repository must be one of %v", []v1.SourceRepository{
 			v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
 			v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+			v1.SourceRepository_SOURCE_REPOSITORY_HTTP,
 		})
 	}
 	return nil
 }
 
+// validatePriority validates the priority of loading a model of the project. The number of the models with
+// positive priorities is limited per project so that a project cannot put all its models ahead of the others.
+// Base models that are not project-scoped have an empty project ID and are limited per tenant.
+func (s *S) validatePriority(priority int32, tenantID, projectID string) error {
+	c := s.modelLoadingConfig.Priority
+	if priority > c.MaxPriority || priority < -c.MaxPriority {
+		return status.Errorf(codes.InvalidArgument, "priority must be between %d and %d", -c.MaxPriority, c.MaxPriority)
+	}
+	if priority <= 0 {
+		return nil
+	}
+
+	n, err := s.store.CountPendingBaseModelsWithRaisedPriority(tenantID, projectID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "count base models: %s", err)
+	}
+	if projectID != "" {
+		m, err := s.store.CountPendingModelsWithRaisedPriority(tenantID, projectID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "count models: %s", err)
+		}
+		n += m
+	}
+	if n >= int64(c.MaxRaisedModelsPerProject) {
+		return status.Errorf(codes.ResourceExhausted, "at most %d models with positive priorities can be waiting to be loaded", c.MaxRaisedModelsPerProject)
+	}
+	return nil
+}
+
+// checksumRE matches a checksum of a downloaded file.
+var checksumRE = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+func validateChecksum(checksum string, sourceRepository v1.SourceRepository) error {
+	if checksum == "" {
+		return nil
+	}
+	if sourceRepository != v1.SourceRepository_SOURCE_REPOSITORY_HTTP {
+		return fmt.Errorf("checksum is only supported for %s", v1.SourceRepository_SOURCE_REPOSITORY_HTTP)
+	}
+	if !checksumRE.MatchString(checksum) {
+		return fmt.Errorf("invalid checksum: %q. The format should be sha256:<hex> with lowercase hex digits", checksum)
+	}
+	return nil
+}
+
+func validateRevision(revision string, sourceRepository v1.SourceRepository) error {
+	if revision == "" {
+		return nil
+	}
+	if sourceRepository != v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE {
+		return fmt.Errorf("revision is only supported for %s", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE)
+	}
+	// The revision is embedded in the path of Hugging Face Hub API requests.
+	if strings.ContainsAny(revision, " \t\n") || strings.Contains(revision, "..") {
+		return fmt.Errorf("invalid revision: %q", revision)
+	}
+	return nil
+}
+
+func validateFilePatterns(p *v1.FilePatterns) error {
+	if _, err := pathfilter.New(p.GetInclude(), p.GetExclude()); err != nil {
+		return fmt.Errorf("invalid file patterns: %s", err)
+	}
+	return nil
+}
+
 func validateHuggingFaceRepoFile(s string) error {
 	l := strings.Split(s, "/")
 	if len(l) <= 1 || len(l) > 3 {
@@ -2,7 +2,9 @@ package server
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	"github.com/google/go-cmp/cmp"
@@ -51,7 +53,7 @@ func TestModels(t *testing.T) {
 	err = st.CreateModelActivationStatus(&store.ModelActivationStatus{ModelID: "m1", TenantID: defaultTenantID, Status: v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE})
 	assert.NoError(t, err)
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 	getResp, err := srv.GetModel(ctx, &v1.GetModelRequest{
 		Id: modelID,
@@ -100,6 +102,9 @@ func TestModels_Pagination(t *testing.T) {
 			[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 			"gguf-path",
 			v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+			"",
+			"",
+			nil,
 		)
 		assert.NoError(t, err)
 		err = st.CreateModelActivationStatus(&store.ModelActivationStatus{ModelID: id, TenantID: defaultTenantID, Status: v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE})
@@ -174,7 +179,7 @@ func TestModels_Pagination(t *testing.T) {
 
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
-			srv := New(st, &fakeProjectCache{}, testr.New(t))
+			srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 			ctx := fakeAuthInto(context.Background())
 
 			got, err := srv.ListModels(ctx, tc.req)
@@ -221,6 +226,9 @@ func TestModels_Pagination_ProjectScoped(t *testing.T) {
 			[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 			"gguf-path",
 			v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+			"",
+			"",
+			nil,
 		)
 		assert.NoError(t, err)
 		err = st.CreateModelActivationStatus(&store.ModelActivationStatus{
@@ -300,7 +308,7 @@ func TestModels_Pagination_ProjectScoped(t *testing.T) {
 
 	for _, tc := range tcs {
 		t.Run(tc.name, func(t *testing.T) {
-			srv := New(st, &fakeProjectCache{}, testr.New(t))
+			srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 			ctx := fakeAuthInto(context.Background())
 
 			got, err := srv.ListModels(ctx, tc.req)
@@ -330,6 +338,9 @@ func TestListModels_ActivationOrder(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 		"gguf-path",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 	err = st.CreateModelActivationStatus(&store.ModelActivationStatus{ModelID: "bm0", TenantID: defaultTenantID, Status: v1.ActivationStatus_ACTIVATION_STATUS_ACTIVE})
@@ -345,6 +356,9 @@ func TestListModels_ActivationOrder(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 		"gguf-path",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 
@@ -375,7 +389,7 @@ func TestListModels_ActivationOrder(t *testing.T) {
 	err = st.CreateModelActivationStatus(&store.ModelActivationStatus{ModelID: "m1", TenantID: defaultTenantID, Status: v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE})
 	assert.NoError(t, err)
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
 	resp, err := srv.ListModels(ctx, &v1.ListModelsRequest{})
@@ -401,10 +415,13 @@ func TestDeleteModel_BaseModel(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 		"gguf-path",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{
 		Id: "m0",
@@ -441,6 +458,9 @@ func TestDeleteModel_BaseModelAndHFModelRepo(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 		"gguf-path",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 
@@ -452,7 +472,7 @@ func TestDeleteModel_BaseModelAndHFModelRepo(t *testing.T) {
 	err = st.CreateHFModelRepo(r)
 	assert.NoError(t, err)
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
 	_, err = srv.GetModel(ctx, &v1.GetModelRequest{Id: modelID})
@@ -484,6 +504,9 @@ func TestDeleteModel_ActiveBaseModel(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 		"gguf-path",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 
@@ -494,7 +517,7 @@ func TestDeleteModel_ActiveBaseModel(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{
 		Id: "m0",
@@ -524,7 +547,7 @@ func TestDeleteModel_ActiveFineTunedModel(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{
 		Id: "m0",
@@ -559,12 +582,12 @@ func TestGetAndListModels(t *testing.T) {
 		ModelID:  baseModelID,
 		TenantID: defaultTenantID,
 	}
-	_, err = st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf-path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err = st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf-path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 	assert.NoError(t, err)
 	err = st.CreateModelActivationStatus(&store.ModelActivationStatus{ModelID: baseModelID, TenantID: defaultTenantID, Status: v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE})
 	assert.NoError(t, err)
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 	getResp, err := srv.GetModel(ctx, &v1.GetModelRequest{
 		Id: modelID,
@@ -601,6 +624,9 @@ func TestIncludeLoadingModels(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 		"gguf-path",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 
@@ -608,13 +634,13 @@ func TestIncludeLoadingModels(t *testing.T) {
 		ModelID:  "bm1",
 		TenantID: defaultTenantID,
 	}
-	_, err = st.CreateBaseModelWithLoadingRequested(k1, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err = st.CreateBaseModelWithLoadingRequested(k1, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", nil, "", "", 0)
 
 	assert.NoError(t, err)
 	err = st.CreateModelActivationStatus(&store.ModelActivationStatus{ModelID: "bm1", TenantID: defaultTenantID, Status: v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE})
 	assert.NoError(t, err)
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
 	tcs0 := []struct {
@@ -668,7 +694,7 @@ func TestActivateModelAndDeactivateModel(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
 	const modelID = "r/m0"
@@ -689,9 +715,9 @@ func TestActivateModelAndDeactivateModel(t *testing.T) {
 	assert.Equal(t, v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE, as.Status)
 
 	// Update the loading status to succeeded.
-	err = st.UpdateBaseModelToLoadingStatus(k)
+	err = st.UpdateBaseModelToLoadingStatus(k, "", time.Time{})
 	assert.NoError(t, err)
-	err = st.UpdateBaseModelToSucceededStatus(k, "", nil, "")
+	err = st.UpdateBaseModelToSucceededStatus(k, "", "", "", nil, "", "", nil)
 	assert.NoError(t, err)
 
 	_, err = srv.ActivateModel(ctx, &v1.ActivateModelRequest{
@@ -713,11 +739,213 @@ func TestActivateModelAndDeactivateModel(t *testing.T) {
 	assert.Equal(t, v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE, as.Status)
 }
 
+func TestRetryModelLoad(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	const modelID = "repo/m0"
+	_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               modelID,
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+
+	// The model has not failed yet.
+	_, err = srv.RetryModelLoad(ctx, &v1.RetryModelLoadRequest{Id: modelID})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+	assert.NoError(t, err)
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id: modelID,
+		LoadingResult: &v1.UpdateBaseModelLoadingStatusRequest_Failure_{
+			Failure: &v1.UpdateBaseModelLoadingStatusRequest_Failure{
+				Reason:    "error",
+				Retryable: true,
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	k := store.ModelKey{
+		ModelID:  modelID,
+		TenantID: defaultTenantID,
+	}
+	bm, err := st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED, bm.LoadingStatus)
+	assert.Equal(t, 1, bm.LoadingAttemptCount)
+	assert.False(t, bm.NextLoadingAttemptAt.IsZero())
+
+	_, err = srv.RetryModelLoad(ctx, &v1.RetryModelLoadRequest{Id: modelID})
+	assert.NoError(t, err)
+
+	bm, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, bm.LoadingStatus)
+	assert.Equal(t, 0, bm.LoadingAttemptCount)
+	assert.Empty(t, bm.LoadingFailureReason)
+
+	_, err = srv.RetryModelLoad(ctx, &v1.RetryModelLoadRequest{Id: "unknown"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCancelModelLoad(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	const (
+		modelID  = "repo/m0"
+		loaderID = "loader0"
+	)
+	k := store.ModelKey{
+		ModelID:  modelID,
+		TenantID: defaultTenantID,
+	}
+	_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               modelID,
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+
+	// The model that has not been acquired is cancelled right away.
+	_, err = srv.CancelModelLoad(ctx, &v1.CancelModelLoadRequest{Id: modelID})
+	assert.NoError(t, err)
+	bm, err := st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED, bm.LoadingStatus)
+
+	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{LoaderId: loaderID})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.BaseModelId)
+
+	// Retry and cancel the model while it is being loaded.
+	_, err = srv.RetryModelLoad(ctx, &v1.RetryModelLoadRequest{Id: modelID})
+	assert.NoError(t, err)
+	resp, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{LoaderId: loaderID})
+	assert.NoError(t, err)
+	assert.Equal(t, modelID, resp.BaseModelId)
+
+	uresp, err := wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id:            modelID,
+		LoaderId:      loaderID,
+		StatusMessage: "downloading",
+	})
+	assert.NoError(t, err)
+	assert.False(t, uresp.Cancelled)
+
+	_, err = srv.CancelModelLoad(ctx, &v1.CancelModelLoadRequest{Id: modelID})
+	assert.NoError(t, err)
+	bm, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING, bm.LoadingStatus)
+
+	// Cancelling again is a no-op.
+	_, err = srv.CancelModelLoad(ctx, &v1.CancelModelLoadRequest{Id: modelID})
+	assert.NoError(t, err)
+
+	// The loader observes the cancellation with the status update.
+	uresp, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id:            modelID,
+		LoaderId:      loaderID,
+		StatusMessage: "downloading",
+	})
+	assert.NoError(t, err)
+	assert.True(t, uresp.Cancelled)
+
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id:            modelID,
+		LoaderId:      loaderID,
+		LoadingResult: &v1.UpdateBaseModelLoadingStatusRequest_Cancelled_{},
+	})
+	assert.NoError(t, err)
+	bm, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED, bm.LoadingStatus)
+	assert.Empty(t, bm.LoadingLeaseHolder)
+
+	m, err := srv.GetModel(ctx, &v1.GetModelRequest{Id: modelID, IncludeLoadingModel: true})
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED, m.LoadingStatus)
+
+	_, err = srv.CancelModelLoad(ctx, &v1.CancelModelLoadRequest{Id: "unknown"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCancelModelLoad_FailureWhileCancelling(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	const modelID = "repo/m0"
+	_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               modelID,
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+	_, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+	assert.NoError(t, err)
+
+	_, err = srv.CancelModelLoad(ctx, &v1.CancelModelLoadRequest{Id: modelID})
+	assert.NoError(t, err)
+
+	// A failure reported while the model is being cancelled completes the cancellation.
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id: modelID,
+		LoadingResult: &v1.UpdateBaseModelLoadingStatusRequest_Failure_{
+			Failure: &v1.UpdateBaseModelLoadingStatusRequest_Failure{
+				Reason: "context canceled",
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	bm, err := st.GetBaseModel(store.ModelKey{
+		ModelID:  modelID,
+		TenantID: defaultTenantID,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED, bm.LoadingStatus)
+	assert.True(t, bm.NextLoadingAttemptAt.IsZero())
+
+	// A model that has been loaded cannot be cancelled.
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "repo/m1",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+	_, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+	assert.NoError(t, err)
+	_, err = wsrv.CreateBaseModel(ctx, &v1.CreateBaseModelRequest{
+		Id:            "repo/m1",
+		Path:          "path",
+		GgufModelPath: "gguf",
+	})
+	assert.NoError(t, err)
+	_, err = srv.CancelModelLoad(ctx, &v1.CancelModelLoadRequest{Id: "repo/m1"})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
 func TestModelConfig_BaseModel(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
 	const modelID = "r/m0"
@@ -758,8 +986,8 @@ func TestModelConfig_FineTunedModel(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	ctx := fakeAuthInto(context.Background())
 
@@ -815,7 +1043,7 @@ func TestUpdateModel(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
 	const modelID = "r/m0"
@@ -948,6 +1176,36 @@ func TestValidateIdAndSourceRepository(t *testing.T) {
 			sourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA,
 			wantErr:          true,
 		},
+		{
+			name:             "valid oci reference with tag",
+			id:               "registry.example.com:5000/org/models/llama-3.1_8b:v1.0",
+			sourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_OCI,
+			wantErr:          false,
+		},
+		{
+			name:             "valid oci reference with digest",
+			id:               "ghcr.io/org/llama@sha256:" + strings.Repeat("a", 64),
+			sourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_OCI,
+			wantErr:          false,
+		},
+		{
+			name:             "invalid oci reference without tag",
+			id:               "ghcr.io/org/llama",
+			sourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_OCI,
+			wantErr:          true,
+		},
+		{
+			name:             "invalid oci reference without registry",
+			id:               "llama:v1",
+			sourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_OCI,
+			wantErr:          true,
+		},
+		{
+			name:             "invalid oci reference with uppercase repository",
+			id:               "ghcr.io/org/Llama:v1",
+			sourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_OCI,
+			wantErr:          true,
+		},
 	}
 
 	for _, tc := range tcs {
@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	v1 "github.com/llmariner/model-manager/api/v1"
+	mid "github.com/llmariner/model-manager/common/pkg/id"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// enqueueObjectDeletionInTransaction creates tasks to delete the objects of a deleted model at the given paths
+// unless the objects are still referenced by another model. The model row must have been deleted in the transaction.
+// The tasks are executed by the loaders of the cluster that loaded the model.
+func enqueueObjectDeletionInTransaction(tx *gorm.DB, k store.ModelKey, clusterID string, paths ...string) error {
+	// Check the models of all tenants as the paths of base models are not qualified with tenant IDs.
+	modelPaths, err := store.ListModelPathsInTransaction(tx, "")
+	if err != nil {
+		return err
+	}
+	pending, err := store.ListPendingBaseModelsInTransaction(tx, k.TenantID)
+	if err != nil {
+		return err
+	}
+	if isPendingLoad(k, pending) {
+		// The objects will be overwritten by the model being loaded.
+		return nil
+	}
+
+	var enqueued []string
+	for _, path := range paths {
+		if path == "" {
+			// The model has not been loaded.
+			continue
+		}
+		if isPathReferenced(path, modelPaths) || isPathReferenced(path, enqueued) {
+			continue
+		}
+		if err := store.CreateObjectDeletionTaskInTransaction(tx, &store.ObjectDeletionTask{
+			TenantID:  k.TenantID,
+			ModelID:   k.ModelID,
+			ProjectID: k.ProjectID,
+			Path:      path,
+			ClusterID: clusterID,
+		}); err != nil {
+			return err
+		}
+		enqueued = append(enqueued, path)
+	}
+	return nil
+}
+
+// enqueueUploadDeletionInTransaction creates a task to delete the objects uploaded by a loading that was abandoned
+// while being cancelled. Unlike the objects of a deleted model, only the objects recorded in the upload manifest
+// at the path are deleted, which is the same as what the loader does when it stops loading the model.
+func enqueueUploadDeletionInTransaction(tx *gorm.DB, k store.ModelKey, clusterID, path string) error {
+	if path == "" {
+		// The loader has not reported the path.
+		return nil
+	}
+	return store.CreateObjectDeletionTaskInTransaction(tx, &store.ObjectDeletionTask{
+		TenantID:    k.TenantID,
+		ModelID:     k.ModelID,
+		ProjectID:   k.ProjectID,
+		Path:        path,
+		ClusterID:   clusterID,
+		UploadsOnly: true,
+	})
+}
+
+// isPathReferenced returns true if any of the model paths is the same as the given path or
+// one contains the other.
+func isPathReferenced(path string, modelPaths []string) bool {
+	for _, p := range modelPaths {
+		if p == path || strings.HasPrefix(p, path+"/") || strings.HasPrefix(path, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// isPendingLoad returns true if any of the pending base models will be uploaded to the path of the deleted model
+// of the given key. The path of a pending model is not known until it is loaded, but it is the same as the one of
+// the deleted model when the model is recreated under the same ID.
+func isPendingLoad(k store.ModelKey, pending []*store.BaseModel) bool {
+	for _, bm := range pending {
+		if bm.TenantID == k.TenantID && bm.ProjectID == k.ProjectID && isDerivedModelID(k.ModelID, bm.ModelID) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPendingModelLoad returns true if the fine-tuned model of the given key has been requested to load again.
+func isPendingModelLoad(k store.ModelKey, pending []*store.Model) bool {
+	for _, m := range pending {
+		if m.TenantID == k.TenantID && m.ModelID == k.ModelID {
+			return true
+		}
+	}
+	return false
+}
+
+// isDerivedModelID returns true if the model of the given ID is created by loading the requested model.
+// A loader converts the requested ID (e.g., "/" to "-") and creates a model for each file of some repositories.
+func isDerivedModelID(modelID, requestedID string) bool {
+	convertedID := mid.ToLLMarinerModelID(requestedID)
+	return modelID == requestedID || modelID == convertedID || strings.HasPrefix(modelID, convertedID+"-")
+}
+
+// AcquireObjectDeletionTask acquires a task to delete the objects of a deleted model.
+func (s *WS) AcquireObjectDeletionTask(
+	ctx context.Context,
+	req *v1.AcquireObjectDeletionTaskRequest,
+) (*v1.AcquireObjectDeletionTaskResponse, error) {
+	clusterInfo, err := s.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	ts, err := s.store.ListAcquirableObjectDeletionTasks(clusterInfo.TenantID, clusterInfo.ClusterID, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list object deletion tasks: %s", err)
+	}
+	if len(ts) == 0 {
+		return &v1.AcquireObjectDeletionTaskResponse{}, nil
+	}
+
+	// Check the references again as a new model might have been loaded or requested to load to the same path
+	// after the task was created.
+	paths, err := s.store.ListModelPaths("")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list model paths: %s", err)
+	}
+	pending, err := s.store.ListPendingBaseModels(clusterInfo.TenantID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list pending base models: %s", err)
+	}
+	pendingModels, err := s.store.ListPendingModels(clusterInfo.TenantID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list pending models: %s", err)
+	}
+
+	for _, t := range ts {
+		k := store.ModelKey{ModelID: t.ModelID, ProjectID: t.ProjectID, TenantID: t.TenantID}
+		// The uploads of an abandoned loading are resumed if the model is requested to load again.
+		if (!t.UploadsOnly && isPathReferenced(t.Path, paths)) || isPendingLoad(k, pending) || isPendingModelLoad(k, pendingModels) {
+			s.log.Info("Dropping object deletion task as the path is referenced", "modelID", t.ModelID, "path", t.Path)
+			if err := s.store.DeleteObjectDeletionTask(t.ID); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.Internal, "delete object deletion task: %s", err)
+			}
+			continue
+		}
+
+		if err := s.store.AcquireObjectDeletionTask(t.ID, leaseHolder(clusterInfo, req.LoaderId), now, s.leaseExpiresAt()); err != nil {
+			if errors.Is(err, store.ErrConcurrentUpdate) {
+				// The task has been acquired by another loader.
+				continue
+			}
+			return nil, status.Errorf(codes.Internal, "acquire object deletion task: %s", err)
+		}
+
+		return &v1.AcquireObjectDeletionTaskResponse{
+			TaskId:      int64(t.ID),
+			Path:        t.Path,
+			UploadsOnly: t.UploadsOnly,
+		}, nil
+	}
+
+	return &v1.AcquireObjectDeletionTaskResponse{}, nil
+}
+
+// CompleteObjectDeletionTask deletes the task whose objects have been deleted.
+func (s *WS) CompleteObjectDeletionTask(
+	ctx context.Context,
+	req *v1.CompleteObjectDeletionTaskRequest,
+) (*v1.CompleteObjectDeletionTaskResponse, error) {
+	clusterInfo, err := s.extractClusterInfoFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TaskId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "task_id is required")
+	}
+
+	if err := s.store.DeleteObjectDeletionTaskByLeaseHolder(uint(req.TaskId), clusterInfo.TenantID, leaseHolder(clusterInfo, req.LoaderId)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Errorf(codes.NotFound, "object deletion task %d not found or not leased by the loader", req.TaskId)
+		}
+		return nil, status.Errorf(codes.Internal, "delete object deletion task: %s", err)
+	}
+
+	return &v1.CompleteObjectDeletionTaskResponse{}, nil
+}
+
+// ListModelPaths lists the object store paths of the models of all tenants.
+func (s *WS) ListModelPaths(
+	ctx context.Context,
+	req *v1.ListModelPathsRequest,
+) (*v1.ListModelPathsResponse, error) {
+	if _, err := s.extractClusterInfoFromContext(ctx); err != nil {
+		return nil, err
+	}
+
+	// The object store path prefix is shared by tenants, and the paths of base models are not qualified
+	// with tenant IDs. List the paths of all tenants so that the objects of other tenants are not reported
+	// as orphans.
+	paths, err := s.store.ListModelPaths("")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list model paths: %s", err)
+	}
+
+	return &v1.ListModelPathsResponse{
+		Paths: paths,
+	}, nil
+}
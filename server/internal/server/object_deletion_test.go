@@ -0,0 +1,345 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestIsPathReferenced(t *testing.T) {
+	tcs := []struct {
+		name       string
+		path       string
+		modelPaths []string
+		want       bool
+	}{
+		{
+			name: "no model",
+			path: "models/m0",
+			want: false,
+		},
+		{
+			name:       "same path",
+			path:       "models/m0",
+			modelPaths: []string{"models/m0"},
+			want:       true,
+		},
+		{
+			name:       "nested path",
+			path:       "models/m0",
+			modelPaths: []string{"models/m0/model.gguf"},
+			want:       true,
+		},
+		{
+			name:       "parent path",
+			path:       "models/m0/model.gguf",
+			modelPaths: []string{"models/m0"},
+			want:       true,
+		},
+		{
+			name:       "common prefix",
+			path:       "models/m0",
+			modelPaths: []string{"models/m01", "models/m"},
+			want:       false,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, isPathReferenced(tc.path, tc.modelPaths))
+		})
+	}
+}
+
+func TestObjectDeletion(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	const pathPrefix = "models/base-models/global/r0/m0"
+	for _, k := range []store.ModelKey{
+		{ModelID: "r0/m0-q4", TenantID: defaultTenantID},
+		{ModelID: "r0/m0-q8", TenantID: defaultTenantID},
+		// A base model of another tenant that shares the objects.
+		{ModelID: "r0/m0-q4", TenantID: "t1"},
+	} {
+		// The GGUF file of a per-file base model is not stored under the model path.
+		filename := strings.TrimPrefix(k.ModelID, "r0/")
+		_, err := st.CreateBaseModel(
+			k,
+			pathPrefix+"/"+filename,
+			[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+			pathPrefix+"/"+filename+".gguf",
+			v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+			"",
+			"",
+			nil,
+		)
+		assert.NoError(t, err)
+	}
+	// A base model that only another tenant has.
+	_, err := st.CreateBaseModel(
+		store.ModelKey{ModelID: "r1/m2", TenantID: "t2"},
+		"models/base-models/global/r1/m2",
+		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+		"",
+		v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		"",
+		"",
+		nil,
+	)
+	assert.NoError(t, err)
+	_, err = st.CreateModel(store.ModelSpec{
+		ModelID:        "ft:m1",
+		OrganizationID: "o0",
+		ProjectID:      defaultProjectID,
+		TenantID:       defaultTenantID,
+		Path:           "models/default-tenant-id/default-project-id/ft:m1",
+		IsPublished:    true,
+		LoadingStatus:  v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
+	})
+	assert.NoError(t, err)
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	presp, err := wsrv.ListModelPaths(ctx, &v1.ListModelPathsRequest{})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		pathPrefix + "/m0-q4",
+		pathPrefix + "/m0-q4.gguf",
+		pathPrefix + "/m0-q8",
+		pathPrefix + "/m0-q8.gguf",
+		"models/default-tenant-id/default-project-id/ft:m1",
+		// The paths of other tenants are listed as the object store is shared.
+		"models/base-models/global/r1/m2",
+	}, presp.Paths)
+
+	// The objects are not deleted while the base model of another tenant references them.
+	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{Id: "r0/m0-q4"})
+	assert.NoError(t, err)
+	aresp, err := wsrv.AcquireObjectDeletionTask(ctx, &v1.AcquireObjectDeletionTaskRequest{LoaderId: "l0"})
+	assert.NoError(t, err)
+	assert.Zero(t, aresp.TaskId)
+
+	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{Id: "r0/m0-q8"})
+	assert.NoError(t, err)
+	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{Id: "ft:m1"})
+	assert.NoError(t, err)
+
+	var taskIDs []int64
+	var paths []string
+	for i := 0; i < 3; i++ {
+		aresp, err = wsrv.AcquireObjectDeletionTask(ctx, &v1.AcquireObjectDeletionTaskRequest{LoaderId: "l0"})
+		assert.NoError(t, err)
+		assert.NotZero(t, aresp.TaskId)
+		taskIDs = append(taskIDs, aresp.TaskId)
+		paths = append(paths, aresp.Path)
+	}
+	assert.Equal(t, []string{
+		pathPrefix + "/m0-q8",
+		pathPrefix + "/m0-q8.gguf",
+		"models/default-tenant-id/default-project-id/ft:m1",
+	}, paths)
+
+	// All tasks have been leased.
+	resp, err := wsrv.AcquireObjectDeletionTask(ctx, &v1.AcquireObjectDeletionTaskRequest{LoaderId: "l1"})
+	assert.NoError(t, err)
+	assert.Zero(t, resp.TaskId)
+
+	// Only the lease holder can complete the task.
+	_, err = wsrv.CompleteObjectDeletionTask(ctx, &v1.CompleteObjectDeletionTaskRequest{TaskId: taskIDs[0], LoaderId: "l1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	for _, id := range taskIDs {
+		_, err = wsrv.CompleteObjectDeletionTask(ctx, &v1.CompleteObjectDeletionTaskRequest{TaskId: id, LoaderId: "l0"})
+		assert.NoError(t, err)
+	}
+
+	presp, err = wsrv.ListModelPaths(ctx, &v1.ListModelPathsRequest{})
+	assert.NoError(t, err)
+	// The paths of the models of other tenants remain.
+	assert.ElementsMatch(t, []string{
+		pathPrefix + "/m0-q4",
+		pathPrefix + "/m0-q4.gguf",
+		"models/base-models/global/r1/m2",
+	}, presp.Paths)
+}
+
+func TestObjectDeletion_OtherCluster(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	_, err := st.CreateModel(store.ModelSpec{
+		ModelID:         "ft:m0",
+		OrganizationID:  "o0",
+		ProjectID:       defaultProjectID,
+		TenantID:        defaultTenantID,
+		Path:            "models/default-tenant-id/default-project-id/ft:m0",
+		IsPublished:     true,
+		LoadingStatus:   v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
+		LoadedClusterID: "c1",
+	})
+	assert.NoError(t, err)
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv.enableAuth = true
+	ctx := fakeAuthInto(context.Background())
+
+	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{Id: "ft:m0"})
+	assert.NoError(t, err)
+
+	// The task is only acquired by the cluster that loaded the model.
+	cctx := auth.AppendClusterInfoToContext(ctx, auth.ClusterInfo{ClusterID: "c0", TenantID: defaultTenantID})
+	resp, err := wsrv.AcquireObjectDeletionTask(cctx, &v1.AcquireObjectDeletionTaskRequest{LoaderId: "l0"})
+	assert.NoError(t, err)
+	assert.Zero(t, resp.TaskId)
+
+	cctx = auth.AppendClusterInfoToContext(ctx, auth.ClusterInfo{ClusterID: "c1", TenantID: defaultTenantID})
+	resp, err = wsrv.AcquireObjectDeletionTask(cctx, &v1.AcquireObjectDeletionTaskRequest{LoaderId: "l0"})
+	assert.NoError(t, err)
+	assert.NotZero(t, resp.TaskId)
+	assert.Equal(t, "models/default-tenant-id/default-project-id/ft:m0", resp.Path)
+}
+
+func TestObjectDeletion_PathReferencedAgain(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	k := store.ModelKey{ModelID: "m0", TenantID: defaultTenantID}
+	_, err := st.CreateBaseModel(k, "models/m0", nil, "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", "", nil)
+	assert.NoError(t, err)
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{Id: "m0"})
+	assert.NoError(t, err)
+
+	// The model is loaded again before the objects are deleted.
+	_, err = st.CreateBaseModel(k, "models/m0", nil, "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", "", nil)
+	assert.NoError(t, err)
+
+	resp, err := wsrv.AcquireObjectDeletionTask(ctx, &v1.AcquireObjectDeletionTaskRequest{LoaderId: "l0"})
+	assert.NoError(t, err)
+	assert.Zero(t, resp.TaskId)
+
+	ts, err := st.ListAcquirableObjectDeletionTasks(defaultTenantID, defaultClusterID, time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, ts)
+}
+
+func TestObjectDeletion_ModelRecreated(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	createAndDelete := func() {
+		// The model loaded from "r0/m0" is registered under the converted ID.
+		_, err := st.CreateBaseModel(store.ModelKey{ModelID: "r0-m0", TenantID: defaultTenantID}, "models/r0/m0", nil, "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", "", nil)
+		assert.NoError(t, err)
+		_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{Id: "r0-m0"})
+		assert.NoError(t, err)
+	}
+
+	// The pending task is dropped as the recreated model will be uploaded to the same path.
+	createAndDelete()
+	_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "r0/m0",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+	resp, err := wsrv.AcquireObjectDeletionTask(ctx, &v1.AcquireObjectDeletionTaskRequest{LoaderId: "l0"})
+	assert.NoError(t, err)
+	assert.Zero(t, resp.TaskId)
+	ts, err := st.ListAcquirableObjectDeletionTasks(defaultTenantID, defaultClusterID, time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, ts)
+
+	// No task is created while the model is being loaded.
+	createAndDelete()
+	ts, err = st.ListAcquirableObjectDeletionTasks(defaultTenantID, defaultClusterID, time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, ts)
+
+	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{Id: "r0/m0"})
+	assert.NoError(t, err)
+	createAndDelete()
+
+	// The recreated model is not loaded while the objects are being deleted.
+	resp, err = wsrv.AcquireObjectDeletionTask(ctx, &v1.AcquireObjectDeletionTaskRequest{LoaderId: "l0"})
+	assert.NoError(t, err)
+	assert.NotZero(t, resp.TaskId)
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "r0/m0",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+	mresp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{LoaderId: "l1"})
+	assert.NoError(t, err)
+	assert.Empty(t, mresp.BaseModelId)
+
+	_, err = wsrv.CompleteObjectDeletionTask(ctx, &v1.CompleteObjectDeletionTaskRequest{TaskId: resp.TaskId, LoaderId: "l0"})
+	assert.NoError(t, err)
+	mresp, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{LoaderId: "l1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "r0/m0", mresp.BaseModelId)
+}
+
+func TestObjectDeletion_UploadsOnly(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	_, err := st.CreateModel(store.ModelSpec{
+		ModelID:       "ft:m0",
+		TenantID:      defaultTenantID,
+		Path:          "models/default-tenant-id/default-project-id/ft:m0",
+		LoadingStatus: v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED,
+	})
+	assert.NoError(t, err)
+
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	k := store.ModelKey{ModelID: "ft:m0", TenantID: defaultTenantID}
+	enqueue := func() {
+		err := st.Transaction(func(tx *gorm.DB) error {
+			return enqueueUploadDeletionInTransaction(tx, k, defaultClusterID, "models/default-tenant-id/default-project-id/ft:m0")
+		})
+		assert.NoError(t, err)
+	}
+
+	// The task is acquired although the model still references the path.
+	enqueue()
+	resp, err := wsrv.AcquireObjectDeletionTask(ctx, &v1.AcquireObjectDeletionTaskRequest{LoaderId: "l0"})
+	assert.NoError(t, err)
+	assert.NotZero(t, resp.TaskId)
+	assert.Equal(t, "models/default-tenant-id/default-project-id/ft:m0", resp.Path)
+	assert.True(t, resp.UploadsOnly)
+	_, err = wsrv.CompleteObjectDeletionTask(ctx, &v1.CompleteObjectDeletionTaskRequest{TaskId: resp.TaskId, LoaderId: "l0"})
+	assert.NoError(t, err)
+
+	// The task is dropped if the model is requested to load again as the loading resumes the uploads.
+	enqueue()
+	err = st.UpdateModelToRetryRequestedStatus("ft:m0", defaultTenantID)
+	assert.NoError(t, err)
+	resp, err = wsrv.AcquireObjectDeletionTask(ctx, &v1.AcquireObjectDeletionTaskRequest{LoaderId: "l0"})
+	assert.NoError(t, err)
+	assert.Zero(t, resp.TaskId)
+	ts, err := st.ListAcquirableObjectDeletionTasks(defaultTenantID, defaultClusterID, time.Now())
+	assert.NoError(t, err)
+	assert.Empty(t, ts)
+}
@@ -12,9 +12,11 @@ import (
 	"github.com/llmariner/model-manager/server/internal/store"
 	"github.com/llmariner/rbac-manager/pkg/auth"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -30,11 +32,19 @@ type pcache interface {
 }
 
 // New creates a server.
-func New(store *store.S, pcache pcache, log logr.Logger) *S {
+func New(
+	store *store.S,
+	pcache pcache,
+	modelLoadingConfig config.ModelLoadingConfig,
+	modelWatchConfig config.ModelWatchConfig,
+	log logr.Logger,
+) *S {
 	return &S{
-		store:  store,
-		pcache: pcache,
-		log:    log.WithName("grpc"),
+		store:              store,
+		pcache:             pcache,
+		modelLoadingConfig: modelLoadingConfig,
+		modelWatchConfig:   modelWatchConfig,
+		log:                log.WithName("grpc"),
 	}
 }
 
@@ -47,13 +57,16 @@ type S struct {
 	store  *store.S
 	pcache pcache
 	log    logr.Logger
+
+	modelLoadingConfig config.ModelLoadingConfig
+	modelWatchConfig   config.ModelWatchConfig
 }
 
 // Run starts the gRPC server.
 func (s *S) Run(ctx context.Context, port int, authConfig config.AuthConfig, usage sender.UsageSetter) error {
 	s.log.Info("Starting gRPC server...", "port", port)
 
-	var opt grpc.ServerOption
+	var opts []grpc.ServerOption
 	if authConfig.Enable {
 		ai, err := auth.NewInterceptor(ctx, auth.Config{
 			RBACServerAddr: authConfig.RBACInternalServerAddr,
@@ -62,15 +75,21 @@ func (s *S) Run(ctx context.Context, port int, authConfig config.AuthConfig, usa
 		if err != nil {
 			return err
 		}
-		opt = grpc.ChainUnaryInterceptor(ai.Unary("/grpc.health.v1.Health/Check"), sender.Unary(usage))
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(ai.Unary("/grpc.health.v1.Health/Check"), sender.Unary(usage)),
+			grpc.ChainStreamInterceptor(streamAuthInterceptor(ai.Unary())),
+		)
 	} else {
 		fakeAuth := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 			return handler(fakeAuthInto(ctx), req)
 		}
-		opt = grpc.ChainUnaryInterceptor(fakeAuth, sender.Unary(usage))
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(fakeAuth, sender.Unary(usage)),
+			grpc.ChainStreamInterceptor(streamAuthInterceptor(fakeAuth)),
+		)
 	}
 
-	grpcServer := grpc.NewServer(opt)
+	grpcServer := grpc.NewServer(opts...)
 	v1.RegisterModelsServiceServer(grpcServer, s)
 	reflection.Register(grpcServer)
 
@@ -100,6 +119,45 @@ func (s *S) GracefulStop() {
 	s.srv.GracefulStop()
 }
 
+// streamAuthMethods maps the full methods of streams to the unary methods whose authorization they share.
+// The auth package decides the required capability from the name of the method and requires the write
+// capability for methods other than Get and List ones.
+var streamAuthMethods = map[string]string{
+	// Watching models only reads them and requires the same capability as listing them.
+	"/llmariner.models.server.v1.ModelsService/WatchModels": "/llmariner.models.server.v1.ModelsService/ListModels",
+}
+
+// streamAuthInterceptor returns a stream server interceptor that authorizes streams with the given
+// unary interceptor as the auth package only provides a unary one for users. Streams that are not in
+// streamAuthMethods are rejected.
+func streamAuthInterceptor(unary grpc.UnaryServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		fullMethod, ok := streamAuthMethods[info.FullMethod]
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "no authorization is defined for stream %q", info.FullMethod)
+		}
+		uinfo := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: fullMethod,
+		}
+		_, err := unary(ss.Context(), nil, uinfo, func(ctx context.Context, req any) (any, error) {
+			return nil, handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		})
+		return err
+	}
+}
+
+// serverStream is a server stream with an authorized context.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream.
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
 // fakeAuthInto sets dummy user info and token into the context.
 func fakeAuthInto(ctx context.Context) context.Context {
 	return auth.AppendUserInfoToContext(ctx, auth.UserInfo{
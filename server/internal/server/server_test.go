@@ -0,0 +1,42 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	type ctxKey struct{}
+
+	var gotMethod string
+	unary := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		gotMethod = info.FullMethod
+		return handler(context.WithValue(ctx, ctxKey{}, "authorized"), req)
+	}
+
+	var gotValue any
+	handler := func(srv any, ss grpc.ServerStream) error {
+		gotValue = ss.Context().Value(ctxKey{})
+		return nil
+	}
+
+	i := streamAuthInterceptor(unary)
+	ss := &fakeWatchModelsServer{ctx: context.Background()}
+	err := i(nil, ss, &grpc.StreamServerInfo{FullMethod: "/llmariner.models.server.v1.ModelsService/WatchModels"}, handler)
+	assert.NoError(t, err)
+	// Watching models is authorized as listing them.
+	assert.Equal(t, "/llmariner.models.server.v1.ModelsService/ListModels", gotMethod)
+	assert.Equal(t, "authorized", gotValue)
+
+	// Streams without authorization mapping are rejected.
+	gotMethod = ""
+	err = i(nil, ss, &grpc.StreamServerInfo{FullMethod: "/llmariner.models.server.v1.ModelsService/WatchOthers"}, handler)
+	assert.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, gotMethod)
+}
@@ -0,0 +1,265 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/config"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// watchModelsBatchSize is the maximum number of events read from the store at once.
+const watchModelsBatchSize = 100
+
+// WatchModels streams the events of the models that are visible to the caller.
+func (s *S) WatchModels(req *v1.WatchModelsRequest, stream v1.ModelsService_WatchModelsServer) error {
+	userInfo, ok := auth.ExtractUserInfoFromContext(stream.Context())
+	if !ok {
+		return fmt.Errorf("failed to extract user info from context")
+	}
+
+	toModel := func(e *store.ModelEvent) (*v1.Model, bool, error) {
+		return visibleModelOfEvent(s.store, s.pcache, e, userInfo, req.IncludeLoadingModels)
+	}
+	return watchModelEvents(stream.Context(), s.store, userInfo.TenantID, req.AfterRevision, s.modelWatchConfig, toModel, stream.Send)
+}
+
+// WatchModels streams the events of the loaded models in the cluster's tenant.
+func (s *WS) WatchModels(req *v1.WatchModelsRequest, stream v1.ModelsWorkerService_WatchModelsServer) error {
+	clusterInfo, err := s.extractClusterInfoFromContext(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	// Workers see the loaded models of all projects in the tenant. This is consistent with ListModels.
+	toModel := func(e *store.ModelEvent) (*v1.Model, bool, error) {
+		userInfo := &auth.UserInfo{
+			TenantID:  clusterInfo.TenantID,
+			ProjectID: e.ProjectID,
+		}
+		return visibleModelOfEvent(s.store, s.pcache, e, userInfo, false /* includeLoadingModels */)
+	}
+	return watchModelEvents(stream.Context(), s.store, clusterInfo.TenantID, req.AfterRevision, s.modelWatchConfig, toModel, stream.Send)
+}
+
+// watchModelEvents sends the events of the tenant whose revisions are greater than the given one
+// until the context is done. New events are found by polling the store so that events recorded
+// by other server replicas are also sent.
+//
+// Revisions are allocated when events are created, but events become visible when their transactions
+// are committed, so an event can become visible after the ones with greater revisions. The events
+// created within the commit lag are read again so that such events are not missed, and the events that
+// have already been read are skipped.
+//
+// toModel returns the model of the event, or false if the event should not be sent.
+func watchModelEvents(
+	ctx context.Context,
+	st *store.S,
+	tenantID string,
+	afterRevision int64,
+	cfg config.ModelWatchConfig,
+	toModel func(e *store.ModelEvent) (*v1.Model, bool, error),
+	send func(e *v1.ModelEvent) error,
+) error {
+	if afterRevision < 0 {
+		return status.Error(codes.InvalidArgument, "after_revision must not be negative")
+	}
+
+	// settled is the revision up to which all events are visible.
+	settled := uint(afterRevision)
+	if settled == 0 {
+		latest, err := st.GetLatestModelEventRevision()
+		if err != nil {
+			return status.Errorf(codes.Internal, "get latest model event revision: %s", err)
+		}
+		settled = latest
+	} else {
+		oldest, err := st.GetOldestModelEventRevision()
+		if err != nil {
+			return status.Errorf(codes.Internal, "get oldest model event revision: %s", err)
+		}
+		if oldest > settled+1 {
+			return status.Errorf(codes.OutOfRange, "events after revision %d have been pruned", settled)
+		}
+
+		// The previous watch might have missed the events committed late. Read the recent events again.
+		first, err := st.GetFirstModelEventRevisionCreatedAfter(time.Now().Add(-cfg.CommitLag))
+		if err != nil {
+			return status.Errorf(codes.Internal, "get first model event revision: %s", err)
+		}
+		if first > 0 && first <= settled {
+			settled = first - 1
+		}
+	}
+
+	// read is the revisions of the events that have been read after the settled revision.
+	read := map[uint]bool{}
+
+	ticker := time.NewTicker(cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		horizon := time.Now().Add(-cfg.CommitLag)
+		rev := settled
+		for {
+			es, err := st.ListModelEventsAfterRevision(tenantID, rev, watchModelsBatchSize)
+			if err != nil {
+				return status.Errorf(codes.Internal, "list model events: %s", err)
+			}
+			for _, e := range es {
+				rev = e.ID
+				if e.CreatedAt.Before(horizon) {
+					// The transactions of the events created before the horizon have been committed.
+					settled = e.ID
+				}
+				if read[e.ID] {
+					continue
+				}
+				read[e.ID] = true
+
+				m, ok, err := toModel(e)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+				if err := send(&v1.ModelEvent{
+					Revision: int64(e.ID),
+					Type:     e.Type,
+					Model:    m,
+					Created:  e.CreatedAt.UTC().Unix(),
+				}); err != nil {
+					return err
+				}
+			}
+			if len(es) < watchModelsBatchSize {
+				break
+			}
+			// Read the remaining events without waiting.
+		}
+
+		for id := range read {
+			if id <= settled {
+				delete(read, id)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// visibleModelOfEvent returns the current model of the event if the model is visible to the user.
+// The visibility follows getVisibleModel.
+func visibleModelOfEvent(
+	st *store.S,
+	pcache pcache,
+	e *store.ModelEvent,
+	userInfo *auth.UserInfo,
+	includeLoadingModels bool,
+) (*v1.Model, bool, error) {
+	if e.TenantID != userInfo.TenantID {
+		return nil, false, nil
+	}
+	// Project-scoped base models are only visible in their projects.
+	if e.IsBaseModel && e.ProjectID != "" && e.ProjectID != userInfo.ProjectID {
+		return nil, false, nil
+	}
+
+	if e.Type == v1.ModelEventType_MODEL_EVENT_TYPE_DELETED {
+		return &v1.Model{
+			Id:          e.ModelID,
+			Object:      "model",
+			IsBaseModel: e.IsBaseModel,
+		}, true, nil
+	}
+
+	// Degraded models are no longer loaded, but the event is sent so that the model can be unloaded.
+	if e.Type == v1.ModelEventType_MODEL_EVENT_TYPE_DEGRADED {
+		includeLoadingModels = true
+	}
+
+	if e.IsBaseModel {
+		bm, err := st.GetBaseModel(store.ModelKey{
+			ModelID:   e.ModelID,
+			ProjectID: e.ProjectID,
+			TenantID:  e.TenantID,
+		})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				// The model has been deleted since the event.
+				return nil, false, nil
+			}
+			return nil, false, status.Errorf(codes.Internal, "get base model: %s", err)
+		}
+		if !isBaseModelLoaded(bm) && !includeLoadingModels {
+			return nil, false, nil
+		}
+		m, err := convertBaseModelToProto(st, pcache, bm)
+		if err != nil {
+			return nil, false, err
+		}
+		return m, true, nil
+	}
+
+	fm, err := st.GetPublishedModelByModelIDAndTenantID(e.ModelID, e.TenantID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, status.Errorf(codes.Internal, "get model: %s", err)
+	}
+	if !isModelLoaded(fm) && !includeLoadingModels {
+		return nil, false, nil
+	}
+	m, err := convertFineTunedModelToProto(st, pcache, fm)
+	if err != nil {
+		return nil, false, err
+	}
+	return m, true, nil
+}
+
+func newModelEvent(k store.ModelKey, isBaseModel bool, t v1.ModelEventType) *store.ModelEvent {
+	return &store.ModelEvent{
+		TenantID:    k.TenantID,
+		ModelID:     k.ModelID,
+		ProjectID:   k.ProjectID,
+		IsBaseModel: isBaseModel,
+		Type:        t,
+	}
+}
+
+// recordModelEvent records a model event after the model has been updated. An error is
+// only logged as the update has already been made.
+func recordModelEvent(st *store.S, log logr.Logger, k store.ModelKey, isBaseModel bool, t v1.ModelEventType) {
+	if err := st.CreateModelEvent(newModelEvent(k, isBaseModel, t)); err != nil {
+		log.Error(err, "Failed to record a model event", "modelID", k.ModelID, "type", t)
+	}
+}
+
+// shouldRecordProgressEvent returns true if no loading progress event has been recorded for the model
+// within the progress event interval. Loaders report the progress frequently, and recording every report
+// would flood the watchers. An error is only logged as progress events are informational.
+func (s *WS) shouldRecordProgressEvent(k store.ModelKey, isBaseModel bool) bool {
+	interval := s.modelWatchConfig.ProgressEventInterval
+	if interval == 0 {
+		return true
+	}
+	found, err := s.store.HasModelEventCreatedAfter(k, isBaseModel, v1.ModelEventType_MODEL_EVENT_TYPE_LOADING_PROGRESS, time.Now().Add(-interval))
+	if err != nil {
+		s.log.Error(err, "Failed to find a loading progress event", "modelID", k.ModelID)
+		return false
+	}
+	return !found
+}
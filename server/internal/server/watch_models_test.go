@@ -0,0 +1,315 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/llmariner/model-manager/server/internal/config"
+	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testModelWatchConfig = config.ModelWatchConfig{
+	PollInterval:   10 * time.Millisecond,
+	EventRetention: time.Hour,
+	PruneInterval:  time.Hour,
+}
+
+func TestWatchModels(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	// The first event is recorded for another tenant so that the test can resume after it.
+	err := st.CreateModelEvent(newModelEvent(store.ModelKey{
+		ModelID:  "other",
+		TenantID: "other-tenant",
+	}, true, v1.ModelEventType_MODEL_EVENT_TYPE_CREATED))
+	assert.NoError(t, err)
+
+	for _, k := range []store.ModelKey{
+		{ModelID: "bm0", TenantID: defaultTenantID},
+		{ModelID: "bm1", ProjectID: "other-project", TenantID: defaultTenantID},
+	} {
+		_, err := st.CreateBaseModel(
+			k,
+			"path",
+			[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+			"gguf-path",
+			v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+			"",
+			"",
+			nil,
+		)
+		assert.NoError(t, err)
+		err = st.CreateModelActivationStatus(&store.ModelActivationStatus{
+			ModelID:   k.ModelID,
+			ProjectID: k.ProjectID,
+			TenantID:  k.TenantID,
+			Status:    v1.ActivationStatus_ACTIVATION_STATUS_INACTIVE,
+		})
+		assert.NoError(t, err)
+	}
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	_, err = srv.ActivateModel(ctx, &v1.ActivateModelRequest{Id: "bm0"})
+	assert.NoError(t, err)
+	_, err = srv.DeactivateModel(ctx, &v1.DeactivateModelRequest{Id: "bm0"})
+	assert.NoError(t, err)
+	// The model in another project is not visible to the user.
+	recordModelEvent(st, testr.New(t), store.ModelKey{
+		ModelID:   "bm1",
+		ProjectID: "other-project",
+		TenantID:  defaultTenantID,
+	}, true, v1.ModelEventType_MODEL_EVENT_TYPE_CONFIG_UPDATED)
+	// The model is still being loaded.
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "google/gemma-2b",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "google/gemma-2b-it",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+	_, err = srv.DeleteModel(ctx, &v1.DeleteModelRequest{Id: "google/gemma-2b-it"})
+	assert.NoError(t, err)
+
+	type event struct {
+		typ     v1.ModelEventType
+		modelID string
+	}
+	tcs := []struct {
+		name string
+		req  *v1.WatchModelsRequest
+		want []event
+	}{
+		{
+			name: "loaded models",
+			req: &v1.WatchModelsRequest{
+				AfterRevision: 1,
+			},
+			want: []event{
+				{v1.ModelEventType_MODEL_EVENT_TYPE_ACTIVATED, "bm0"},
+				{v1.ModelEventType_MODEL_EVENT_TYPE_DEACTIVATED, "bm0"},
+				{v1.ModelEventType_MODEL_EVENT_TYPE_DELETED, "google/gemma-2b-it"},
+			},
+		},
+		{
+			name: "include loading models",
+			req: &v1.WatchModelsRequest{
+				AfterRevision:        1,
+				IncludeLoadingModels: true,
+			},
+			want: []event{
+				{v1.ModelEventType_MODEL_EVENT_TYPE_ACTIVATED, "bm0"},
+				{v1.ModelEventType_MODEL_EVENT_TYPE_DEACTIVATED, "bm0"},
+				// The creation of the deleted model is not sent.
+				{v1.ModelEventType_MODEL_EVENT_TYPE_CREATED, "google/gemma-2b"},
+				{v1.ModelEventType_MODEL_EVENT_TYPE_DELETED, "google/gemma-2b-it"},
+			},
+		},
+		{
+			name: "resume",
+			req: &v1.WatchModelsRequest{
+				AfterRevision: 2,
+			},
+			want: []event{
+				{v1.ModelEventType_MODEL_EVENT_TYPE_DEACTIVATED, "bm0"},
+				{v1.ModelEventType_MODEL_EVENT_TYPE_DELETED, "google/gemma-2b-it"},
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := newFakeWatchModelsServer(ctx, len(tc.want))
+			err := srv.WatchModels(tc.req, stream)
+			assert.NoError(t, err)
+
+			var got []event
+			for _, e := range stream.events {
+				got = append(got, event{e.Type, e.Model.Id})
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWatchModels_Worker(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	err := st.CreateModelEvent(newModelEvent(store.ModelKey{
+		ModelID:  "other",
+		TenantID: "other-tenant",
+	}, true, v1.ModelEventType_MODEL_EVENT_TYPE_CREATED))
+	assert.NoError(t, err)
+
+	for _, k := range []store.ModelKey{
+		{ModelID: "bm0", TenantID: defaultTenantID},
+		{ModelID: "bm1", ProjectID: "other-project", TenantID: defaultTenantID},
+	} {
+		_, err := st.CreateBaseModel(
+			k,
+			"path",
+			[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+			"gguf-path",
+			v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+			"",
+			"",
+			nil,
+		)
+		assert.NoError(t, err)
+		recordModelEvent(st, testr.New(t), k, true, v1.ModelEventType_MODEL_EVENT_TYPE_LOADED)
+	}
+	_, err = st.CreateBaseModelWithLoadingRequested(store.ModelKey{
+		ModelID:  "bm2",
+		TenantID: defaultTenantID,
+	}, v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", nil, "", "", 0)
+	assert.NoError(t, err)
+	recordModelEvent(st, testr.New(t), store.ModelKey{
+		ModelID:  "bm2",
+		TenantID: defaultTenantID,
+	}, true, v1.ModelEventType_MODEL_EVENT_TYPE_CREATED)
+	recordModelEvent(st, testr.New(t), store.ModelKey{
+		ModelID:  "bm0",
+		TenantID: defaultTenantID,
+	}, true, v1.ModelEventType_MODEL_EVENT_TYPE_ACTIVATED)
+
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	stream := newFakeWatchModelsServer(context.Background(), 3)
+	err = wsrv.WatchModels(&v1.WatchModelsRequest{AfterRevision: 1}, stream)
+	assert.NoError(t, err)
+
+	// Models of all projects are sent, but loading models are not.
+	var got []string
+	for _, e := range stream.events {
+		got = append(got, e.Model.Id)
+	}
+	assert.Equal(t, []string{"bm0", "bm1", "bm0"}, got)
+}
+
+func TestWatchModels_LateCommit(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	k := store.ModelKey{
+		ModelID:  "bm0",
+		TenantID: defaultTenantID,
+	}
+	_, err := st.CreateBaseModel(
+		k,
+		"path",
+		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+		"gguf-path",
+		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
+	)
+	assert.NoError(t, err)
+
+	newEvent := func(id uint, typ v1.ModelEventType) *store.ModelEvent {
+		e := newModelEvent(k, true, typ)
+		e.ID = id
+		return e
+	}
+	for _, e := range []*store.ModelEvent{
+		newEvent(1, v1.ModelEventType_MODEL_EVENT_TYPE_CREATED),
+		newEvent(3, v1.ModelEventType_MODEL_EVENT_TYPE_ACTIVATED),
+	} {
+		err := st.CreateModelEvent(e)
+		assert.NoError(t, err)
+	}
+
+	cfg := testModelWatchConfig
+	cfg.CommitLag = time.Hour
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, cfg, testr.New(t))
+	stream := newFakeWatchModelsServer(context.Background(), 3)
+	stream.onSend = func(e *v1.ModelEvent) {
+		if e.Revision != 3 {
+			return
+		}
+		// The event whose revision is smaller than the sent one is committed late.
+		err := st.CreateModelEvent(newEvent(2, v1.ModelEventType_MODEL_EVENT_TYPE_DEACTIVATED))
+		assert.NoError(t, err)
+	}
+	err = wsrv.WatchModels(&v1.WatchModelsRequest{AfterRevision: 1}, stream)
+	assert.NoError(t, err)
+
+	// The event created within the commit lag is sent again on resumption. The late event is sent, and
+	// the events that have already been sent are not sent again.
+	var got []int64
+	for _, e := range stream.events {
+		got = append(got, e.Revision)
+	}
+	assert.Equal(t, []int64{1, 3, 2}, got)
+}
+
+func TestWatchModels_Pruned(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	k := store.ModelKey{
+		ModelID:  "bm0",
+		TenantID: defaultTenantID,
+	}
+	for i := 0; i < 3; i++ {
+		err := st.CreateModelEvent(newModelEvent(k, true, v1.ModelEventType_MODEL_EVENT_TYPE_CONFIG_UPDATED))
+		assert.NoError(t, err)
+	}
+	_, err := st.DeleteModelEventsCreatedBefore(time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+	err = srv.WatchModels(&v1.WatchModelsRequest{AfterRevision: 1}, newFakeWatchModelsServer(ctx, 0))
+	assert.Error(t, err)
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+func newFakeWatchModelsServer(ctx context.Context, n int) *fakeWatchModelsServer {
+	// Fail the test instead of waiting forever when fewer events are sent than expected.
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	return &fakeWatchModelsServer{
+		ctx:    ctx,
+		cancel: cancel,
+		n:      n,
+	}
+}
+
+// fakeWatchModelsServer captures sent events. Its context is cancelled once n events are sent.
+type fakeWatchModelsServer struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	n      int
+	// onSend is called after an event is sent.
+	onSend func(e *v1.ModelEvent)
+
+	events []*v1.ModelEvent
+}
+
+func (s *fakeWatchModelsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeWatchModelsServer) Send(e *v1.ModelEvent) error {
+	s.events = append(s.events, e)
+	if s.onSend != nil {
+		s.onSend(e)
+	}
+	if len(s.events) >= s.n {
+		s.cancel()
+	}
+	return nil
+}
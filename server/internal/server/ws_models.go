@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/llmariner/common/pkg/id"
 	v1 "github.com/llmariner/model-manager/api/v1"
 	mid "github.com/llmariner/model-manager/common/pkg/id"
+	"github.com/llmariner/model-manager/common/pkg/lease"
 	"github.com/llmariner/model-manager/server/internal/store"
+	"github.com/llmariner/rbac-manager/pkg/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
@@ -134,6 +138,8 @@ func (s *WS) RegisterModel(
 			Adapter:        req.Adapter,
 			Quantization:   req.Quantization,
 			LoadingStatus:  v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+			// The model is uploaded by a job in the cluster.
+			LoadedClusterID: clusterInfo.ClusterID,
 		}); err != nil {
 			return status.Errorf(codes.Internal, "create model: %s", err)
 		}
@@ -177,6 +183,10 @@ func (s *WS) PublishModel(
 		}
 		return nil, status.Errorf(codes.Internal, "update model: %s", err)
 	}
+	recordModelEvent(s.store, s.log, store.ModelKey{
+		ModelID:  req.Id,
+		TenantID: clusterInfo.TenantID,
+	}, false, v1.ModelEventType_MODEL_EVENT_TYPE_LOADED)
 	return &v1.PublishModelResponse{}, nil
 }
 
@@ -261,10 +271,33 @@ func (s *WS) CreateBaseModel(
 		formats = append(formats, req.Formats...)
 	}
 
-	for _, format := range formats {
-		if format == v1.ModelFormat_MODEL_FORMAT_GGUF && req.GgufModelPath == "" {
-			return nil, status.Error(codes.InvalidArgument, "gguf_model_path is required for the GGUF format")
-		}
+	artifacts, err := toFormatArtifactRecords(req, formats)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	ggufModelPath := ggufModelPathOf(artifacts)
+	if ggufModelPath == "" {
+		// Old loaders set the GGUF model path without the format.
+		ggufModelPath = req.GgufModelPath
+	}
+
+	files, err := toModelFileRecords(req.FilesBasePath, req.Files)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	if err := validateModelSize(req.Size); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	if err := validateGGUFMetadata(req.GgufMetadata, ggufModelPath); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	if err := validateHuggingFaceMetadata(req.HuggingFaceMetadata, formats); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	md := &store.BaseModelMetadata{
+		Size:                req.Size,
+		GGUFMetadata:        req.GgufMetadata,
+		HuggingFaceMetadata: req.HuggingFaceMetadata,
 	}
 
 	// Note: We skip the validation of source repository for backward compatibility.
@@ -289,13 +322,8 @@ func (s *WS) CreateBaseModel(
 			if m.ProjectID != req.ProjectId {
 				continue
 			}
-			convertedModelID := mid.ToLLMarinerModelID(m.ModelID)
-			if convertedModelID == req.Id {
-				originalBaseModel = m
-				break
-			}
-			if strings.HasPrefix(req.Id, convertedModelID+"-") {
-				// Handle a case where a new model is created for each file in a Hugging Face model repository.
+			// Handle a case where a new model is created for each file in a Hugging Face model repository.
+			if isDerivedModelID(req.Id, m.ModelID) {
 				originalBaseModel = m
 				break
 			}
@@ -305,7 +333,7 @@ func (s *WS) CreateBaseModel(
 		var m *store.BaseModel
 		if err := s.store.Transaction(func(tx *gorm.DB) error {
 			var err error
-			m, err = store.CreateBaseModelInTransaction(tx, k, req.Path, formats, req.GgufModelPath, req.SourceRepository)
+			m, err = store.CreateBaseModelInTransaction(tx, k, req.Path, formats, ggufModelPath, req.SourceRepository, req.Revision, req.ResolvedRevision, req.FilePatterns, clusterInfo.ClusterID)
 			if err != nil {
 				return status.Errorf(codes.Internal, "create base model: %s", err)
 			}
@@ -319,6 +347,18 @@ func (s *WS) CreateBaseModel(
 				return status.Errorf(codes.Internal, "create model activation status: %s", err)
 			}
 
+			if err := store.ReplaceModelFilesInTransaction(tx, k, files); err != nil {
+				return status.Errorf(codes.Internal, "replace model files: %s", err)
+			}
+
+			if err := store.ReplaceModelFormatArtifactsInTransaction(tx, k, artifacts); err != nil {
+				return status.Errorf(codes.Internal, "replace model format artifacts: %s", err)
+			}
+
+			if err := store.UpdateBaseModelMetadataInTransaction(tx, k, md); err != nil {
+				return status.Errorf(codes.Internal, "update base model metadata: %s", err)
+			}
+
 			// Copy the model config from the original base model if it exists.
 			if originalBaseModel != nil {
 				existing, err := s.store.GetModelConfig(store.ModelKey{
@@ -346,6 +386,16 @@ func (s *WS) CreateBaseModel(
 				}
 			}
 
+			// The model is created in the loaded state.
+			for _, t := range []v1.ModelEventType{
+				v1.ModelEventType_MODEL_EVENT_TYPE_CREATED,
+				v1.ModelEventType_MODEL_EVENT_TYPE_LOADED,
+			} {
+				if err := store.CreateModelEventInTransaction(tx, newModelEvent(k, true, t)); err != nil {
+					return status.Errorf(codes.Internal, "create model event: %s", err)
+				}
+			}
+
 			return nil
 		}); err != nil {
 			return nil, err
@@ -358,15 +408,40 @@ func (s *WS) CreateBaseModel(
 		return nil, status.Errorf(codes.AlreadyExists, "model %q already exists", req.Id)
 	}
 
-	// Update the existing model.
-	if err := s.store.UpdateBaseModelToSucceededStatus(
-		k,
-		req.Path,
-		formats,
-		req.GgufModelPath,
-	); err != nil {
-		return nil, status.Errorf(codes.Internal, "update base model: %s", err)
+	// Update the existing model. The files and the format artifacts are replaced in the same transaction so
+	// that the model is never seen as loaded without them.
+	holder := leaseHolder(clusterInfo, req.LoaderId)
+	if err := s.store.Transaction(func(tx *gorm.DB) error {
+		if err := store.UpdateBaseModelToSucceededStatusInTransaction(
+			tx,
+			k,
+			holder,
+			clusterInfo.ClusterID,
+			req.Path,
+			formats,
+			ggufModelPath,
+			req.ResolvedRevision,
+			md,
+		); err != nil {
+			if errors.Is(err, store.ErrConcurrentUpdate) {
+				if holder != "" {
+					return lease.NewLostError(req.Id, req.LoaderId)
+				}
+				return status.Errorf(codes.FailedPrecondition, "concurrent update to model status")
+			}
+			return status.Errorf(codes.Internal, "update base model: %s", err)
+		}
+		if err := store.ReplaceModelFilesInTransaction(tx, k, files); err != nil {
+			return status.Errorf(codes.Internal, "replace model files: %s", err)
+		}
+		if err := store.ReplaceModelFormatArtifactsInTransaction(tx, k, artifacts); err != nil {
+			return status.Errorf(codes.Internal, "replace model format artifacts: %s", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
+	recordModelEvent(s.store, s.log, k, true, v1.ModelEventType_MODEL_EVENT_TYPE_LOADED)
 	return toBaseModelProto(existing), nil
 }
 
@@ -422,17 +497,56 @@ func (s *WS) GetBaseModelPath(
 		formats = append(formats, v1.ModelFormat_MODEL_FORMAT_GGUF)
 	}
 
-	return &v1.GetBaseModelPathResponse{
-		Path:          m.Path,
-		Formats:       formats,
-		GgufModelPath: m.GGUFModelPath,
-	}, nil
+	size, err := store.UnmarshalModelSize(m.Size)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unmarshal model size: %s", err)
+	}
+	hfMetadata, err := store.UnmarshalHuggingFaceMetadata(m.HuggingFaceMetadata)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unmarshal hugging face metadata: %s", err)
+	}
+
+	as, err := s.store.ListModelFormatArtifacts(store.ModelKey{
+		ModelID:   m.ModelID,
+		ProjectID: m.ProjectID,
+		TenantID:  m.TenantID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list model format artifacts: %s", err)
+	}
+	artifacts, err := toFormatArtifactProtos(as)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "convert model format artifacts: %s", err)
+	}
+
+	resp := &v1.GetBaseModelPathResponse{
+		Path:                m.Path,
+		Formats:             formats,
+		GgufModelPath:       m.GGUFModelPath,
+		Size:                size,
+		HuggingFaceMetadata: hfMetadata,
+		Artifacts:           artifacts,
+	}
+	// Populate the deprecated fields for backward compatibility.
+	for _, a := range artifacts {
+		switch a.Format {
+		case v1.ModelFormat_MODEL_FORMAT_ONNX:
+			resp.OnnxModelPath = a.Path
+		case v1.ModelFormat_MODEL_FORMAT_MLX:
+			resp.MlxModelPath = a.Path
+		case v1.ModelFormat_MODEL_FORMAT_GPTQ:
+			resp.GptqModelPath = a.Path
+		case v1.ModelFormat_MODEL_FORMAT_AWQ:
+			resp.AwqModelPath = a.Path
+		case v1.ModelFormat_MODEL_FORMAT_VLLM:
+			resp.VllmModelPath = a.Path
+		}
+	}
+	return resp, nil
 }
 
-// AcquireUnloadedBaseModel checks if there is any unloaded base model. If exists,
-// update the loading status to LOADED and return it.
-//
-// TODO(kenji): Exclude models that shouldn't be loaded in the requesting cluster based on their model config.
+// AcquireUnloadedBaseModel checks if there is any unloaded base model that the requesting loader can load.
+// If exists, update the loading status to LOADED and return it.
 func (s *WS) AcquireUnloadedBaseModel(
 	ctx context.Context,
 	req *v1.AcquireUnloadedBaseModelRequest,
@@ -442,22 +556,63 @@ func (s *WS) AcquireUnloadedBaseModel(
 		return nil, err
 	}
 
-	ms, err := s.store.ListUnloadedBaseModels(clusterInfo.TenantID)
+	ms, err := listUnloadedBaseModelsInLoadOrder(s.store, clusterInfo.TenantID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s", err)
+	}
+
+	// Objects being deleted might be at the path of a recreated model.
+	ts, err := s.store.ListLeasedObjectDeletionTasks(clusterInfo.TenantID, time.Now())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "list unloaded base models: %s", err)
+		return nil, status.Errorf(codes.Internal, "list leased object deletion tasks: %s", err)
 	}
 
-	if len(ms) == 0 {
+	lc := loaderCapability{
+		clusterID:                   s.loaderClusterID(clusterInfo, req.ClusterId),
+		supportedSourceRepositories: req.SupportedSourceRepositories,
+		freeDiskBytes:               req.FreeDiskBytes,
+	}
+	configs, err := listModelConfigs(s.store, clusterInfo.TenantID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list model configs: %s", err)
+	}
+	var m *store.BaseModel
+	for _, bm := range ms {
+		if isBeingDeleted(bm, ts) {
+			continue
+		}
+		k := store.ModelKey{
+			ModelID:   bm.ModelID,
+			ProjectID: bm.ProjectID,
+			TenantID:  bm.TenantID,
+		}
+		c, ok := configs[k]
+		if !ok {
+			c = defaultModelConfig()
+		}
+		ok, err := canLoad(lc, c, bm.SourceRepository, bm.Size, bm.LoadingProgress)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "check base model %q: %s", bm.ModelID, err)
+		}
+		if ok {
+			m = bm
+			break
+		}
+	}
+	if m == nil {
 		return &v1.AcquireUnloadedBaseModelResponse{}, nil
 	}
 
-	m := ms[0]
 	k := store.ModelKey{
 		ModelID:   m.ModelID,
 		ProjectID: m.ProjectID,
 		TenantID:  m.TenantID,
 	}
-	if err := s.store.UpdateBaseModelToLoadingStatus(k); err != nil {
+	filePatterns, err := store.UnmarshalFilePatterns(m.FilePatterns)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unmarshal file patterns: %s", err)
+	}
+	if err := s.store.UpdateBaseModelToLoadingStatus(k, leaseHolder(clusterInfo, req.LoaderId), s.leaseExpiresAt()); err != nil {
 		if errors.Is(err, store.ErrConcurrentUpdate) {
 			return nil, status.Errorf(codes.FailedPrecondition, "concurrent update to model status")
 		}
@@ -466,16 +621,18 @@ func (s *WS) AcquireUnloadedBaseModel(
 	}
 
 	return &v1.AcquireUnloadedBaseModelResponse{
-		BaseModelId:      m.ModelID,
-		SourceRepository: m.SourceRepository,
-		ProjectId:        m.ProjectID,
+		BaseModelId:       m.ModelID,
+		SourceRepository:  m.SourceRepository,
+		ProjectId:         m.ProjectID,
+		Revision:          m.Revision,
+		FilePatterns:      filePatterns,
+		ModelFileLocation: m.ModelFileLocation,
+		Checksum:          m.Checksum,
 	}, nil
 }
 
-// AcquireUnloadedModel checks if there is any unloaded model. If exists,
+// AcquireUnloadedModel checks if there is any unloaded model that the requesting loader can load. If exists,
 // update the loading status to LOADED and return it.
-//
-// TODO(kenji): Exclude models that shouldn't be loaded in the requesting cluster based on their model config.
 func (s *WS) AcquireUnloadedModel(
 	ctx context.Context,
 	req *v1.AcquireUnloadedModelRequest,
@@ -485,17 +642,48 @@ func (s *WS) AcquireUnloadedModel(
 		return nil, err
 	}
 
-	ms, err := s.store.ListUnloadedModels(clusterInfo.TenantID)
+	ms, err := listUnloadedModelsInLoadOrder(s.store, clusterInfo.TenantID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "list unloaded models: %s", err)
+		return nil, status.Errorf(codes.Internal, "%s", err)
 	}
 
-	if len(ms) == 0 {
+	lc := loaderCapability{
+		clusterID:                   s.loaderClusterID(clusterInfo, req.ClusterId),
+		supportedSourceRepositories: req.SupportedSourceRepositories,
+		freeDiskBytes:               req.FreeDiskBytes,
+	}
+	configs, err := listModelConfigs(s.store, clusterInfo.TenantID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list model configs: %s", err)
+	}
+	var m *store.Model
+	for _, um := range ms {
+		k := store.ModelKey{
+			ModelID:  um.ModelID,
+			TenantID: um.TenantID,
+		}
+		c, ok := configs[k]
+		if !ok {
+			c = defaultModelConfig()
+		}
+		ok, err := canLoad(lc, c, um.SourceRepository, um.Size, um.LoadingProgress)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "check model %q: %s", um.ModelID, err)
+		}
+		if ok {
+			m = um
+			break
+		}
+	}
+	if m == nil {
 		return &v1.AcquireUnloadedModelResponse{}, nil
 	}
 
-	m := ms[0]
-	if err := s.store.UpdateModelToLoadingStatus(m.ModelID, clusterInfo.TenantID); err != nil {
+	filePatterns, err := store.UnmarshalFilePatterns(m.FilePatterns)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unmarshal file patterns: %s", err)
+	}
+	if err := s.store.UpdateModelToLoadingStatus(m.ModelID, clusterInfo.TenantID, leaseHolder(clusterInfo, req.LoaderId), s.leaseExpiresAt()); err != nil {
 		if errors.Is(err, store.ErrConcurrentUpdate) {
 			return nil, status.Errorf(codes.FailedPrecondition, "concurrent update to model status")
 		}
@@ -509,6 +697,7 @@ func (s *WS) AcquireUnloadedModel(
 		SourceRepository:  m.SourceRepository,
 		ModelFileLocation: m.ModelFileLocation,
 		DestPath:          m.Path,
+		FilePatterns:      filePatterns,
 	}, nil
 }
 
@@ -545,6 +734,15 @@ func (s *WS) UpdateBaseModelLoadingStatus(
 		return nil, status.Errorf(codes.Internal, "get base model: %s", err)
 	}
 
+	holder := leaseHolder(clusterInfo, req.LoaderId)
+	if holder != "" && isLoadingInProgress(bm.LoadingStatus) && bm.LoadingLeaseHolder != holder {
+		return nil, lease.NewLostError(req.Id, req.LoaderId)
+	}
+
+	var (
+		eventType v1.ModelEventType
+		cancelled bool
+	)
 	switch req.LoadingResult.(type) {
 	case *v1.UpdateBaseModelLoadingStatusRequest_Success_:
 		// model-manager-loader calls this RPC after making the CreateBaseModel RPC request.
@@ -554,7 +752,7 @@ func (s *WS) UpdateBaseModelLoadingStatus(
 		// a Hugging Face repo contains multiple models.
 		//
 		// In this case, we should delete the requested model ID.
-		if bm.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING {
+		if isLoadingInProgress(bm.LoadingStatus) {
 			s.log.Info("Delete the model as a new base model has been successfully created", "modelID", req.Id)
 			if err := s.store.Transaction(func(tx *gorm.DB) error {
 				if err := store.DeleteBaseModelInTransaction(tx, k); err != nil {
@@ -565,6 +763,18 @@ func (s *WS) UpdateBaseModelLoadingStatus(
 					return status.Errorf(codes.Internal, "delete model activation status and config: %s", err)
 				}
 
+				if err := store.DeleteModelFilesInTransaction(tx, k); err != nil {
+					return status.Errorf(codes.Internal, "delete model files: %s", err)
+				}
+
+				if err := store.DeleteModelFormatArtifactsInTransaction(tx, k); err != nil {
+					return status.Errorf(codes.Internal, "delete model format artifacts: %s", err)
+				}
+
+				if err := store.CreateModelEventInTransaction(tx, newModelEvent(k, true, v1.ModelEventType_MODEL_EVENT_TYPE_DELETED)); err != nil {
+					return status.Errorf(codes.Internal, "create model event: %s", err)
+				}
+
 				return nil
 			}); err != nil {
 				return nil, err
@@ -575,21 +785,52 @@ func (s *WS) UpdateBaseModelLoadingStatus(
 		if failure.Reason == "" {
 			return nil, status.Error(codes.InvalidArgument, "reason is required")
 		}
-		err = s.store.UpdateBaseModelToFailedStatus(k, failure.Reason)
+		if bm.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING {
+			// The failure is most likely caused by the cancellation.
+			err = s.store.UpdateBaseModelToCancelledStatus(k, holder, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING)
+			eventType = v1.ModelEventType_MODEL_EVENT_TYPE_CANCELLED
+			break
+		}
+		err = s.store.UpdateBaseModelToFailedStatus(k, holder, failure.Reason, s.nextLoadingAttemptAt(failure.Retryable, bm.LoadingAttemptCount), s.modelLoadingConfig.Retry.MaxAttempts)
+		eventType = v1.ModelEventType_MODEL_EVENT_TYPE_FAILED
+	case *v1.UpdateBaseModelLoadingStatusRequest_Cancelled_:
+		err = s.store.UpdateBaseModelToCancelledStatus(k, holder, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING)
+		eventType = v1.ModelEventType_MODEL_EVENT_TYPE_CANCELLED
 	default:
-		// Loading is still in progress. Just update the status message.
-		err = s.store.UpdateBaseModelLoadingStatusMessage(k, req.StatusMessage)
+		// Loading is still in progress. Update the status message and renew the lease.
+		if holder != "" {
+			if err := s.store.RenewBaseModelLoadingLease(k, holder, s.leaseExpiresAt()); err != nil {
+				if errors.Is(err, store.ErrConcurrentUpdate) {
+					return nil, lease.NewLostError(req.Id, req.LoaderId)
+				}
+				return nil, status.Errorf(codes.Internal, "renew base model loading lease: %s", err)
+			}
+		}
+		err = s.store.UpdateBaseModelLoadingStatusMessage(k, req.StatusMessage, req.UploadPath, req.Progress)
+		if s.shouldRecordProgressEvent(k, true) {
+			eventType = v1.ModelEventType_MODEL_EVENT_TYPE_LOADING_PROGRESS
+		}
+		cancelled = bm.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING
 	}
 
 	if err != nil {
 		if errors.Is(err, store.ErrConcurrentUpdate) {
+			if holder != "" {
+				// The lease has been released or taken over by another loader since the model was read.
+				return nil, lease.NewLostError(req.Id, req.LoaderId)
+			}
 			return nil, status.Errorf(codes.FailedPrecondition, "concurrent update to model status")
 		}
 
 		return nil, status.Errorf(codes.Internal, "update base model loading status: %s", err)
 	}
+	if eventType != v1.ModelEventType_MODEL_EVENT_TYPE_UNSPECIFIED {
+		recordModelEvent(s.store, s.log, k, true, eventType)
+	}
 
-	return &v1.UpdateBaseModelLoadingStatusResponse{}, nil
+	return &v1.UpdateBaseModelLoadingStatusResponse{
+		Cancelled: cancelled,
+	}, nil
 }
 
 // UpdateModelLoadingStatus updates the loading status. When the loading succeeded, it also
@@ -611,43 +852,110 @@ func (s *WS) UpdateModelLoadingStatus(
 		return nil, status.Error(codes.InvalidArgument, "loading_result or status_message is required")
 	}
 
-	if _, err := s.store.GetModelByModelIDAndTenantID(req.Id, clusterInfo.TenantID); err != nil {
+	m, err := s.store.GetModelByModelIDAndTenantID(req.Id, clusterInfo.TenantID)
+	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Errorf(codes.NotFound, "model %q not found", req.Id)
 		}
 		return nil, status.Errorf(codes.Internal, "get base model: %s", err)
 	}
 
+	holder := leaseHolder(clusterInfo, req.LoaderId)
+	if holder != "" && isLoadingInProgress(m.LoadingStatus) && m.LoadingLeaseHolder != holder {
+		return nil, lease.NewLostError(req.Id, req.LoaderId)
+	}
+
+	var (
+		eventType v1.ModelEventType
+		cancelled bool
+	)
 	switch req.LoadingResult.(type) {
 	case *v1.UpdateModelLoadingStatusRequest_Success_:
-		err = s.store.UpdateModelToSucceededStatus(
-			req.Id,
-			clusterInfo.TenantID,
-		)
+		success := req.GetSuccess()
+		files, ferr := toModelFileRecords(success.FilesBasePath, success.Files)
+		if ferr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", ferr)
+		}
+		if ferr := validateModelSize(success.Size); ferr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", ferr)
+		}
+		// The files are replaced in the same transaction so that the model is never seen as loaded
+		// without its files.
+		err = s.store.Transaction(func(tx *gorm.DB) error {
+			if err := store.UpdateModelToSucceededStatusInTransaction(
+				tx,
+				req.Id,
+				clusterInfo.TenantID,
+				holder,
+				clusterInfo.ClusterID,
+				success.Size,
+			); err != nil {
+				return err
+			}
+			return store.ReplaceModelFilesInTransaction(tx, store.ModelKey{ModelID: req.Id, TenantID: clusterInfo.TenantID}, files)
+		})
+		eventType = v1.ModelEventType_MODEL_EVENT_TYPE_LOADED
 	case *v1.UpdateModelLoadingStatusRequest_Failure_:
 		failure := req.GetFailure()
 		if failure.Reason == "" {
 			return nil, status.Error(codes.InvalidArgument, "reason is required")
 		}
+		if m.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING {
+			// The failure is most likely caused by the cancellation.
+			err = s.store.UpdateModelToCancelledStatus(req.Id, clusterInfo.TenantID, holder, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING)
+			eventType = v1.ModelEventType_MODEL_EVENT_TYPE_CANCELLED
+			break
+		}
 		err = s.store.UpdateModelToFailedStatus(
 			req.Id,
 			clusterInfo.TenantID,
+			holder,
 			failure.Reason,
+			s.nextLoadingAttemptAt(failure.Retryable, m.LoadingAttemptCount),
+			s.modelLoadingConfig.Retry.MaxAttempts,
 		)
+		eventType = v1.ModelEventType_MODEL_EVENT_TYPE_FAILED
+	case *v1.UpdateModelLoadingStatusRequest_Cancelled_:
+		err = s.store.UpdateModelToCancelledStatus(req.Id, clusterInfo.TenantID, holder, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING)
+		eventType = v1.ModelEventType_MODEL_EVENT_TYPE_CANCELLED
 	default:
-		// Loading is still in progress. Just update the status message.
-		err = s.store.UpdateModelLoadingStatusMessage(req.Id, clusterInfo.TenantID, req.StatusMessage)
+		// Loading is still in progress. Update the status message and renew the lease.
+		if holder != "" {
+			if err := s.store.RenewModelLoadingLease(req.Id, clusterInfo.TenantID, holder, s.leaseExpiresAt()); err != nil {
+				if errors.Is(err, store.ErrConcurrentUpdate) {
+					return nil, lease.NewLostError(req.Id, req.LoaderId)
+				}
+				return nil, status.Errorf(codes.Internal, "renew model loading lease: %s", err)
+			}
+		}
+		err = s.store.UpdateModelLoadingStatusMessage(req.Id, clusterInfo.TenantID, req.StatusMessage, req.Progress)
+		if s.shouldRecordProgressEvent(store.ModelKey{ModelID: req.Id, TenantID: clusterInfo.TenantID}, false) {
+			eventType = v1.ModelEventType_MODEL_EVENT_TYPE_LOADING_PROGRESS
+		}
+		cancelled = m.LoadingStatus == v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING
 	}
 
 	if err != nil {
 		if errors.Is(err, store.ErrConcurrentUpdate) {
+			if holder != "" {
+				// The lease has been released or taken over by another loader since the model was read.
+				return nil, lease.NewLostError(req.Id, req.LoaderId)
+			}
 			return nil, status.Errorf(codes.FailedPrecondition, "concurrent update to model status")
 		}
 
 		return nil, status.Errorf(codes.Internal, "update model loading status: %s", err)
 	}
+	if eventType != v1.ModelEventType_MODEL_EVENT_TYPE_UNSPECIFIED {
+		recordModelEvent(s.store, s.log, store.ModelKey{
+			ModelID:  req.Id,
+			TenantID: clusterInfo.TenantID,
+		}, false, eventType)
+	}
 
-	return &v1.UpdateModelLoadingStatusResponse{}, nil
+	return &v1.UpdateModelLoadingStatusResponse{
+		Cancelled: cancelled,
+	}, nil
 }
 
 func (s *WS) getLoadedBaseModel(modelID, tenantID string) (*store.BaseModel, bool, error) {
@@ -720,6 +1028,183 @@ func (s *WS) GetModel(ctx context.Context, req *v1.GetModelRequest) (*v1.Model,
 	return convertFineTunedModelToProto(s.store, s.pcache, fm)
 }
 
+// formatsWithEntryPoint are the formats whose artifacts must be specified explicitly.
+var formatsWithEntryPoint = []v1.ModelFormat{
+	v1.ModelFormat_MODEL_FORMAT_GGUF,
+	v1.ModelFormat_MODEL_FORMAT_ONNX,
+	v1.ModelFormat_MODEL_FORMAT_MLX,
+	v1.ModelFormat_MODEL_FORMAT_GPTQ,
+	v1.ModelFormat_MODEL_FORMAT_AWQ,
+	v1.ModelFormat_MODEL_FORMAT_VLLM,
+}
+
+// toFormatArtifactRecords validates the formats of a base model and their artifacts, and converts them to
+// the store records. The artifacts are taken from the artifacts field and the deprecated per-format fields.
+// The formats without an entry point default to the path of the model.
+func toFormatArtifactRecords(req *v1.CreateBaseModelRequest, formats []v1.ModelFormat) ([]*store.ModelFormatArtifact, error) {
+	for _, format := range formats {
+		if _, ok := v1.ModelFormat_name[int32(format)]; !ok || format == v1.ModelFormat_MODEL_FORMAT_UNSPECIFIED {
+			return nil, fmt.Errorf("unsupported format %s", format)
+		}
+	}
+
+	byFormat := map[v1.ModelFormat]*v1.FormatArtifact{}
+	for _, a := range req.Artifacts {
+		if !slices.Contains(formats, a.Format) {
+			return nil, fmt.Errorf("artifact is set without the %s format", a.Format)
+		}
+		if a.Path == "" {
+			return nil, fmt.Errorf("path of the %s artifact is required", a.Format)
+		}
+		if _, ok := byFormat[a.Format]; ok {
+			return nil, fmt.Errorf("duplicate artifact of the %s format", a.Format)
+		}
+		byFormat[a.Format] = a
+	}
+
+	for _, p := range []struct {
+		format v1.ModelFormat
+		field  string
+		path   string
+	}{
+		{format: v1.ModelFormat_MODEL_FORMAT_GGUF, field: "gguf_model_path", path: req.GgufModelPath},
+		{format: v1.ModelFormat_MODEL_FORMAT_ONNX, field: "onnx_model_path", path: req.OnnxModelPath},
+		{format: v1.ModelFormat_MODEL_FORMAT_MLX, field: "mlx_model_path", path: req.MlxModelPath},
+		{format: v1.ModelFormat_MODEL_FORMAT_GPTQ, field: "gptq_model_path", path: req.GptqModelPath},
+		{format: v1.ModelFormat_MODEL_FORMAT_AWQ, field: "awq_model_path", path: req.AwqModelPath},
+		{format: v1.ModelFormat_MODEL_FORMAT_VLLM, field: "vllm_model_path", path: req.VllmModelPath},
+	} {
+		if p.path == "" {
+			continue
+		}
+		if !slices.Contains(formats, p.format) {
+			if p.format == v1.ModelFormat_MODEL_FORMAT_GGUF {
+				// The GGUF model path is kept for old loaders that set it without the format.
+				continue
+			}
+			return nil, fmt.Errorf("%s is set without the %s format", p.field, p.format)
+		}
+		if a, ok := byFormat[p.format]; ok {
+			if a.Path != p.path {
+				return nil, fmt.Errorf("%s does not match the path of the %s artifact", p.field, p.format)
+			}
+			continue
+		}
+		byFormat[p.format] = &v1.FormatArtifact{Format: p.format, Path: p.path}
+	}
+
+	var as []*store.ModelFormatArtifact
+	seen := map[v1.ModelFormat]bool{}
+	for _, format := range formats {
+		if seen[format] {
+			continue
+		}
+		seen[format] = true
+
+		a, ok := byFormat[format]
+		if !ok {
+			if slices.Contains(formatsWithEntryPoint, format) {
+				return nil, fmt.Errorf("artifact is required for the %s format", format)
+			}
+			a = &v1.FormatArtifact{Format: format, Path: req.Path}
+		}
+		// The GGUF file of a per-file GGUF model is not under the path.
+		if format != v1.ModelFormat_MODEL_FORMAT_GGUF && a.Path != req.Path && !strings.HasPrefix(a.Path, req.Path+"/") {
+			return nil, fmt.Errorf("path of the %s artifact must be under the path", format)
+		}
+
+		attrs, err := store.MarshalFormatArtifactAttributes(a.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		as = append(as, &store.ModelFormatArtifact{
+			Format:     format,
+			Path:       a.Path,
+			Attributes: attrs,
+		})
+	}
+	return as, nil
+}
+
+// ggufModelPathOf returns the path of the GGUF artifact. It returns an empty string if there is no GGUF artifact.
+func ggufModelPathOf(as []*store.ModelFormatArtifact) string {
+	for _, a := range as {
+		if a.Format == v1.ModelFormat_MODEL_FORMAT_GGUF {
+			return a.Path
+		}
+	}
+	return ""
+}
+
+func toFormatArtifactProtos(as []*store.ModelFormatArtifact) ([]*v1.FormatArtifact, error) {
+	var artifacts []*v1.FormatArtifact
+	for _, a := range as {
+		attrs, err := store.UnmarshalFormatArtifactAttributes(a.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, &v1.FormatArtifact{
+			Format:     a.Format,
+			Path:       a.Path,
+			Attributes: attrs,
+		})
+	}
+	return artifacts, nil
+}
+
+// validateGGUFMetadata validates the GGUF metadata reported by a loader. The metadata is optional.
+func validateGGUFMetadata(md *v1.GGUFMetadata, ggufModelPath string) error {
+	if md == nil {
+		return nil
+	}
+	if ggufModelPath == "" {
+		return fmt.Errorf("gguf_model_path is required when gguf_metadata is set")
+	}
+	if md.ParameterCount < 0 {
+		return fmt.Errorf("parameter_count must be non-negative")
+	}
+	if md.ContextLength < 0 {
+		return fmt.Errorf("context_length must be non-negative")
+	}
+	return nil
+}
+
+// validateHuggingFaceMetadata validates the Hugging Face metadata reported by a loader. The metadata is optional.
+func validateHuggingFaceMetadata(md *v1.HuggingFaceMetadata, formats []v1.ModelFormat) error {
+	if md == nil {
+		return nil
+	}
+	if !slices.Contains(formats, v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE) {
+		return fmt.Errorf("hugging_face_metadata requires the Hugging Face format")
+	}
+	if md.ParameterCount < 0 {
+		return fmt.Errorf("parameter_count must be non-negative")
+	}
+	if md.MaxPositionEmbeddings < 0 {
+		return fmt.Errorf("max_position_embeddings must be non-negative")
+	}
+	if md.VocabSize < 0 {
+		return fmt.Errorf("vocab_size must be non-negative")
+	}
+	return nil
+}
+
+// validateModelSize validates the size of a model reported by a loader. The size is optional.
+func validateModelSize(size *v1.ModelSize) error {
+	if size == nil {
+		return nil
+	}
+	if size.TotalBytes < 0 {
+		return fmt.Errorf("total_bytes must be non-negative")
+	}
+	for _, fs := range size.FormatSizes {
+		if fs.SizeBytes < 0 || fs.SizeBytes > size.TotalBytes {
+			return fmt.Errorf("size_bytes of format %s must be between 0 and total_bytes", fs.Format)
+		}
+	}
+	return nil
+}
+
 func toBaseModelProto(m *store.BaseModel) *v1.BaseModel {
 	return &v1.BaseModel{
 		Id:      m.ModelID,
@@ -727,3 +1212,76 @@ func toBaseModelProto(m *store.BaseModel) *v1.BaseModel {
 		Created: m.CreatedAt.UTC().Unix(),
 	}
 }
+
+// loaderCapability is what a loader reports when it acquires a model.
+type loaderCapability struct {
+	clusterID string
+	// supportedSourceRepositories is the list of the source repositories that the loader supports.
+	// All source repositories are supported if empty.
+	supportedSourceRepositories []v1.SourceRepository
+	// freeDiskBytes is the free disk space of the loader. It is not checked if zero.
+	freeDiskBytes int64
+}
+
+// loaderClusterID returns the ID of the cluster where the requesting loader runs. The cluster of the
+// authenticated worker is used if authentication is enabled.
+func (s *WS) loaderClusterID(clusterInfo *auth.ClusterInfo, reqClusterID string) string {
+	if !s.enableAuth && reqClusterID != "" {
+		return reqClusterID
+	}
+	return clusterInfo.ClusterID
+}
+
+// isBeingDeleted returns true if the objects at the path of the base model are being deleted by any of the
+// given tasks.
+func isBeingDeleted(bm *store.BaseModel, ts []*store.ObjectDeletionTask) bool {
+	for _, t := range ts {
+		k := store.ModelKey{ModelID: t.ModelID, ProjectID: t.ProjectID, TenantID: t.TenantID}
+		if isPendingLoad(k, []*store.BaseModel{bm}) {
+			return true
+		}
+	}
+	return false
+}
+
+// canLoad returns true if the loader can load the model.
+//
+// The size of the model is known if the model was loaded before or if a loader has reported the progress
+// of a previous attempt. The disk space is not checked for a model of an unknown size.
+func canLoad(lc loaderCapability, c *v1.ModelConfig, sr v1.SourceRepository, size, loadingProgress []byte) (bool, error) {
+	if len(lc.supportedSourceRepositories) > 0 && !slices.Contains(lc.supportedSourceRepositories, sr) {
+		return false, nil
+	}
+
+	if lc.freeDiskBytes > 0 {
+		totalBytes, err := modelTotalBytes(size, loadingProgress)
+		if err != nil {
+			return false, err
+		}
+		if totalBytes > lc.freeDiskBytes {
+			return false, nil
+		}
+	}
+
+	if ids := c.GetClusterAllocationPolicy().GetAllowedClusterIds(); len(ids) > 0 && !slices.Contains(ids, lc.clusterID) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// modelTotalBytes returns the total size of the model files. The size reported by a successful load
+// takes precedence over the progress of a previous attempt. It returns zero if the size is unknown.
+func modelTotalBytes(size, loadingProgress []byte) (int64, error) {
+	ms, err := store.UnmarshalModelSize(size)
+	if err != nil {
+		return 0, err
+	}
+	if b := ms.GetTotalBytes(); b > 0 {
+		return b, nil
+	}
+	progress, err := store.UnmarshalLoadingProgress(loadingProgress)
+	if err != nil {
+		return 0, err
+	}
+	return progress.GetTotalBytes(), nil
+}
@@ -3,19 +3,39 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/testr"
 	v1 "github.com/llmariner/model-manager/api/v1"
 	mid "github.com/llmariner/model-manager/common/pkg/id"
+	"github.com/llmariner/model-manager/common/pkg/lease"
+	"github.com/llmariner/model-manager/server/internal/config"
 	"github.com/llmariner/model-manager/server/internal/store"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 )
 
+var testModelLoadingConfig = config.ModelLoadingConfig{
+	LeaseDuration:     time.Minute,
+	LeaseReapInterval: time.Minute,
+	Retry: config.LoadingRetryConfig{
+		MaxAttempts:     3,
+		InitialBackoff:  time.Minute,
+		MaxBackoff:      time.Hour,
+		RequeueInterval: time.Minute,
+	},
+	Priority: config.LoadingPriorityConfig{
+		MaxPriority:               10,
+		MaxRaisedModelsPerProject: 2,
+	},
+}
+
 func TestInternalGetModel(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
@@ -24,7 +44,7 @@ func TestInternalGetModel(t *testing.T) {
 		ModelID:  "model0",
 		TenantID: defaultTenantID,
 	}
-	_, err := st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf-path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err := st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf-path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 	assert.NoError(t, err)
 
 	_, err = st.CreateModel(store.ModelSpec{
@@ -37,7 +57,7 @@ func TestInternalGetModel(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	ctx := fakeAuthInto(context.Background())
 	got, err := wsrv.GetModel(ctx, &v1.GetModelRequest{
@@ -63,8 +83,8 @@ func TestRegisterAndPublishModel(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
 	_, err := wsrv.CreateStorageConfig(ctx, &v1.CreateStorageConfigRequest{
@@ -124,7 +144,7 @@ func TestGetModelPath(t *testing.T) {
 		orgID   = "o0"
 	)
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 	_, err := wsrv.GetModelPath(ctx, &v1.GetModelPathRequest{
 		Id: modelID,
@@ -170,7 +190,7 @@ func TestGetModelAttributes(t *testing.T) {
 		orgID   = "o0"
 	)
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 	_, err := wsrv.GetModelPath(ctx, &v1.GetModelPathRequest{
 		Id: modelID,
@@ -218,7 +238,7 @@ func TestBaseModels(t *testing.T) {
 	defer tearDown()
 
 	ctx := fakeAuthInto(context.Background())
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	const modelID = "m0"
 
@@ -326,7 +346,7 @@ func TestGetBaseModelPath(t *testing.T) {
 			defer tearDown()
 
 			ctx := fakeAuthInto(context.Background())
-			wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+			wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 			_, err := wsrv.CreateBaseModel(ctx, tc.createReq)
 			assert.NoError(t, err)
@@ -348,10 +368,10 @@ func TestBaseModelCreation(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	// No model to be acquired.
 	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
@@ -412,14 +432,398 @@ func TestBaseModelCreation(t *testing.T) {
 	assert.Equal(t, "gguf-path", got.GGUFModelPath)
 }
 
+func TestBaseModelCreation_Revision(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+
+	const (
+		modelID  = "r/m0"
+		revision = "v1.0"
+		sha      = "0123456789abcdef0123456789abcdef01234567"
+	)
+
+	// Revisions are only supported for Hugging Face.
+	_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "m0:tag",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA,
+		Revision:         revision,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	m, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               modelID,
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		Revision:         revision,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, revision, m.Revision)
+	assert.Empty(t, m.ResolvedRevision)
+
+	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, modelID, resp.BaseModelId)
+	assert.Equal(t, revision, resp.Revision)
+
+	_, err = wsrv.CreateBaseModel(ctx, &v1.CreateBaseModelRequest{
+		Id:               modelID,
+		Path:             "path",
+		GgufModelPath:    "gguf-path",
+		Revision:         revision,
+		ResolvedRevision: sha,
+	})
+	assert.NoError(t, err)
+
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id: modelID,
+		LoadingResult: &v1.UpdateBaseModelLoadingStatusRequest_Success_{
+			Success: &v1.UpdateBaseModelLoadingStatusRequest_Success{},
+		},
+	})
+	assert.NoError(t, err)
+
+	got, err := srv.GetModel(ctx, &v1.GetModelRequest{Id: modelID})
+	assert.NoError(t, err)
+	assert.Equal(t, revision, got.Revision)
+	assert.Equal(t, sha, got.ResolvedRevision)
+}
+
+func TestBaseModelCreation_FilePatterns(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+
+	const modelID = "r/m0"
+
+	_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               modelID,
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		FilePatterns: &v1.FilePatterns{
+			Include: []string{""},
+		},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	filePatterns := &v1.FilePatterns{
+		Include: []string{"*.json", "*.safetensors"},
+		Exclude: []string{"original/"},
+	}
+	m, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               modelID,
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		FilePatterns:     filePatterns,
+	})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(filePatterns, m.FilePatterns))
+
+	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, modelID, resp.BaseModelId)
+	assert.True(t, proto.Equal(filePatterns, resp.FilePatterns))
+
+	// File patterns select the blobs of Ollama models.
+	ollamaPatterns := &v1.FilePatterns{
+		Exclude: []string{"manifests/"},
+	}
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "gemma:2b",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA,
+		FilePatterns:     ollamaPatterns,
+	})
+	assert.NoError(t, err)
+
+	resp, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "gemma:2b", resp.BaseModelId)
+	assert.True(t, proto.Equal(ollamaPatterns, resp.FilePatterns))
+}
+
+func TestBaseModelCreation_HTTP(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+
+	const (
+		modelID  = "phi-4-q4"
+		location = "https://example.com/models/phi-4-Q4_K_M.gguf"
+	)
+	checksum := "sha256:" + strings.Repeat("a", 64)
+
+	tcs := []struct {
+		name     string
+		location string
+		checksum string
+	}{
+		{
+			name: "no location",
+		},
+		{
+			name:     "unsupported scheme",
+			location: "ftp://example.com/models/phi-4-Q4_K_M.gguf",
+		},
+		{
+			name:     "no file name",
+			location: "https://example.com/",
+		},
+		{
+			name:     "invalid checksum",
+			location: location,
+			checksum: "md5:1234",
+		},
+		{
+			name:     "uppercase checksum",
+			location: location,
+			checksum: "sha256:" + strings.Repeat("A", 64),
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+				Id:                modelID,
+				SourceRepository:  v1.SourceRepository_SOURCE_REPOSITORY_HTTP,
+				ModelFileLocation: tc.location,
+				Checksum:          tc.checksum,
+			})
+			assert.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	// Checksums are only supported for HTTP.
+	_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "r/m0",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		Checksum:         checksum,
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:                modelID,
+		SourceRepository:  v1.SourceRepository_SOURCE_REPOSITORY_HTTP,
+		ModelFileLocation: location,
+		Checksum:          checksum,
+	})
+	assert.NoError(t, err)
+
+	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, modelID, resp.BaseModelId)
+	assert.Equal(t, v1.SourceRepository_SOURCE_REPOSITORY_HTTP, resp.SourceRepository)
+	assert.Equal(t, location, resp.ModelFileLocation)
+	assert.Equal(t, checksum, resp.Checksum)
+
+	// Private addresses are checked by the loader.
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:                "internal-model",
+		SourceRepository:  v1.SourceRepository_SOURCE_REPOSITORY_HTTP,
+		ModelFileLocation: "http://10.0.0.1/models/phi-4-Q4_K_M.gguf",
+	})
+	assert.NoError(t, err)
+}
+
+func TestAcquireUnloadedModels_SupportedSourceRepositories(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	_, err := wsrv.CreateStorageConfig(ctx, &v1.CreateStorageConfigRequest{
+		PathPrefix: "models",
+	})
+	assert.NoError(t, err)
+
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "r0/m0",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "gemma:2b",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA,
+	})
+	assert.NoError(t, err)
+
+	// A loader that only supports Ollama skips the Hugging Face model.
+	ollamaOnly := []v1.SourceRepository{v1.SourceRepository_SOURCE_REPOSITORY_OLLAMA}
+	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{
+		SupportedSourceRepositories: ollamaOnly,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "gemma:2b", resp.BaseModelId)
+
+	resp, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{
+		SupportedSourceRepositories: ollamaOnly,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.BaseModelId)
+
+	// A loader that does not specify the source repositories acquires any model.
+	resp, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "r0/m0", resp.BaseModelId)
+
+	// Fine-tuned models are filtered in the same way.
+	_, err = st.CreateBaseModel(
+		store.ModelKey{ModelID: "bm0", TenantID: defaultTenantID},
+		"path",
+		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+		"",
+		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
+	)
+	assert.NoError(t, err)
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		IsFineTunedModel:  true,
+		BaseModelId:       "bm0",
+		Suffix:            "suffix0",
+		SourceRepository:  v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		ModelFileLocation: "s3://bucket0/path0",
+	})
+	assert.NoError(t, err)
+
+	mresp, err := wsrv.AcquireUnloadedModel(ctx, &v1.AcquireUnloadedModelRequest{
+		SupportedSourceRepositories: ollamaOnly,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, mresp.ModelId)
+
+	mresp, err = wsrv.AcquireUnloadedModel(ctx, &v1.AcquireUnloadedModelRequest{
+		SupportedSourceRepositories: []v1.SourceRepository{v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ft:bm0:suffix0", mresp.ModelId)
+}
+
+func TestAcquireUnloadedBaseModel_ClusterAndDisk(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	// m0 can only be loaded in cluster c1. m1 is known to be 100 bytes from the progress of a previous attempt.
+	_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "r0/m0",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		Config: &v1.ModelConfig{
+			RuntimeConfig: &v1.ModelConfig_RuntimeConfig{
+				Resources: &v1.ModelConfig_RuntimeConfig_Resources{
+					Gpu: 1,
+				},
+				Replicas: 1,
+			},
+			ClusterAllocationPolicy: &v1.ModelConfig_ClusterAllocationPolicy{
+				AllowedClusterIds: []string{"c1"},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	_, err = srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               "r0/m1",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+	err = st.UpdateBaseModelLoadingStatusMessage(
+		store.ModelKey{ModelID: "r0/m1", TenantID: defaultTenantID},
+		"",
+		"",
+		&v1.ModelLoadingProgress{TotalBytes: 100},
+	)
+	assert.NoError(t, err)
+
+	// Neither model can be loaded in cluster c0 with 10 bytes of free disk space.
+	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{
+		ClusterId:     "c0",
+		FreeDiskBytes: 10,
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.BaseModelId)
+
+	resp, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{
+		ClusterId:     "c0",
+		FreeDiskBytes: 100,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "r0/m1", resp.BaseModelId)
+
+	resp, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{
+		ClusterId: "c0",
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.BaseModelId)
+
+	resp, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{
+		ClusterId: "c1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "r0/m0", resp.BaseModelId)
+}
+
+func TestModelTotalBytes(t *testing.T) {
+	size, err := proto.Marshal(&v1.ModelSize{TotalBytes: 200})
+	assert.NoError(t, err)
+	progress, err := proto.Marshal(&v1.ModelLoadingProgress{TotalBytes: 100})
+	assert.NoError(t, err)
+
+	tcs := []struct {
+		name     string
+		size     []byte
+		progress []byte
+		want     int64
+	}{
+		{
+			name: "unknown",
+			want: 0,
+		},
+		{
+			name:     "progress",
+			progress: progress,
+			want:     100,
+		},
+		{
+			name:     "size",
+			size:     size,
+			progress: progress,
+			want:     200,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := modelTotalBytes(tc.size, tc.progress)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestBaseModelCreation_CreateModelOfDifferentID(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	const modelID = "r/m0"
 
@@ -476,10 +880,10 @@ func TestBaseModelCreation_ProjectScoped(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	// No model to be acquired.
 	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
@@ -549,10 +953,10 @@ func TestBaseModelCreation_ModelConfig(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	// No model to be acquired.
 	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
@@ -615,10 +1019,10 @@ func TestBaseModelCreation_ModelConfig_HuggingFaceSingleModel(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	// No model to be acquired.
 	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
@@ -681,10 +1085,10 @@ func TestBaseModelCreation_Failure(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	const modelID = "r/m0"
 
@@ -728,7 +1132,7 @@ func TestBaseModelCreation_Duplicate(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
 	const modelID = "r/m0"
@@ -752,8 +1156,8 @@ func TestFineTunedModelCreation(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	ctx := fakeAuthInto(context.Background())
 	_, err := wsrv.CreateStorageConfig(ctx, &v1.CreateStorageConfigRequest{
@@ -779,6 +1183,9 @@ func TestFineTunedModelCreation(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
 		"",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 
@@ -835,8 +1242,8 @@ func TestFineTunedModelCreation_Failure(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	ctx := fakeAuthInto(context.Background())
 	_, err := wsrv.CreateStorageConfig(ctx, &v1.CreateStorageConfigRequest{
@@ -857,6 +1264,9 @@ func TestFineTunedModelCreation_Failure(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
 		"",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 
@@ -899,8 +1309,8 @@ func TestFineTunedModelCreation_CreateModel(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	ctx := fakeAuthInto(context.Background())
 	_, err := wsrv.CreateStorageConfig(ctx, &v1.CreateStorageConfigRequest{
@@ -926,6 +1336,9 @@ func TestFineTunedModelCreation_CreateModel(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
 		"",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 
@@ -996,6 +1409,9 @@ func TestListModels(t *testing.T) {
 			[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 			"gguf-path",
 			v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+			"",
+			"",
+			nil,
 		)
 		assert.NoError(t, err)
 	}
@@ -1013,7 +1429,7 @@ func TestListModels(t *testing.T) {
 		assert.NoError(t, err)
 	}
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
 	got, err := wsrv.ListModels(ctx, &v1.ListModelsRequest{})
@@ -1030,10 +1446,10 @@ func TestBaseModelUpdateLoadingStatusMessage(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
 
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	const modelID = "repo/m0"
 
@@ -1055,15 +1471,40 @@ func TestBaseModelUpdateLoadingStatusMessage(t *testing.T) {
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, "msg", got.LoadingStatusMessage)
+
+	_, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{})
+	assert.NoError(t, err)
+
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id:            modelID,
+		StatusMessage: "msg",
+		Progress: &v1.ModelLoadingProgress{
+			TotalBytes:      100,
+			DownloadedBytes: 40,
+			BytesPerSecond:  10,
+			EtaSeconds:      16,
+		},
+	})
+	assert.NoError(t, err)
+
+	m, err := srv.GetModel(ctx, &v1.GetModelRequest{
+		Id:                  modelID,
+		IncludeLoadingModel: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING, m.LoadingStatus)
+	assert.Equal(t, int64(100), m.LoadingProgress.TotalBytes)
+	assert.Equal(t, int64(40), m.LoadingProgress.DownloadedBytes)
+	assert.Equal(t, int64(16), m.LoadingProgress.EtaSeconds)
 }
 
 func TestFineTunedModelUpdateLoadingStatusMessage(t *testing.T) {
 	st, tearDown := store.NewTest(t)
 	defer tearDown()
 
-	srv := New(st, &fakeProjectCache{}, testr.New(t))
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 	ctx := fakeAuthInto(context.Background())
-	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
 
 	_, err := wsrv.CreateStorageConfig(ctx, &v1.CreateStorageConfigRequest{
 		PathPrefix: "models",
@@ -1083,6 +1524,9 @@ func TestFineTunedModelUpdateLoadingStatusMessage(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
 		"",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 
@@ -1106,3 +1550,564 @@ func TestFineTunedModelUpdateLoadingStatusMessage(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "msg", got.LoadingStatusMessage)
 }
+
+func TestBaseModelLoadingLease(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+
+	const modelID = "repo/m0"
+
+	_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+		Id:               modelID,
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+	})
+	assert.NoError(t, err)
+
+	resp, err := wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{
+		LoaderId: "l0",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, modelID, resp.BaseModelId)
+
+	k := store.ModelKey{
+		ModelID:  modelID,
+		TenantID: defaultTenantID,
+	}
+	got, err := st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultClusterID+"/l0", got.LoadingLeaseHolder)
+	expiresAt := got.LoadingLeaseExpiresAt
+
+	// The lease holder renews the lease.
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id:            modelID,
+		StatusMessage: "msg",
+		LoaderId:      "l0",
+	})
+	assert.NoError(t, err)
+
+	got, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.False(t, got.LoadingLeaseExpiresAt.Before(expiresAt))
+
+	// Other loaders cannot update the status.
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id:            modelID,
+		StatusMessage: "msg",
+		LoaderId:      "l1",
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id:       modelID,
+		LoaderId: "l1",
+		LoadingResult: &v1.UpdateBaseModelLoadingStatusRequest_Failure_{
+			Failure: &v1.UpdateBaseModelLoadingStatusRequest_Failure{
+				Reason: "error",
+			},
+		},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// The lease expires, and the model is acquired by another loader.
+	n, err := st.ReleaseExpiredBaseModelLoadingLeases(time.Now().Add(2 * time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id:            modelID,
+		StatusMessage: "msg",
+		LoaderId:      "l0",
+	})
+	assert.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	resp, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{
+		LoaderId: "l1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, modelID, resp.BaseModelId)
+
+	// The loader that has lost the lease cannot complete the loading.
+	_, err = wsrv.CreateBaseModel(ctx, &v1.CreateBaseModelRequest{
+		Id:               modelID,
+		Path:             "path",
+		Formats:          []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		LoaderId:         "l0",
+	})
+	assert.Error(t, err)
+	assert.True(t, lease.IsLostError(err))
+
+	_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+		Id:       modelID,
+		LoaderId: "l0",
+		LoadingResult: &v1.UpdateBaseModelLoadingStatusRequest_Failure_{
+			Failure: &v1.UpdateBaseModelLoadingStatusRequest_Failure{
+				Reason: "error",
+			},
+		},
+	})
+	assert.Error(t, err)
+	assert.True(t, lease.IsLostError(err))
+
+	_, err = wsrv.CreateBaseModel(ctx, &v1.CreateBaseModelRequest{
+		Id:               modelID,
+		Path:             "path",
+		Formats:          []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		LoaderId:         "l1",
+	})
+	assert.NoError(t, err)
+
+	got, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED, got.LoadingStatus)
+	assert.Empty(t, got.LoadingLeaseHolder)
+}
+
+func TestLoadingProgressEvents(t *testing.T) {
+	tcs := []struct {
+		name     string
+		interval time.Duration
+		want     int
+	}{
+		{
+			name:     "rate limited",
+			interval: time.Hour,
+			want:     1,
+		},
+		{
+			name:     "not rate limited",
+			interval: 0,
+			want:     3,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+			ctx := fakeAuthInto(context.Background())
+
+			cfg := testModelWatchConfig
+			cfg.ProgressEventInterval = tc.interval
+			wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, cfg, testr.New(t))
+
+			const modelID = "repo/m0"
+			_, err := srv.CreateModel(ctx, &v1.CreateModelRequest{
+				Id:               modelID,
+				SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+			})
+			assert.NoError(t, err)
+			_, err = wsrv.AcquireUnloadedBaseModel(ctx, &v1.AcquireUnloadedBaseModelRequest{LoaderId: "l0"})
+			assert.NoError(t, err)
+
+			for i := 0; i < 3; i++ {
+				_, err = wsrv.UpdateBaseModelLoadingStatus(ctx, &v1.UpdateBaseModelLoadingStatusRequest{
+					Id:            modelID,
+					StatusMessage: fmt.Sprintf("msg%d", i),
+					LoaderId:      "l0",
+				})
+				assert.NoError(t, err)
+			}
+
+			es, err := st.ListModelEventsAfterRevision(defaultTenantID, 0, 100)
+			assert.NoError(t, err)
+			var n int
+			for _, e := range es {
+				if e.Type == v1.ModelEventType_MODEL_EVENT_TYPE_LOADING_PROGRESS {
+					n++
+				}
+			}
+			assert.Equal(t, tc.want, n)
+
+			// The status message is updated even if the event is not recorded.
+			bm, err := st.GetBaseModel(store.ModelKey{ModelID: modelID, TenantID: defaultTenantID})
+			assert.NoError(t, err)
+			assert.Equal(t, "msg2", bm.LoadingStatusMessage)
+		})
+	}
+}
+
+func TestNextLoadingAttemptAt(t *testing.T) {
+	wsrv := NewWorkerServiceServer(nil, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+
+	tcs := []struct {
+		name      string
+		retryable bool
+		attempts  int
+		want      time.Duration
+	}{
+		{
+			name:      "first attempt",
+			retryable: true,
+			attempts:  1,
+			want:      time.Minute,
+		},
+		{
+			name:      "second attempt",
+			retryable: true,
+			attempts:  2,
+			want:      2 * time.Minute,
+		},
+		{
+			name:      "no more attempt",
+			retryable: true,
+			attempts:  3,
+			want:      0,
+		},
+		{
+			name:      "not retryable",
+			retryable: false,
+			attempts:  1,
+			want:      0,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			now := time.Now()
+			got := wsrv.nextLoadingAttemptAt(tc.retryable, tc.attempts)
+			if tc.want == 0 {
+				assert.True(t, got.IsZero())
+				return
+			}
+			assert.WithinDuration(t, now.Add(tc.want), got, time.Second)
+		})
+	}
+}
+
+func TestNextLoadingAttemptAt_MaxBackoff(t *testing.T) {
+	c := testModelLoadingConfig
+	c.Retry.MaxAttempts = 100
+	wsrv := NewWorkerServiceServer(nil, &fakeProjectCache{}, c, testModelWatchConfig, testr.New(t))
+
+	got := wsrv.nextLoadingAttemptAt(true, 50)
+	assert.WithinDuration(t, time.Now().Add(c.Retry.MaxBackoff), got, time.Second)
+}
+
+func TestCreateBaseModel_Size(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	size := &v1.ModelSize{
+		TotalBytes: 300,
+		FormatSizes: []*v1.ModelSize_FormatSize{
+			{Format: v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE, SizeBytes: 200},
+			{Format: v1.ModelFormat_MODEL_FORMAT_GGUF, SizeBytes: 100},
+		},
+	}
+	req := &v1.CreateBaseModelRequest{
+		Id:   "m0",
+		Path: "models/m0",
+		Formats: []v1.ModelFormat{
+			v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE,
+			v1.ModelFormat_MODEL_FORMAT_GGUF,
+		},
+		GgufModelPath:    "models/m0/model.gguf",
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		Size: &v1.ModelSize{
+			TotalBytes: 100,
+			FormatSizes: []*v1.ModelSize_FormatSize{
+				{Format: v1.ModelFormat_MODEL_FORMAT_GGUF, SizeBytes: 200},
+			},
+		},
+	}
+	_, err := wsrv.CreateBaseModel(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req.Size = size
+	_, err = wsrv.CreateBaseModel(ctx, req)
+	assert.NoError(t, err)
+
+	resp, err := wsrv.GetBaseModelPath(ctx, &v1.GetBaseModelPathRequest{Id: "m0"})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(size, resp.Size))
+
+	m, err := srv.GetModel(ctx, &v1.GetModelRequest{Id: "m0"})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(size, m.Size))
+}
+
+func TestCreateBaseModel_GGUFMetadata(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	md := &v1.GGUFMetadata{
+		Architecture:     "llama",
+		ParameterCount:   8_000_000_000,
+		ContextLength:    8192,
+		QuantizationType: "Q4_K_M",
+		Tokenizer:        "gpt2",
+		ChatTemplate:     "{{ messages }}",
+	}
+	req := &v1.CreateBaseModelRequest{
+		Id:               "m0",
+		Path:             "models/m0",
+		Formats:          []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+		SourceRepository: v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		GgufMetadata:     md,
+	}
+	// The metadata requires a GGUF file.
+	_, err := wsrv.CreateBaseModel(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req.Formats = []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}
+	req.GgufModelPath = "models/m0/model.gguf"
+	req.GgufMetadata = &v1.GGUFMetadata{ContextLength: -1}
+	_, err = wsrv.CreateBaseModel(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req.GgufMetadata = md
+	_, err = wsrv.CreateBaseModel(ctx, req)
+	assert.NoError(t, err)
+
+	m, err := srv.GetModel(ctx, &v1.GetModelRequest{Id: "m0"})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(md, m.GgufMetadata))
+
+	resp, err := srv.ListModels(ctx, &v1.ListModelsRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Data, 1)
+	assert.True(t, proto.Equal(md, resp.Data[0].GgufMetadata))
+}
+
+func TestCreateBaseModel_HuggingFaceMetadata(t *testing.T) {
+	st, tearDown := store.NewTest(t)
+	defer tearDown()
+
+	srv := New(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+	ctx := fakeAuthInto(context.Background())
+
+	md := &v1.HuggingFaceMetadata{
+		Architecture:          "LlamaForCausalLM",
+		ModelType:             "llama",
+		Dtype:                 "bfloat16",
+		ParameterCount:        8_030_261_248,
+		MaxPositionEmbeddings: 131072,
+		VocabSize:             128256,
+		HasChatTemplate:       true,
+	}
+	req := &v1.CreateBaseModelRequest{
+		Id:                  "m0",
+		Path:                "models/m0",
+		Formats:             []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+		GgufModelPath:       "models/m0/model.gguf",
+		SourceRepository:    v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE,
+		HuggingFaceMetadata: md,
+	}
+	// The metadata requires the Hugging Face format.
+	_, err := wsrv.CreateBaseModel(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req.Formats = []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE}
+	req.GgufModelPath = ""
+	req.HuggingFaceMetadata = &v1.HuggingFaceMetadata{VocabSize: -1}
+	_, err = wsrv.CreateBaseModel(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	req.HuggingFaceMetadata = md
+	_, err = wsrv.CreateBaseModel(ctx, req)
+	assert.NoError(t, err)
+
+	resp, err := wsrv.GetBaseModelPath(ctx, &v1.GetBaseModelPathRequest{Id: "m0"})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(md, resp.HuggingFaceMetadata))
+
+	m, err := srv.GetModel(ctx, &v1.GetModelRequest{Id: "m0"})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(md, m.HuggingFaceMetadata))
+}
+
+func TestCreateBaseModel_Formats(t *testing.T) {
+	tcs := []struct {
+		name          string
+		req           *v1.CreateBaseModelRequest
+		wantArtifacts []*v1.FormatArtifact
+		wantErr       bool
+	}{
+		{
+			name: "onnx",
+			req: &v1.CreateBaseModelRequest{
+				Formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_ONNX},
+				OnnxModelPath: "models/m0/onnx/model.onnx",
+			},
+			wantArtifacts: []*v1.FormatArtifact{
+				{Format: v1.ModelFormat_MODEL_FORMAT_ONNX, Path: "models/m0/onnx/model.onnx"},
+			},
+		},
+		{
+			name: "vllm and awq",
+			req: &v1.CreateBaseModelRequest{
+				Formats: []v1.ModelFormat{
+					v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE,
+					v1.ModelFormat_MODEL_FORMAT_AWQ,
+					v1.ModelFormat_MODEL_FORMAT_VLLM,
+				},
+				AwqModelPath:  "models/m0",
+				VllmModelPath: "models/m0",
+			},
+			wantArtifacts: []*v1.FormatArtifact{
+				{Format: v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE, Path: "models/m0"},
+				{Format: v1.ModelFormat_MODEL_FORMAT_AWQ, Path: "models/m0"},
+				{Format: v1.ModelFormat_MODEL_FORMAT_VLLM, Path: "models/m0"},
+			},
+		},
+		{
+			name: "artifacts",
+			req: &v1.CreateBaseModelRequest{
+				Formats: []v1.ModelFormat{
+					v1.ModelFormat_MODEL_FORMAT_GGUF,
+					v1.ModelFormat_MODEL_FORMAT_MLX,
+				},
+				Artifacts: []*v1.FormatArtifact{
+					{
+						Format:     v1.ModelFormat_MODEL_FORMAT_GGUF,
+						Path:       "models/m0.gguf",
+						Attributes: map[string]string{"quantization_type": "Q4_K_M"},
+					},
+					{Format: v1.ModelFormat_MODEL_FORMAT_MLX, Path: "models/m0/mlx"},
+				},
+			},
+			wantArtifacts: []*v1.FormatArtifact{
+				{
+					Format:     v1.ModelFormat_MODEL_FORMAT_GGUF,
+					Path:       "models/m0.gguf",
+					Attributes: map[string]string{"quantization_type": "Q4_K_M"},
+				},
+				{Format: v1.ModelFormat_MODEL_FORMAT_MLX, Path: "models/m0/mlx"},
+			},
+		},
+		{
+			name: "artifact and matching legacy path",
+			req: &v1.CreateBaseModelRequest{
+				Formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+				GgufModelPath: "models/m0.gguf",
+				Artifacts: []*v1.FormatArtifact{
+					{Format: v1.ModelFormat_MODEL_FORMAT_GGUF, Path: "models/m0.gguf"},
+				},
+			},
+			wantArtifacts: []*v1.FormatArtifact{
+				{Format: v1.ModelFormat_MODEL_FORMAT_GGUF, Path: "models/m0.gguf"},
+			},
+		},
+		{
+			name: "artifact and conflicting legacy path",
+			req: &v1.CreateBaseModelRequest{
+				Formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
+				GgufModelPath: "models/m1.gguf",
+				Artifacts: []*v1.FormatArtifact{
+					{Format: v1.ModelFormat_MODEL_FORMAT_GGUF, Path: "models/m0.gguf"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate artifacts",
+			req: &v1.CreateBaseModelRequest{
+				Formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_ONNX},
+				Artifacts: []*v1.FormatArtifact{
+					{Format: v1.ModelFormat_MODEL_FORMAT_ONNX, Path: "models/m0/a.onnx"},
+					{Format: v1.ModelFormat_MODEL_FORMAT_ONNX, Path: "models/m0/b.onnx"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "artifact without format",
+			req: &v1.CreateBaseModelRequest{
+				Formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+				Artifacts: []*v1.FormatArtifact{
+					{Format: v1.ModelFormat_MODEL_FORMAT_ONNX, Path: "models/m0/model.onnx"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no entry point",
+			req: &v1.CreateBaseModelRequest{
+				Formats: []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_MLX},
+			},
+			wantErr: true,
+		},
+		{
+			name: "entry point without format",
+			req: &v1.CreateBaseModelRequest{
+				Formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE},
+				GptqModelPath: "models/m0",
+			},
+			wantErr: true,
+		},
+		{
+			name: "entry point outside of path",
+			req: &v1.CreateBaseModelRequest{
+				Formats:       []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_ONNX},
+				OnnxModelPath: "models/m1/model.onnx",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown format",
+			req: &v1.CreateBaseModelRequest{
+				Formats: []v1.ModelFormat{v1.ModelFormat(100)},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			st, tearDown := store.NewTest(t)
+			defer tearDown()
+
+			wsrv := NewWorkerServiceServer(st, &fakeProjectCache{}, testModelLoadingConfig, testModelWatchConfig, testr.New(t))
+			ctx := fakeAuthInto(context.Background())
+
+			tc.req.Id = "m0"
+			tc.req.Path = "models/m0"
+			tc.req.SourceRepository = v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE
+			_, err := wsrv.CreateBaseModel(ctx, tc.req)
+			if tc.wantErr {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+
+			resp, err := wsrv.GetBaseModelPath(ctx, &v1.GetBaseModelPathRequest{Id: "m0"})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.req.Formats, resp.Formats)
+			assert.Len(t, resp.Artifacts, len(tc.wantArtifacts))
+			for i, want := range tc.wantArtifacts {
+				assert.Truef(t, proto.Equal(want, resp.Artifacts[i]), "want %v, got %v", want, resp.Artifacts[i])
+			}
+
+			// The deprecated fields are populated from the artifacts.
+			paths := map[v1.ModelFormat]string{}
+			for _, a := range tc.wantArtifacts {
+				paths[a.Format] = a.Path
+			}
+			assert.Equal(t, paths[v1.ModelFormat_MODEL_FORMAT_GGUF], resp.GgufModelPath)
+			assert.Equal(t, paths[v1.ModelFormat_MODEL_FORMAT_ONNX], resp.OnnxModelPath)
+			assert.Equal(t, paths[v1.ModelFormat_MODEL_FORMAT_MLX], resp.MlxModelPath)
+			assert.Equal(t, paths[v1.ModelFormat_MODEL_FORMAT_GPTQ], resp.GptqModelPath)
+			assert.Equal(t, paths[v1.ModelFormat_MODEL_FORMAT_AWQ], resp.AwqModelPath)
+			assert.Equal(t, paths[v1.ModelFormat_MODEL_FORMAT_VLLM], resp.VllmModelPath)
+		})
+	}
+}
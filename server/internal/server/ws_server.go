@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	v1 "github.com/llmariner/model-manager/api/v1"
@@ -21,11 +23,19 @@ const (
 )
 
 // NewWorkerServiceServer creates a new worker service server.
-func NewWorkerServiceServer(s *store.S, pcache pcache, log logr.Logger) *WS {
+func NewWorkerServiceServer(
+	s *store.S,
+	pcache pcache,
+	modelLoadingConfig config.ModelLoadingConfig,
+	modelWatchConfig config.ModelWatchConfig,
+	log logr.Logger,
+) *WS {
 	return &WS{
-		store:  s,
-		pcache: pcache,
-		log:    log.WithName("worker"),
+		store:              s,
+		pcache:             pcache,
+		modelLoadingConfig: modelLoadingConfig,
+		modelWatchConfig:   modelWatchConfig,
+		log:                log.WithName("worker"),
 	}
 }
 
@@ -38,6 +48,9 @@ type WS struct {
 	pcache pcache
 	log    logr.Logger
 
+	modelLoadingConfig config.ModelLoadingConfig
+	modelWatchConfig   config.ModelWatchConfig
+
 	enableAuth bool
 }
 
@@ -53,7 +66,7 @@ func (ws *WS) Run(ctx context.Context, port int, authConfig config.AuthConfig) e
 		if err != nil {
 			return err
 		}
-		opts = append(opts, grpc.ChainUnaryInterceptor(ai.Unary()))
+		opts = append(opts, grpc.ChainUnaryInterceptor(ai.Unary()), grpc.ChainStreamInterceptor(ai.Stream()))
 		ws.enableAuth = true
 	}
 
@@ -96,3 +109,41 @@ func (ws *WS) extractClusterInfoFromContext(ctx context.Context) (*auth.ClusterI
 	}
 	return clusterInfo, nil
 }
+
+// leaseHolder returns the ID of the lease holder for the given loader. Loader IDs are
+// only unique within a cluster, so the ID is qualified with the cluster ID.
+//
+// An empty string is returned if the loader does not report its ID. Such a loader
+// does not hold a lease, and its models are never reaped.
+func leaseHolder(clusterInfo *auth.ClusterInfo, loaderID string) string {
+	if loaderID == "" {
+		return ""
+	}
+	return clusterInfo.ClusterID + "/" + loaderID
+}
+
+// leaseHolderClusterID returns the ID of the cluster of the given lease holder.
+func leaseHolderClusterID(holder string) string {
+	clusterID, _, _ := strings.Cut(holder, "/")
+	return clusterID
+}
+
+// leaseExpiresAt returns the expiration time of a lease that is granted or renewed now.
+func (ws *WS) leaseExpiresAt() time.Time {
+	return time.Now().Add(ws.modelLoadingConfig.LeaseDuration)
+}
+
+// nextLoadingAttemptAt returns the time when a model that has failed after the given number of
+// attempts should be loaded again. The delay grows exponentially. A zero time is returned
+// if the failure is not retryable or the model has used up all the attempts.
+func (ws *WS) nextLoadingAttemptAt(retryable bool, attempts int) time.Time {
+	c := ws.modelLoadingConfig.Retry
+	if !retryable || attempts >= c.MaxAttempts {
+		return time.Time{}
+	}
+	backoff := c.InitialBackoff
+	for i := 1; i < attempts && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return time.Now().Add(min(backoff, c.MaxBackoff))
+}
@@ -1,6 +1,8 @@
 package store
 
 import (
+	"time"
+
 	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 
@@ -23,17 +25,72 @@ type BaseModel struct {
 
 	Formats []byte
 
-	// GGUFModelPath is the path to the GGUF model.
+	// GGUFModelPath is the path to the GGUF model. The entry points of all formats are recorded as
+	// ModelFormatArtifacts, and this is kept in sync with the artifact of the GGUF format.
 	GGUFModelPath string
 
 	SourceRepository v1.SourceRepository
 
+	// Revision is the revision of the source repository that was requested. It is empty if the default
+	// branch was requested.
+	Revision string
+	// ResolvedRevision is the commit SHA that the revision was resolved to when the model was downloaded.
+	ResolvedRevision string
+
+	// FilePatterns is the marshalled v1.FilePatterns that selects the model files to load. It is empty
+	// if all files are loaded.
+	FilePatterns []byte
+
+	// ModelFileLocation is the URL to download the model from. It is only set for the HTTP source repository.
+	ModelFileLocation string
+	// Checksum is the expected checksum of the downloaded file (e.g., "sha256:<hex>"). It is empty if
+	// the checksum is not verified.
+	Checksum string
+
+	// Priority is the priority of loading the model. Models with higher priorities are loaded first.
+	Priority int32
+
 	// TODO(kenji): Consider moving loading status related columns to a separate table
 	// to keep track of model downloading per object store.
 
 	LoadingStatus        v1.ModelLoadingStatus
 	LoadingFailureReason string
 	LoadingStatusMessage string
+	// LoadingProgress is the marshalled v1.ModelLoadingProgress reported by the loader.
+	LoadingProgress []byte
+	// UploadPath is the object store path that the loader is uploading the model files to. It is empty
+	// if the loader does not report the path.
+	UploadPath string
+
+	// Size is the marshalled v1.ModelSize reported by the loader. It is empty if the model was loaded
+	// by a loader that does not report the size.
+	Size []byte
+	// GGUFMetadata is the marshalled v1.GGUFMetadata extracted from the GGUF file by the loader. It is empty
+	// if the model does not have the GGUF format or the loader does not extract the metadata.
+	GGUFMetadata []byte
+	// HuggingFaceMetadata is the marshalled v1.HuggingFaceMetadata extracted from the configuration files by
+	// the loader. It is empty if the model does not have the Hugging Face format or the loader does not
+	// extract the metadata.
+	HuggingFaceMetadata []byte
+
+	// LoadingLeaseHolder is the ID of the loader that is loading the model. It is empty if
+	// the model was acquired by a loader that does not support leases.
+	LoadingLeaseHolder string
+	// LoadingLeaseExpiresAt is the time when the lease expires. The model goes back to the
+	// REQUESTED status if the lease holder does not renew the lease by then.
+	LoadingLeaseExpiresAt time.Time
+
+	// LoadedClusterID is the ID of the cluster whose loader uploaded the model to the object store of the
+	// cluster. It is empty if the model was loaded before the cluster was recorded.
+	LoadedClusterID string
+
+	// LoadingAttemptCount is the number of times that loaders have attempted to load the model.
+	LoadingAttemptCount int
+	// NextLoadingAttemptAt is the time when the failed model is requeued for another attempt.
+	// It is zero if no more attempt is scheduled.
+	NextLoadingAttemptAt time.Time
+	// MaxLoadingAttempts is the maximum number of attempts that was applied when the model failed to load.
+	MaxLoadingAttempts int
 }
 
 // UnmarshalModelFormats unmarshals model formats.
@@ -45,6 +102,66 @@ func UnmarshalModelFormats(b []byte) ([]v1.ModelFormat, error) {
 	return formats.Formats, nil
 }
 
+// UnmarshalFilePatterns unmarshals file patterns. It returns nil if no pattern has been specified.
+func UnmarshalFilePatterns(b []byte) (*v1.FilePatterns, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var p v1.FilePatterns
+	if err := proto.Unmarshal(b, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// UnmarshalLoadingProgress unmarshals loading progress. It returns nil if no progress has been reported.
+func UnmarshalLoadingProgress(b []byte) (*v1.ModelLoadingProgress, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var p v1.ModelLoadingProgress
+	if err := proto.Unmarshal(b, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// UnmarshalGGUFMetadata unmarshals GGUF metadata. It returns nil if no metadata has been extracted.
+func UnmarshalGGUFMetadata(b []byte) (*v1.GGUFMetadata, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var md v1.GGUFMetadata
+	if err := proto.Unmarshal(b, &md); err != nil {
+		return nil, err
+	}
+	return &md, nil
+}
+
+// UnmarshalHuggingFaceMetadata unmarshals Hugging Face metadata. It returns nil if no metadata has been extracted.
+func UnmarshalHuggingFaceMetadata(b []byte) (*v1.HuggingFaceMetadata, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var md v1.HuggingFaceMetadata
+	if err := proto.Unmarshal(b, &md); err != nil {
+		return nil, err
+	}
+	return &md, nil
+}
+
+// UnmarshalModelSize unmarshals a model size. It returns nil if no size has been reported.
+func UnmarshalModelSize(b []byte) (*v1.ModelSize, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var size v1.ModelSize
+	if err := proto.Unmarshal(b, &size); err != nil {
+		return nil, err
+	}
+	return &size, nil
+}
+
 // CreateBaseModel creates a model.
 func (s *S) CreateBaseModel(
 	k ModelKey,
@@ -52,6 +169,9 @@ func (s *S) CreateBaseModel(
 	formats []v1.ModelFormat,
 	ggufModelPath string,
 	sourceRepository v1.SourceRepository,
+	revision string,
+	resolvedRevision string,
+	filePatterns *v1.FilePatterns,
 ) (*BaseModel, error) {
 	return CreateBaseModelInTransaction(
 		s.db,
@@ -60,6 +180,10 @@ func (s *S) CreateBaseModel(
 		formats,
 		ggufModelPath,
 		sourceRepository,
+		revision,
+		resolvedRevision,
+		filePatterns,
+		"",
 	)
 }
 
@@ -71,11 +195,19 @@ func CreateBaseModelInTransaction(
 	formats []v1.ModelFormat,
 	ggufModelPath string,
 	sourceRepository v1.SourceRepository,
+	revision string,
+	resolvedRevision string,
+	filePatterns *v1.FilePatterns,
+	clusterID string,
 ) (*BaseModel, error) {
 	b, err := marshalFormats(formats)
 	if err != nil {
 		return nil, err
 	}
+	fp, err := marshalFilePatterns(filePatterns)
+	if err != nil {
+		return nil, err
+	}
 
 	m := &BaseModel{
 		ModelID:          k.ModelID,
@@ -85,6 +217,10 @@ func CreateBaseModelInTransaction(
 		GGUFModelPath:    ggufModelPath,
 		LoadingStatus:    v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
 		SourceRepository: sourceRepository,
+		Revision:         revision,
+		ResolvedRevision: resolvedRevision,
+		FilePatterns:     fp,
+		LoadedClusterID:  clusterID,
 		TenantID:         k.TenantID,
 	}
 	if err := tx.Create(m).Error; err != nil {
@@ -97,11 +233,21 @@ func CreateBaseModelInTransaction(
 func (s *S) CreateBaseModelWithLoadingRequested(
 	k ModelKey,
 	sourceRepository v1.SourceRepository,
+	revision string,
+	filePatterns *v1.FilePatterns,
+	modelFileLocation string,
+	checksum string,
+	priority int32,
 ) (*BaseModel, error) {
 	return CreateBaseModelWithLoadingRequestedInTransaction(
 		s.db,
 		k,
 		sourceRepository,
+		revision,
+		filePatterns,
+		modelFileLocation,
+		checksum,
+		priority,
 	)
 }
 
@@ -110,13 +256,27 @@ func CreateBaseModelWithLoadingRequestedInTransaction(
 	tx *gorm.DB,
 	k ModelKey,
 	sourceRepository v1.SourceRepository,
+	revision string,
+	filePatterns *v1.FilePatterns,
+	modelFileLocation string,
+	checksum string,
+	priority int32,
 ) (*BaseModel, error) {
+	fp, err := marshalFilePatterns(filePatterns)
+	if err != nil {
+		return nil, err
+	}
 	m := &BaseModel{
-		ModelID:          k.ModelID,
-		ProjectID:        k.ProjectID,
-		SourceRepository: sourceRepository,
-		LoadingStatus:    v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
-		TenantID:         k.TenantID,
+		ModelID:           k.ModelID,
+		ProjectID:         k.ProjectID,
+		SourceRepository:  sourceRepository,
+		Revision:          revision,
+		FilePatterns:      fp,
+		ModelFileLocation: modelFileLocation,
+		Checksum:          checksum,
+		Priority:          priority,
+		LoadingStatus:     v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+		TenantID:          k.TenantID,
 	}
 	if err := tx.Create(m).Error; err != nil {
 		return nil, err
@@ -143,8 +303,13 @@ func DeleteBaseModelInTransaction(tx *gorm.DB, k ModelKey) error {
 
 // GetBaseModel returns a base model by a model key.
 func (s *S) GetBaseModel(k ModelKey) (*BaseModel, error) {
+	return GetBaseModelInTransaction(s.db, k)
+}
+
+// GetBaseModelInTransaction returns a base model by a model key in a transaction.
+func GetBaseModelInTransaction(tx *gorm.DB, k ModelKey) (*BaseModel, error) {
 	var m BaseModel
-	if err := k.buildQuery(s.db).Take(&m).Error; err != nil {
+	if err := k.buildQuery(tx).Take(&m).Error; err != nil {
 		return nil, err
 	}
 	return &m, nil
@@ -239,25 +404,66 @@ func (s *S) ListUnloadedBaseModels(tenantID string) ([]*BaseModel, error) {
 	return ms, nil
 }
 
-// ListLoadingBaseModels returns all base models with the loading status.
+// ListLoadingBaseModels returns all base models that are being loaded, including the ones being cancelled.
 func (s *S) ListLoadingBaseModels(tenantID string) ([]*BaseModel, error) {
 	var ms []*BaseModel
-	if err := s.db.Where("tenant_id = ? AND loading_status = ?", tenantID, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING).
+	if err := s.db.Where("tenant_id = ? AND loading_status IN ?", tenantID, inProgressLoadingStatuses).
 		Find(&ms).Error; err != nil {
 		return nil, err
 	}
 	return ms, nil
 }
 
-// updateBaseModel updates the model if the current status matches with the given one.
+// ListPendingBaseModels returns all base models of the tenant that have been requested to load and have not been
+// loaded or cancelled yet.
+func (s *S) ListPendingBaseModels(tenantID string) ([]*BaseModel, error) {
+	return ListPendingBaseModelsInTransaction(s.db, tenantID)
+}
+
+// ListPendingBaseModelsInTransaction returns all base models of the tenant that have been requested to load
+// and have not been loaded or cancelled yet in a transaction.
+func ListPendingBaseModelsInTransaction(tx *gorm.DB, tenantID string) ([]*BaseModel, error) {
+	var ms []*BaseModel
+	if err := tx.Where("tenant_id = ? AND loading_status IN ?", tenantID, pendingLoadingStatuses).
+		Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// updateBaseModel updates the model if the current status matches with one of the given ones.
 func (s *S) updateBaseModel(
 	k ModelKey,
-	curr v1.ModelLoadingStatus,
+	curr []v1.ModelLoadingStatus,
 	updates map[string]interface{},
 ) error {
-	res := k.buildQuery(s.db.Model(&BaseModel{})).
-		Where("loading_status = ?", curr).
-		Updates(updates)
+	return s.updateLeasedBaseModel(k, curr, "", updates)
+}
+
+// updateLeasedBaseModel updates the model if the current status matches with one of the given ones and
+// the given loader holds the lease of the model. The lease is not checked if the lease holder is empty.
+func (s *S) updateLeasedBaseModel(
+	k ModelKey,
+	curr []v1.ModelLoadingStatus,
+	leaseHolder string,
+	updates map[string]interface{},
+) error {
+	return updateLeasedBaseModelInTransaction(s.db, k, curr, leaseHolder, updates)
+}
+
+func updateLeasedBaseModelInTransaction(
+	tx *gorm.DB,
+	k ModelKey,
+	curr []v1.ModelLoadingStatus,
+	leaseHolder string,
+	updates map[string]interface{},
+) error {
+	q := k.buildQuery(tx.Model(&BaseModel{})).
+		Where("loading_status IN ?", curr)
+	if leaseHolder != "" {
+		q = q.Where("loading_lease_holder = ?", leaseHolder)
+	}
+	res := q.Updates(updates)
 	if err := res.Error; err != nil {
 		return err
 	}
@@ -267,58 +473,230 @@ func (s *S) updateBaseModel(
 	return nil
 }
 
-// UpdateBaseModelToLoadingStatus updates the loading status to LOADING.
-func (s *S) UpdateBaseModelToLoadingStatus(k ModelKey) error {
+// UpdateBaseModelToLoadingStatus updates the loading status to LOADING and records the lease of the loader.
+func (s *S) UpdateBaseModelToLoadingStatus(k ModelKey, leaseHolder string, leaseExpiresAt time.Time) error {
 	return s.updateBaseModel(
 		k,
-		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+		[]v1.ModelLoadingStatus{v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED},
 		map[string]interface{}{
-			"loading_status": v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+			"loading_status":           v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+			"loading_lease_holder":     leaseHolder,
+			"loading_lease_expires_at": leaseExpiresAt,
+			"loading_attempt_count":    gorm.Expr("COALESCE(loading_attempt_count, 0) + 1"),
 		},
 	)
 }
 
+// RenewBaseModelLoadingLease extends the lease of a base model that is being loaded or cancelled. It returns
+// ErrConcurrentUpdate if the given lease holder no longer holds the lease.
+func (s *S) RenewBaseModelLoadingLease(k ModelKey, leaseHolder string, leaseExpiresAt time.Time) error {
+	res := k.buildQuery(s.db.Model(&BaseModel{})).
+		Where("loading_status IN ? AND loading_lease_holder = ?", inProgressLoadingStatuses, leaseHolder).
+		Updates(map[string]interface{}{
+			"loading_lease_expires_at": leaseExpiresAt,
+		})
+	if err := res.Error; err != nil {
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return ErrConcurrentUpdate
+	}
+	return nil
+}
+
+// ReleaseExpiredBaseModelLoadingLeases moves base models whose leases have expired back to the REQUESTED status
+// so that other loaders can pick them up. It returns the number of released models. Base models being cancelled
+// are not released. See ListBaseModelsWithExpiredCancellingLeases.
+func (s *S) ReleaseExpiredBaseModelLoadingLeases(now time.Time) (int64, error) {
+	var n int64
+	for curr, next := range expiredLeaseTransitions {
+		res := s.db.Model(&BaseModel{}).
+			Where("loading_status = ? AND loading_lease_holder != '' AND loading_lease_expires_at < ?", curr, now).
+			Updates(map[string]interface{}{
+				"loading_status":       next,
+				"loading_lease_holder": "",
+			})
+		if err := res.Error; err != nil {
+			return 0, err
+		}
+		n += res.RowsAffected
+	}
+	return n, nil
+}
+
+// ListBaseModelsWithExpiredCancellingLeases lists the base models being cancelled whose leases have expired.
+func (s *S) ListBaseModelsWithExpiredCancellingLeases(now time.Time) ([]*BaseModel, error) {
+	var ms []*BaseModel
+	if err := s.db.
+		Where("loading_status = ? AND loading_lease_holder != '' AND loading_lease_expires_at < ?", v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING, now).
+		Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
 // UpdateBaseModelToSucceededStatus updates the loading status to SUCCEEDED and updates other relevant information.
+// It returns ErrConcurrentUpdate if the given lease holder no longer holds the lease.
 func (s *S) UpdateBaseModelToSucceededStatus(
 	k ModelKey,
+	leaseHolder string,
+	clusterID string,
+	path string,
+	formats []v1.ModelFormat,
+	ggufModelPath string,
+	resolvedRevision string,
+	md *BaseModelMetadata,
+) error {
+	return UpdateBaseModelToSucceededStatusInTransaction(s.db, k, leaseHolder, clusterID, path, formats, ggufModelPath, resolvedRevision, md)
+}
+
+// UpdateBaseModelToSucceededStatusInTransaction updates the loading status to SUCCEEDED and updates other
+// relevant information in a transaction.
+func UpdateBaseModelToSucceededStatusInTransaction(
+	tx *gorm.DB,
+	k ModelKey,
+	leaseHolder string,
+	clusterID string,
 	path string,
 	formats []v1.ModelFormat,
 	ggufModelPath string,
+	resolvedRevision string,
+	md *BaseModelMetadata,
 ) error {
 	b, err := marshalFormats(formats)
 	if err != nil {
 		return err
 	}
+	updates, err := md.toUpdates()
+	if err != nil {
+		return err
+	}
+	updates["path"] = path
+	updates["formats"] = b
+	updates["gguf_model_path"] = ggufModelPath
+	updates["resolved_revision"] = resolvedRevision
+	updates["loading_status"] = v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED
+	updates["loading_lease_holder"] = ""
+	updates["loaded_cluster_id"] = clusterID
+
+	return updateLeasedBaseModelInTransaction(tx, k, inProgressLoadingStatuses, leaseHolder, updates)
+}
 
-	return s.updateBaseModel(
+// BaseModelMetadata is the metadata of a base model reported by the loader.
+type BaseModelMetadata struct {
+	Size                *v1.ModelSize
+	GGUFMetadata        *v1.GGUFMetadata
+	HuggingFaceMetadata *v1.HuggingFaceMetadata
+}
+
+func (md *BaseModelMetadata) toUpdates() (map[string]interface{}, error) {
+	if md == nil {
+		md = &BaseModelMetadata{}
+	}
+	sb, err := marshalModelSize(md.Size)
+	if err != nil {
+		return nil, err
+	}
+	gb, err := marshalGGUFMetadata(md.GGUFMetadata)
+	if err != nil {
+		return nil, err
+	}
+	hb, err := marshalHuggingFaceMetadata(md.HuggingFaceMetadata)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"size":                  sb,
+		"gguf_metadata":         gb,
+		"hugging_face_metadata": hb,
+	}, nil
+}
+
+// UpdateBaseModelMetadataInTransaction updates the metadata of a base model in a transaction.
+func UpdateBaseModelMetadataInTransaction(tx *gorm.DB, k ModelKey, md *BaseModelMetadata) error {
+	updates, err := md.toUpdates()
+	if err != nil {
+		return err
+	}
+	return k.buildQuery(tx.Model(&BaseModel{})).Updates(updates).Error
+}
+
+// UpdateBaseModelToFailedStatus updates the loading status to FAILED and updates other relevant information.
+//
+// The model is requeued at nextAttemptAt unless it is zero. maxAttempts is recorded for visibility.
+// It returns ErrConcurrentUpdate if the given lease holder no longer holds the lease.
+func (s *S) UpdateBaseModelToFailedStatus(k ModelKey, leaseHolder string, failureReason string, nextAttemptAt time.Time, maxAttempts int) error {
+	return s.updateLeasedBaseModel(
 		k,
-		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+		[]v1.ModelLoadingStatus{v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING},
+		leaseHolder,
 		map[string]interface{}{
-			"path":            path,
-			"formats":         b,
-			"gguf_model_path": ggufModelPath,
-			"loading_status":  v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
+			"loading_failure_reason":  failureReason,
+			"loading_status":          v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED,
+			"loading_lease_holder":    "",
+			"next_loading_attempt_at": nextAttemptAt,
+			"max_loading_attempts":    maxAttempts,
 		},
 	)
 }
 
-// UpdateBaseModelToFailedStatus updates the loading status to FAILED and updates other relevant information.
-func (s *S) UpdateBaseModelToFailedStatus(k ModelKey, failureReason string) error {
+// RequeueFailedBaseModels moves failed base models whose next attempt time has come back to the
+// REQUESTED status. It returns the number of requeued models.
+func (s *S) RequeueFailedBaseModels(now time.Time) (int64, error) {
+	res := s.db.Model(&BaseModel{}).
+		Where("loading_status = ? AND next_loading_attempt_at > ? AND next_loading_attempt_at <= ?", v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED, time.Time{}, now).
+		Updates(map[string]interface{}{
+			"loading_status":          v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+			"next_loading_attempt_at": time.Time{},
+			// Clear the result of the previous attempt.
+			"loading_failure_reason": "",
+			"loading_progress":       nil,
+		})
+	if err := res.Error; err != nil {
+		return 0, err
+	}
+	return res.RowsAffected, nil
+}
+
+// UpdateBaseModelToCancellingStatus updates the loading status of a base model being loaded to CANCELLING.
+func (s *S) UpdateBaseModelToCancellingStatus(k ModelKey) error {
 	return s.updateBaseModel(
 		k,
-		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+		[]v1.ModelLoadingStatus{v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING},
+		map[string]interface{}{
+			"loading_status": v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING,
+		},
+	)
+}
+
+// UpdateBaseModelToCancelledStatus updates the loading status to CANCELLED if the current status is one of the given ones.
+// It returns ErrConcurrentUpdate if the given lease holder no longer holds the lease.
+func (s *S) UpdateBaseModelToCancelledStatus(k ModelKey, leaseHolder string, curr ...v1.ModelLoadingStatus) error {
+	return UpdateBaseModelToCancelledStatusInTransaction(s.db, k, leaseHolder, curr...)
+}
+
+// UpdateBaseModelToCancelledStatusInTransaction updates the loading status to CANCELLED in a transaction.
+func UpdateBaseModelToCancelledStatusInTransaction(tx *gorm.DB, k ModelKey, leaseHolder string, curr ...v1.ModelLoadingStatus) error {
+	return updateLeasedBaseModelInTransaction(
+		tx,
+		k,
+		curr,
+		leaseHolder,
 		map[string]interface{}{
-			"loading_failure_reason": failureReason,
-			"loading_status":         v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED,
+			"loading_status":          v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED,
+			"loading_lease_holder":    "",
+			"next_loading_attempt_at": time.Time{},
 		},
 	)
 }
 
-// UpdateBaseModelLoadingStatusMessage updates the loading status message of a base model.
-func (s *S) UpdateBaseModelLoadingStatusMessage(k ModelKey, msg string) error {
+// UpdateBaseModelToDegradedStatus marks a loaded or degraded base model as degraded with the reason.
+func (s *S) UpdateBaseModelToDegradedStatus(k ModelKey, reason string) error {
 	res := k.buildQuery(s.db.Model(&BaseModel{})).
+		Where("(loading_status IS NULL OR loading_status IN ?)", loadedOrDegradedLoadingStatuses).
 		Updates(map[string]interface{}{
-			"loading_status_message": msg,
+			"loading_status":         v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED,
+			"loading_failure_reason": reason,
 		})
 	if err := res.Error; err != nil {
 		return err
@@ -329,6 +707,75 @@ func (s *S) UpdateBaseModelLoadingStatusMessage(k ModelKey, msg string) error {
 	return nil
 }
 
+// UpdateBaseModelToRestoredStatus marks a degraded base model as loaded again.
+func (s *S) UpdateBaseModelToRestoredStatus(k ModelKey) error {
+	return s.updateBaseModel(
+		k,
+		[]v1.ModelLoadingStatus{v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED},
+		map[string]interface{}{
+			"loading_status":         v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
+			"loading_failure_reason": "",
+		},
+	)
+}
+
+// ListLoadedOrDegradedBaseModels lists the base models of the tenant that have been loaded by the cluster,
+// including the degraded ones.
+func (s *S) ListLoadedOrDegradedBaseModels(tenantID, clusterID string) ([]*BaseModel, error) {
+	var ms []*BaseModel
+	if err := s.db.
+		Where("tenant_id = ? AND loaded_cluster_id = ?", tenantID, clusterID).
+		Where("(loading_status IS NULL OR loading_status IN ?)", loadedOrDegradedLoadingStatuses).
+		Order("id").
+		Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// UpdateBaseModelToRetryRequestedStatus moves a failed or cancelled base model back to the REQUESTED status
+// and resets its attempt count.
+func (s *S) UpdateBaseModelToRetryRequestedStatus(k ModelKey) error {
+	return s.updateBaseModel(
+		k,
+		retryableLoadingStatuses,
+		map[string]interface{}{
+			"loading_status":          v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+			"loading_attempt_count":   0,
+			"next_loading_attempt_at": time.Time{},
+			"loading_failure_reason":  "",
+			"loading_progress":        nil,
+		},
+	)
+}
+
+// UpdateBaseModelLoadingStatusMessage updates the loading status message and the loading progress of a base model.
+// The progress is kept unchanged if it is nil.
+func (s *S) UpdateBaseModelLoadingStatusMessage(k ModelKey, msg, uploadPath string, progress *v1.ModelLoadingProgress) error {
+	updates := map[string]interface{}{
+		"loading_status_message": msg,
+	}
+	if uploadPath != "" {
+		updates["upload_path"] = uploadPath
+	}
+	if progress != nil {
+		b, err := proto.Marshal(progress)
+		if err != nil {
+			return err
+		}
+		updates["loading_progress"] = b
+	}
+	res := k.buildQuery(s.db.Model(&BaseModel{})).
+		Updates(updates)
+	if err := res.Error; err != nil {
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return ErrConcurrentUpdate
+	}
+	return nil
+}
+
 // CountBaseModels counts the total number of base models.
 func (s *S) CountBaseModels(projectID, tenantID string, includeLoadingModels bool) (int64, error) {
 	q := s.db.Model(&BaseModel{}).
@@ -348,9 +795,59 @@ func (s *S) CountBaseModels(projectID, tenantID string, includeLoadingModels boo
 	return count, nil
 }
 
+// CountPendingBaseModelsWithRaisedPriority returns the number of the base models of the tenant and the project
+// that have positive priorities and have not been loaded or cancelled yet. Base models that are not
+// project-scoped are counted if the project ID is empty.
+func (s *S) CountPendingBaseModelsWithRaisedPriority(tenantID, projectID string) (int64, error) {
+	q := s.db.Model(&BaseModel{}).
+		Where("tenant_id = ? AND priority > 0 AND loading_status IN ?", tenantID, pendingLoadingStatuses)
+	if projectID == "" {
+		q = q.Where("(project_id IS NULL OR project_id = '')")
+	} else {
+		q = q.Where("project_id = ?", projectID)
+	}
+	var count int64
+	if err := q.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func marshalFormats(formats []v1.ModelFormat) ([]byte, error) {
 	p := v1.ModelFormats{
 		Formats: formats,
 	}
 	return proto.Marshal(&p)
 }
+
+// marshalModelSize marshals a model size. It returns nil if the size is not reported.
+func marshalModelSize(size *v1.ModelSize) ([]byte, error) {
+	if size == nil {
+		return nil, nil
+	}
+	return proto.Marshal(size)
+}
+
+// marshalGGUFMetadata marshals GGUF metadata. It returns nil if no metadata is extracted.
+func marshalGGUFMetadata(md *v1.GGUFMetadata) ([]byte, error) {
+	if md == nil {
+		return nil, nil
+	}
+	return proto.Marshal(md)
+}
+
+// marshalHuggingFaceMetadata marshals Hugging Face metadata. It returns nil if no metadata is extracted.
+func marshalHuggingFaceMetadata(md *v1.HuggingFaceMetadata) ([]byte, error) {
+	if md == nil {
+		return nil, nil
+	}
+	return proto.Marshal(md)
+}
+
+// marshalFilePatterns marshals file patterns. It returns nil if no pattern is specified.
+func marshalFilePatterns(p *v1.FilePatterns) ([]byte, error) {
+	if len(p.GetInclude()) == 0 && len(p.GetExclude()) == 0 {
+		return nil, nil
+	}
+	return proto.Marshal(p)
+}
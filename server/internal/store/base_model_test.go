@@ -3,6 +3,7 @@ package store
 import (
 	"errors"
 	"testing"
+	"time"
 
 	gerrors "github.com/llmariner/common/pkg/gormlib/errors"
 	v1 "github.com/llmariner/model-manager/api/v1"
@@ -27,7 +28,7 @@ func TestBaseModel(t *testing.T) {
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
 
-	_, err = st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err = st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 	assert.NoError(t, err)
 
 	gotM, err := st.GetBaseModel(k)
@@ -80,10 +81,10 @@ func TestBaseModel_UniqueConstraint(t *testing.T) {
 		ModelID:  "m0",
 		TenantID: "t0",
 	}
-	_, err := st.CreateBaseModel(k0, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err := st.CreateBaseModel(k0, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 	assert.NoError(t, err)
 
-	_, err = st.CreateBaseModel(k0, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err = st.CreateBaseModel(k0, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 	assert.Error(t, err)
 	assert.True(t, gerrors.IsUniqueConstraintViolation(err))
 
@@ -91,7 +92,7 @@ func TestBaseModel_UniqueConstraint(t *testing.T) {
 		ModelID:  "m1",
 		TenantID: "t0",
 	}
-	_, err = st.CreateBaseModel(k1, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err = st.CreateBaseModel(k1, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 	assert.NoError(t, err)
 }
 
@@ -108,7 +109,7 @@ func TestDeleteBaseModel(t *testing.T) {
 		ModelID:  modelID,
 		TenantID: tenantID,
 	}
-	_, err := st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err := st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 	assert.NoError(t, err)
 
 	err = st.DeleteBaseModel(k)
@@ -131,21 +132,21 @@ func TestListUnloadedBaseModels(t *testing.T) {
 		ModelID:  "m0",
 		TenantID: "t0",
 	}
-	_, err := st.CreateBaseModelWithLoadingRequested(k0, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err := st.CreateBaseModelWithLoadingRequested(k0, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", nil, "", "", 0)
 	assert.NoError(t, err)
 
 	k1 := ModelKey{
 		ModelID:  "m1",
 		TenantID: "t1",
 	}
-	_, err = st.CreateBaseModelWithLoadingRequested(k1, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err = st.CreateBaseModelWithLoadingRequested(k1, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", nil, "", "", 0)
 	assert.NoError(t, err)
 
 	k2 := ModelKey{
 		ModelID:  "m2",
 		TenantID: "t0",
 	}
-	_, err = st.CreateBaseModel(k2, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err = st.CreateBaseModel(k2, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf_model_path", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 	assert.NoError(t, err)
 
 	ms, err := st.ListUnloadedBaseModels("t0")
@@ -167,26 +168,26 @@ func TestListLoadingBaseModels(t *testing.T) {
 		ModelID:  "m0",
 		TenantID: "t0",
 	}
-	_, err := st.CreateBaseModelWithLoadingRequested(k0, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err := st.CreateBaseModelWithLoadingRequested(k0, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", nil, "", "", 0)
 	assert.NoError(t, err)
 
 	k1 := ModelKey{
 		ModelID:  "m1",
 		TenantID: "t0",
 	}
-	_, err = st.CreateBaseModelWithLoadingRequested(k1, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err = st.CreateBaseModelWithLoadingRequested(k1, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", nil, "", "", 0)
 	assert.NoError(t, err)
 
 	k2 := ModelKey{
 		ModelID:  "m2",
 		TenantID: "t1",
 	}
-	_, err = st.CreateBaseModelWithLoadingRequested(k2, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err = st.CreateBaseModelWithLoadingRequested(k2, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", nil, "", "", 0)
 	assert.NoError(t, err)
 
-	err = st.UpdateBaseModelToLoadingStatus(k0)
+	err = st.UpdateBaseModelToLoadingStatus(k0, "", time.Time{})
 	assert.NoError(t, err)
-	err = st.UpdateBaseModelToLoadingStatus(k2)
+	err = st.UpdateBaseModelToLoadingStatus(k2, "", time.Time{})
 	assert.NoError(t, err)
 
 	ms, err := st.ListLoadingBaseModels("t0")
@@ -213,14 +214,14 @@ func TestUpdateBaseModel(t *testing.T) {
 		ModelID:  modelID,
 		TenantID: tenantID,
 	}
-	_, err := st.CreateBaseModelWithLoadingRequested(k, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+	_, err := st.CreateBaseModelWithLoadingRequested(k, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", nil, "", "", 0)
 	assert.NoError(t, err)
 
 	m, err := st.GetBaseModel(k)
 	assert.NoError(t, err)
 	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, m.LoadingStatus)
 
-	err = st.UpdateBaseModelToLoadingStatus(k)
+	err = st.UpdateBaseModelToLoadingStatus(k, "loader0", time.Time{})
 	assert.NoError(t, err)
 
 	m, err = st.GetBaseModel(k)
@@ -228,30 +229,57 @@ func TestUpdateBaseModel(t *testing.T) {
 	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING, m.LoadingStatus)
 
 	// Failed to update as the current state does not match.
-	err = st.UpdateBaseModelToLoadingStatus(k)
+	err = st.UpdateBaseModelToLoadingStatus(k, "", time.Time{})
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, ErrConcurrentUpdate))
 
+	// Failed to update as another loader holds the lease.
+	err = st.UpdateBaseModelToSucceededStatus(k, "loader1", "", "path", nil, "", "", nil)
+	assert.True(t, errors.Is(err, ErrConcurrentUpdate))
+	err = st.UpdateBaseModelToFailedStatus(k, "loader1", "error", time.Time{}, 0)
+	assert.True(t, errors.Is(err, ErrConcurrentUpdate))
+
 	err = st.UpdateBaseModelToSucceededStatus(
 		k,
+		"loader0",
+		"c0",
 		"path",
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 		"gguf_model_path",
+		"",
+		&BaseModelMetadata{
+			Size:         &v1.ModelSize{TotalBytes: 100},
+			GGUFMetadata: &v1.GGUFMetadata{Architecture: "llama"},
+			HuggingFaceMetadata: &v1.HuggingFaceMetadata{
+				Architecture: "LlamaForCausalLM",
+			},
+		},
 	)
 	assert.NoError(t, err)
 
 	m, err = st.GetBaseModel(k)
 	assert.NoError(t, err)
 	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED, m.LoadingStatus)
+	assert.Empty(t, m.LoadingLeaseHolder)
 	assert.Equal(t, "path", m.Path)
 	assert.Equal(t, "gguf_model_path", m.GGUFModelPath)
+	assert.Equal(t, "c0", m.LoadedClusterID)
+	size, err := UnmarshalModelSize(m.Size)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), size.TotalBytes)
+	md, err := UnmarshalGGUFMetadata(m.GGUFMetadata)
+	assert.NoError(t, err)
+	assert.Equal(t, "llama", md.Architecture)
+	hmd, err := UnmarshalHuggingFaceMetadata(m.HuggingFaceMetadata)
+	assert.NoError(t, err)
+	assert.Equal(t, "LlamaForCausalLM", hmd.Architecture)
 
 	// Set the stateus back to Loading.
 	m.LoadingStatus = v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING
 	err = st.db.Save(m).Error
 	assert.NoError(t, err)
 
-	err = st.UpdateBaseModelToFailedStatus(k, "error")
+	err = st.UpdateBaseModelToFailedStatus(k, "", "error", time.Time{}, 0)
 	assert.NoError(t, err)
 
 	m, err = st.GetBaseModel(k)
@@ -260,6 +288,126 @@ func TestUpdateBaseModel(t *testing.T) {
 	assert.Equal(t, "error", m.LoadingFailureReason)
 }
 
+func TestBaseModelLoadingLease(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	k := ModelKey{
+		ModelID:  "m0",
+		TenantID: "t0",
+	}
+	_, err := st.CreateBaseModelWithLoadingRequested(k, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", nil, "", "", 0)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	err = st.UpdateBaseModelToLoadingStatus(k, "c0/l0", now.Add(time.Minute))
+	assert.NoError(t, err)
+
+	m, err := st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, "c0/l0", m.LoadingLeaseHolder)
+
+	// Only the lease holder can renew the lease.
+	err = st.RenewBaseModelLoadingLease(k, "c0/l1", now.Add(2*time.Minute))
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+	err = st.RenewBaseModelLoadingLease(k, "c0/l0", now.Add(2*time.Minute))
+	assert.NoError(t, err)
+
+	// The lease has not expired yet.
+	n, err := st.ReleaseExpiredBaseModelLoadingLeases(now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+
+	n, err = st.ReleaseExpiredBaseModelLoadingLeases(now.Add(3 * time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	m, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, m.LoadingStatus)
+	assert.Empty(t, m.LoadingLeaseHolder)
+
+	// The previous lease holder can no longer renew the lease.
+	err = st.RenewBaseModelLoadingLease(k, "c0/l0", now.Add(4*time.Minute))
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+
+	// A model loaded without a lease is never released.
+	err = st.UpdateBaseModelToLoadingStatus(k, "", time.Time{})
+	assert.NoError(t, err)
+	n, err = st.ReleaseExpiredBaseModelLoadingLeases(now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+}
+
+func TestBaseModelLoadingRetry(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	k := ModelKey{
+		ModelID:  "m0",
+		TenantID: "t0",
+	}
+	_, err := st.CreateBaseModelWithLoadingRequested(k, v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", nil, "", "", 0)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	err = st.UpdateBaseModelToLoadingStatus(k, "", time.Time{})
+	assert.NoError(t, err)
+	err = st.UpdateBaseModelLoadingStatusMessage(k, "downloading", "", &v1.ModelLoadingProgress{TotalBytes: 100, DownloadedBytes: 10})
+	assert.NoError(t, err)
+	err = st.UpdateBaseModelToFailedStatus(k, "", "error", now.Add(time.Minute), 3)
+	assert.NoError(t, err)
+
+	m, err := st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, m.LoadingAttemptCount)
+	assert.Equal(t, 3, m.MaxLoadingAttempts)
+
+	// The next attempt time has not come yet.
+	n, err := st.RequeueFailedBaseModels(now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+
+	n, err = st.RequeueFailedBaseModels(now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	m, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, m.LoadingStatus)
+	// The result of the previous attempt is cleared.
+	assert.Empty(t, m.LoadingFailureReason)
+	assert.Empty(t, m.LoadingProgress)
+
+	// The model is not requeued once no more attempt is scheduled.
+	err = st.UpdateBaseModelToLoadingStatus(k, "", time.Time{})
+	assert.NoError(t, err)
+	err = st.UpdateBaseModelToFailedStatus(k, "", "error", time.Time{}, 3)
+	assert.NoError(t, err)
+
+	n, err = st.RequeueFailedBaseModels(now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+
+	m, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED, m.LoadingStatus)
+	assert.Equal(t, 2, m.LoadingAttemptCount)
+
+	// A manual retry resets the attempt count.
+	err = st.UpdateBaseModelToRetryRequestedStatus(k)
+	assert.NoError(t, err)
+
+	m, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, m.LoadingStatus)
+	assert.Equal(t, 0, m.LoadingAttemptCount)
+
+	// A model that has not failed cannot be retried.
+	err = st.UpdateBaseModelToRetryRequestedStatus(k)
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+}
+
 func TestListBaseModelsByActivationStatusWithPagination(t *testing.T) {
 	st, tearDown := NewTest(t)
 	defer tearDown()
@@ -272,7 +420,7 @@ func TestListBaseModelsByActivationStatusWithPagination(t *testing.T) {
 			ModelID:  id,
 			TenantID: tenantID,
 		}
-		_, err := st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+		_, err := st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 		assert.NoError(t, err)
 		status := v1.ActivationStatus_ACTIVATION_STATUS_ACTIVE
 		if i == 2 {
@@ -326,7 +474,7 @@ func TestListBaseModelsByActivationStatusWithPagination_ProjectScoped(t *testing
 	}
 
 	for _, k := range keys {
-		_, err := st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+		_, err := st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 		assert.NoError(t, err)
 
 		err = st.CreateModelActivationStatus(&ModelActivationStatus{
@@ -411,7 +559,7 @@ func TestListBaseModelsByModelIDAndTenantID(t *testing.T) {
 	}
 
 	for _, k := range keys {
-		_, err := st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE)
+		_, err := st.CreateBaseModel(k, "path", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}, "gguf", v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE, "", "", nil)
 		assert.NoError(t, err)
 	}
 
@@ -472,6 +620,9 @@ func TestCountBaseModels(t *testing.T) {
 			[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 			"gguf",
 			v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+			"",
+			"",
+			nil,
 		)
 		assert.NoError(t, err)
 	}
@@ -546,13 +697,42 @@ func TestUpdateBaseModelLoadingStatusMessage(t *testing.T) {
 		[]v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF},
 		"gguf_model_path",
 		v1.SourceRepository_SOURCE_REPOSITORY_OBJECT_STORE,
+		"",
+		"",
+		nil,
 	)
 	assert.NoError(t, err)
 
-	err = st.UpdateBaseModelLoadingStatusMessage(k, "current msg")
+	err = st.UpdateBaseModelLoadingStatusMessage(k, "current msg", "", nil)
 	assert.NoError(t, err)
 
 	got, err := st.GetBaseModel(k)
 	assert.NoError(t, err)
 	assert.Equal(t, "current msg", got.LoadingStatusMessage)
+	progress, err := UnmarshalLoadingProgress(got.LoadingProgress)
+	assert.NoError(t, err)
+	assert.Nil(t, progress)
+
+	err = st.UpdateBaseModelLoadingStatusMessage(k, "new msg", "upload_path", &v1.ModelLoadingProgress{
+		TotalBytes:      100,
+		DownloadedBytes: 50,
+	})
+	assert.NoError(t, err)
+
+	got, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, "new msg", got.LoadingStatusMessage)
+	assert.Equal(t, "upload_path", got.UploadPath)
+	progress, err = UnmarshalLoadingProgress(got.LoadingProgress)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), progress.TotalBytes)
+	assert.Equal(t, int64(50), progress.DownloadedBytes)
+
+	// The upload path is kept if it is not reported.
+	err = st.UpdateBaseModelLoadingStatusMessage(k, "last msg", "", nil)
+	assert.NoError(t, err)
+
+	got, err = st.GetBaseModel(k)
+	assert.NoError(t, err)
+	assert.Equal(t, "upload_path", got.UploadPath)
 }
@@ -15,6 +15,10 @@ type HFModelRepo struct {
 	ModelID   string `gorm:"uniqueIndex:idx_hf_model_repo_model_id_tenant_id_project_id"`
 	TenantID  string `gorm:"uniqueIndex:idx_hf_model_repo_name_tenant_id_project_id;uniqueIndex:idx_hf_model_repo_model_id_tenant_id_project_id"`
 	ProjectID string `gorm:"uniqueIndex:idx_hf_model_repo_name_tenant_id_project_id;uniqueIndex:idx_hf_model_repo_model_id_tenant_id_project_id"`
+
+	// Revision is the commit SHA of the downloaded repository. It is empty if the repository was
+	// downloaded before revisions were recorded.
+	Revision string
 }
 
 // CreateHFModelRepo creates a model repo.
@@ -0,0 +1,44 @@
+package store
+
+import (
+	v1 "github.com/llmariner/model-manager/api/v1"
+)
+
+var (
+	// inProgressLoadingStatuses are the loading statuses of the models that a loader holds the lease of.
+	inProgressLoadingStatuses = []v1.ModelLoadingStatus{
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING,
+	}
+
+	// pendingLoadingStatuses are the loading statuses of the models that have not been loaded yet but
+	// will be uploaded to the object store unless cancelled.
+	pendingLoadingStatuses = []v1.ModelLoadingStatus{
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING,
+	}
+
+	// retryableLoadingStatuses are the loading statuses of the models that can be requeued by users.
+	retryableLoadingStatuses = []v1.ModelLoadingStatus{
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED,
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED,
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED,
+	}
+
+	// loadedOrDegradedLoadingStatuses are the loading statuses of the loaded models, including the ones
+	// whose files are found missing in the object store. The UNSPECIFIED status is considered as loaded
+	// for backward compatibility.
+	loadedOrDegradedLoadingStatuses = []v1.ModelLoadingStatus{
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_UNSPECIFIED,
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED,
+	}
+
+	// expiredLeaseTransitions maps the loading status of a model whose lease has expired to its next status.
+	// A model being cancelled is not included since its uploaded objects need to be deleted when it is
+	// moved to the CANCELLED status.
+	expiredLeaseTransitions = map[v1.ModelLoadingStatus]v1.ModelLoadingStatus{
+		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING: v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+	}
+)
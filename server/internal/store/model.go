@@ -1,7 +1,10 @@
 package store
 
 import (
+	"time"
+
 	v1 "github.com/llmariner/model-manager/api/v1"
+	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 )
 
@@ -28,9 +31,41 @@ type Model struct {
 	LoadingStatus        v1.ModelLoadingStatus
 	LoadingFailureReason string
 	LoadingStatusMessage string
+	// LoadingProgress is the marshalled v1.ModelLoadingProgress reported by the loader.
+	LoadingProgress []byte
+
+	// Size is the marshalled v1.ModelSize reported by the loader. It is empty if the model was loaded
+	// by a loader that does not report the size.
+	Size []byte
+
+	// LoadingLeaseHolder is the ID of the loader that is loading the model. It is empty if
+	// the model was acquired by a loader that does not support leases.
+	LoadingLeaseHolder string
+	// LoadingLeaseExpiresAt is the time when the lease expires. The model goes back to the
+	// REQUESTED status if the lease holder does not renew the lease by then.
+	LoadingLeaseExpiresAt time.Time
+
+	// LoadedClusterID is the ID of the cluster whose loader uploaded the model to the object store of the
+	// cluster. It is empty if the model was loaded before the cluster was recorded.
+	LoadedClusterID string
+
+	// LoadingAttemptCount is the number of times that loaders have attempted to load the model.
+	LoadingAttemptCount int
+	// NextLoadingAttemptAt is the time when the failed model is requeued for another attempt.
+	// It is zero if no more attempt is scheduled.
+	NextLoadingAttemptAt time.Time
+	// MaxLoadingAttempts is the maximum number of attempts that was applied when the model failed to load.
+	MaxLoadingAttempts int
 
 	SourceRepository  v1.SourceRepository
 	ModelFileLocation string
+
+	// FilePatterns is the marshalled v1.FilePatterns that selects the model files to load. It is empty
+	// if all files are loaded.
+	FilePatterns []byte
+
+	// Priority is the priority of loading the model. Models with higher priorities are loaded first.
+	Priority int32
 }
 
 // ModelSpec represents a model spec that is passed to CreateModel.
@@ -47,6 +82,9 @@ type ModelSpec struct {
 	LoadingStatus     v1.ModelLoadingStatus
 	SourceRepository  v1.SourceRepository
 	ModelFileLocation string
+	FilePatterns      *v1.FilePatterns
+	Priority          int32
+	LoadedClusterID   string
 }
 
 // CreateModel creates a model.
@@ -56,6 +94,10 @@ func (s *S) CreateModel(spec ModelSpec) (*Model, error) {
 
 // CreateModelInTransaction creates a model in a transaction.
 func CreateModelInTransaction(tx *gorm.DB, spec ModelSpec) (*Model, error) {
+	fp, err := marshalFilePatterns(spec.FilePatterns)
+	if err != nil {
+		return nil, err
+	}
 	m := &Model{
 		ModelID:           spec.ModelID,
 		TenantID:          spec.TenantID,
@@ -69,6 +111,9 @@ func CreateModelInTransaction(tx *gorm.DB, spec ModelSpec) (*Model, error) {
 		LoadingStatus:     spec.LoadingStatus,
 		SourceRepository:  spec.SourceRepository,
 		ModelFileLocation: spec.ModelFileLocation,
+		FilePatterns:      fp,
+		Priority:          spec.Priority,
+		LoadedClusterID:   spec.LoadedClusterID,
 	}
 	if err := tx.Create(m).Error; err != nil {
 		return nil, err
@@ -87,8 +132,13 @@ func (s *S) GetPublishedModelByModelIDAndTenantID(modelID, tenantID string) (*Mo
 
 // GetModelByModelIDAndTenantID returns a model by model ID and tenant ID.
 func (s *S) GetModelByModelIDAndTenantID(modelID, tenantID string) (*Model, error) {
+	return GetModelByModelIDAndTenantIDInTransaction(s.db, modelID, tenantID)
+}
+
+// GetModelByModelIDAndTenantIDInTransaction returns a model by model ID and tenant ID in a transaction.
+func GetModelByModelIDAndTenantIDInTransaction(tx *gorm.DB, modelID, tenantID string) (*Model, error) {
 	var m Model
-	if err := s.db.Where("model_id = ? AND tenant_id = ?", modelID, tenantID).Take(&m).Error; err != nil {
+	if err := tx.Where("model_id = ? AND tenant_id = ?", modelID, tenantID).Take(&m).Error; err != nil {
 		return nil, err
 	}
 	return &m, nil
@@ -163,16 +213,63 @@ func (s *S) ListUnloadedModels(tenantID string) ([]*Model, error) {
 	return ms, nil
 }
 
-// updateModel updates the model if the current status matches with the given one.
+// ListLoadingModels returns all fine-tuned models that are being loaded, including the ones being cancelled.
+func (s *S) ListLoadingModels(tenantID string) ([]*Model, error) {
+	var ms []*Model
+	if err := s.db.Where("tenant_id = ? AND loading_status IN ?", tenantID, inProgressLoadingStatuses).
+		Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// ListPendingModels returns all fine-tuned models of the tenant that have been requested to load and have not
+// been loaded or cancelled yet.
+func (s *S) ListPendingModels(tenantID string) ([]*Model, error) {
+	var ms []*Model
+	if err := s.db.Where("tenant_id = ? AND loading_status IN ?", tenantID, pendingLoadingStatuses).
+		Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// updateModel updates the model if the current status matches with one of the given ones.
 func (s *S) updateModel(
 	modelID string,
 	tenantID string,
-	curr v1.ModelLoadingStatus,
+	curr []v1.ModelLoadingStatus,
 	updates map[string]interface{},
 ) error {
-	res := s.db.Model(&Model{}).
-		Where("model_id = ? AND tenant_id = ? AND loading_status = ?", modelID, tenantID, curr).
-		Updates(updates)
+	return s.updateLeasedModel(modelID, tenantID, curr, "", updates)
+}
+
+// updateLeasedModel updates the model if the current status matches with one of the given ones and
+// the given loader holds the lease of the model. The lease is not checked if the lease holder is empty.
+func (s *S) updateLeasedModel(
+	modelID string,
+	tenantID string,
+	curr []v1.ModelLoadingStatus,
+	leaseHolder string,
+	updates map[string]interface{},
+) error {
+	return updateLeasedModelInTransaction(s.db, modelID, tenantID, curr, leaseHolder, updates)
+}
+
+func updateLeasedModelInTransaction(
+	tx *gorm.DB,
+	modelID string,
+	tenantID string,
+	curr []v1.ModelLoadingStatus,
+	leaseHolder string,
+	updates map[string]interface{},
+) error {
+	q := tx.Model(&Model{}).
+		Where("model_id = ? AND tenant_id = ? AND loading_status IN ?", modelID, tenantID, curr)
+	if leaseHolder != "" {
+		q = q.Where("loading_lease_holder = ?", leaseHolder)
+	}
+	res := q.Updates(updates)
 	if err := res.Error; err != nil {
 		return err
 	}
@@ -182,49 +279,194 @@ func (s *S) updateModel(
 	return nil
 }
 
-// UpdateModelToLoadingStatus updates the loading status to LOADING.
-func (s *S) UpdateModelToLoadingStatus(modelID string, tenantID string) error {
+// UpdateModelToLoadingStatus updates the loading status to LOADING and records the lease of the loader.
+func (s *S) UpdateModelToLoadingStatus(modelID string, tenantID string, leaseHolder string, leaseExpiresAt time.Time) error {
 	return s.updateModel(
 		modelID,
 		tenantID,
-		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+		[]v1.ModelLoadingStatus{v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED},
 		map[string]interface{}{
-			"loading_status": v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+			"loading_status":           v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+			"loading_lease_holder":     leaseHolder,
+			"loading_lease_expires_at": leaseExpiresAt,
+			"loading_attempt_count":    gorm.Expr("COALESCE(loading_attempt_count, 0) + 1"),
 		},
 	)
 }
 
+// RenewModelLoadingLease extends the lease of a model that is being loaded or cancelled. It returns
+// ErrConcurrentUpdate if the given lease holder no longer holds the lease.
+func (s *S) RenewModelLoadingLease(modelID, tenantID, leaseHolder string, leaseExpiresAt time.Time) error {
+	res := s.db.Model(&Model{}).
+		Where("model_id = ? AND tenant_id = ? AND loading_status IN ? AND loading_lease_holder = ?", modelID, tenantID, inProgressLoadingStatuses, leaseHolder).
+		Updates(map[string]interface{}{
+			"loading_lease_expires_at": leaseExpiresAt,
+		})
+	if err := res.Error; err != nil {
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return ErrConcurrentUpdate
+	}
+	return nil
+}
+
+// ReleaseExpiredModelLoadingLeases moves models whose leases have expired back to the REQUESTED status
+// so that other loaders can pick them up. It returns the number of released models. Models being cancelled
+// are not released. See ListModelsWithExpiredCancellingLeases.
+func (s *S) ReleaseExpiredModelLoadingLeases(now time.Time) (int64, error) {
+	var n int64
+	for curr, next := range expiredLeaseTransitions {
+		res := s.db.Model(&Model{}).
+			Where("loading_status = ? AND loading_lease_holder != '' AND loading_lease_expires_at < ?", curr, now).
+			Updates(map[string]interface{}{
+				"loading_status":       next,
+				"loading_lease_holder": "",
+			})
+		if err := res.Error; err != nil {
+			return 0, err
+		}
+		n += res.RowsAffected
+	}
+	return n, nil
+}
+
+// ListModelsWithExpiredCancellingLeases lists the models being cancelled whose leases have expired.
+func (s *S) ListModelsWithExpiredCancellingLeases(now time.Time) ([]*Model, error) {
+	var ms []*Model
+	if err := s.db.
+		Where("loading_status = ? AND loading_lease_holder != '' AND loading_lease_expires_at < ?", v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING, now).
+		Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
 // UpdateModelToSucceededStatus updates the loading status to SUCCEEDED and updates other relevant information.
-func (s *S) UpdateModelToSucceededStatus(modelID string, tenantID string) error {
-	return s.updateModel(
+// It returns ErrConcurrentUpdate if the given lease holder no longer holds the lease.
+func (s *S) UpdateModelToSucceededStatus(modelID string, tenantID string, leaseHolder string, clusterID string, size *v1.ModelSize) error {
+	return UpdateModelToSucceededStatusInTransaction(s.db, modelID, tenantID, leaseHolder, clusterID, size)
+}
+
+// UpdateModelToSucceededStatusInTransaction updates the loading status to SUCCEEDED and updates other relevant
+// information in a transaction.
+func UpdateModelToSucceededStatusInTransaction(tx *gorm.DB, modelID string, tenantID string, leaseHolder string, clusterID string, size *v1.ModelSize) error {
+	b, err := marshalModelSize(size)
+	if err != nil {
+		return err
+	}
+	return updateLeasedModelInTransaction(
+		tx,
 		modelID,
 		tenantID,
-		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+		inProgressLoadingStatuses,
+		leaseHolder,
 		map[string]interface{}{
-			"loading_status": v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
+			"loading_status":       v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
+			"loading_lease_holder": "",
+			"loaded_cluster_id":    clusterID,
+			"size":                 b,
 		},
 	)
 }
 
 // UpdateModelToFailedStatus updates the loading status to FAILED and updates other relevant information.
-func (s *S) UpdateModelToFailedStatus(modelID string, tenantID string, failureReason string) error {
+//
+// The model is requeued at nextAttemptAt unless it is zero. maxAttempts is recorded for visibility.
+// It returns ErrConcurrentUpdate if the given lease holder no longer holds the lease.
+func (s *S) UpdateModelToFailedStatus(modelID string, tenantID string, leaseHolder string, failureReason string, nextAttemptAt time.Time, maxAttempts int) error {
+	return s.updateLeasedModel(
+		modelID,
+		tenantID,
+		[]v1.ModelLoadingStatus{v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING},
+		leaseHolder,
+		map[string]interface{}{
+			"loading_failure_reason":  failureReason,
+			"loading_status":          v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED,
+			"loading_lease_holder":    "",
+			"next_loading_attempt_at": nextAttemptAt,
+			"max_loading_attempts":    maxAttempts,
+		},
+	)
+}
+
+// RequeueFailedModels moves failed models whose next attempt time has come back to the
+// REQUESTED status. It returns the number of requeued models.
+func (s *S) RequeueFailedModels(now time.Time) (int64, error) {
+	res := s.db.Model(&Model{}).
+		Where("loading_status = ? AND next_loading_attempt_at > ? AND next_loading_attempt_at <= ?", v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED, time.Time{}, now).
+		Updates(map[string]interface{}{
+			"loading_status":          v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+			"next_loading_attempt_at": time.Time{},
+			// Clear the result of the previous attempt.
+			"loading_failure_reason": "",
+			"loading_progress":       nil,
+		})
+	if err := res.Error; err != nil {
+		return 0, err
+	}
+	return res.RowsAffected, nil
+}
+
+// UpdateModelToCancellingStatus updates the loading status of a model being loaded to CANCELLING.
+func (s *S) UpdateModelToCancellingStatus(modelID string, tenantID string) error {
 	return s.updateModel(
 		modelID,
 		tenantID,
-		v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING,
+		[]v1.ModelLoadingStatus{v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING},
 		map[string]interface{}{
-			"loading_failure_reason": failureReason,
-			"loading_status":         v1.ModelLoadingStatus_MODEL_LOADING_STATUS_FAILED,
+			"loading_status": v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLING,
 		},
 	)
 }
 
-// UpdateModelLoadingStatusMessage updates the loading status message of a base model.
-func (s *S) UpdateModelLoadingStatusMessage(modelID, tenantID, msg string) error {
+// UpdateModelToCancelledStatus updates the loading status to CANCELLED if the current status is one of the given ones.
+// It returns ErrConcurrentUpdate if the given lease holder no longer holds the lease.
+func (s *S) UpdateModelToCancelledStatus(modelID string, tenantID string, leaseHolder string, curr ...v1.ModelLoadingStatus) error {
+	return UpdateModelToCancelledStatusInTransaction(s.db, modelID, tenantID, leaseHolder, curr...)
+}
+
+// UpdateModelToCancelledStatusInTransaction updates the loading status to CANCELLED in a transaction.
+func UpdateModelToCancelledStatusInTransaction(tx *gorm.DB, modelID string, tenantID string, leaseHolder string, curr ...v1.ModelLoadingStatus) error {
+	return updateLeasedModelInTransaction(
+		tx,
+		modelID,
+		tenantID,
+		curr,
+		leaseHolder,
+		map[string]interface{}{
+			"loading_status":          v1.ModelLoadingStatus_MODEL_LOADING_STATUS_CANCELLED,
+			"loading_lease_holder":    "",
+			"next_loading_attempt_at": time.Time{},
+		},
+	)
+}
+
+// UpdateModelToRetryRequestedStatus moves a failed or cancelled model back to the REQUESTED status
+// and resets its attempt count.
+func (s *S) UpdateModelToRetryRequestedStatus(modelID string, tenantID string) error {
+	return s.updateModel(
+		modelID,
+		tenantID,
+		retryableLoadingStatuses,
+		map[string]interface{}{
+			"loading_status":          v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+			"loading_attempt_count":   0,
+			"next_loading_attempt_at": time.Time{},
+			"loading_failure_reason":  "",
+			"loading_progress":        nil,
+		},
+	)
+}
+
+// UpdateModelToDegradedStatus marks a loaded or degraded model as degraded with the reason.
+func (s *S) UpdateModelToDegradedStatus(modelID, tenantID, reason string) error {
 	res := s.db.Model(&Model{}).
 		Where("model_id = ? AND tenant_id = ?", modelID, tenantID).
+		Where("(loading_status IS NULL OR loading_status IN ?)", loadedOrDegradedLoadingStatuses).
 		Updates(map[string]interface{}{
-			"loading_status_message": msg,
+			"loading_status":         v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED,
+			"loading_failure_reason": reason,
 		})
 	if err := res.Error; err != nil {
 		return err
@@ -235,6 +477,58 @@ func (s *S) UpdateModelLoadingStatusMessage(modelID, tenantID, msg string) error
 	return nil
 }
 
+// UpdateModelToRestoredStatus marks a degraded model as loaded again.
+func (s *S) UpdateModelToRestoredStatus(modelID, tenantID string) error {
+	return s.updateModel(
+		modelID,
+		tenantID,
+		[]v1.ModelLoadingStatus{v1.ModelLoadingStatus_MODEL_LOADING_STATUS_DEGRADED},
+		map[string]interface{}{
+			"loading_status":         v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED,
+			"loading_failure_reason": "",
+		},
+	)
+}
+
+// ListLoadedOrDegradedModels lists the published models of the tenant that have been loaded by the cluster,
+// including the degraded ones.
+func (s *S) ListLoadedOrDegradedModels(tenantID, clusterID string) ([]*Model, error) {
+	var ms []*Model
+	if err := s.db.
+		Where("tenant_id = ? AND loaded_cluster_id = ? AND is_published = ?", tenantID, clusterID, true).
+		Where("(loading_status IS NULL OR loading_status IN ?)", loadedOrDegradedLoadingStatuses).
+		Order("id").
+		Find(&ms).Error; err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// UpdateModelLoadingStatusMessage updates the loading status message and the loading progress of a model.
+// The progress is kept unchanged if it is nil.
+func (s *S) UpdateModelLoadingStatusMessage(modelID, tenantID, msg string, progress *v1.ModelLoadingProgress) error {
+	updates := map[string]interface{}{
+		"loading_status_message": msg,
+	}
+	if progress != nil {
+		b, err := proto.Marshal(progress)
+		if err != nil {
+			return err
+		}
+		updates["loading_progress"] = b
+	}
+	res := s.db.Model(&Model{}).
+		Where("model_id = ? AND tenant_id = ?", modelID, tenantID).
+		Updates(updates)
+	if err := res.Error; err != nil {
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return ErrConcurrentUpdate
+	}
+	return nil
+}
+
 // DeleteModel deletes a model by model ID and tenant ID.
 func (s *S) DeleteModel(modelID, tenantID string) error {
 	return DeleteModelInTransaction(s.db, modelID, tenantID)
@@ -286,3 +580,15 @@ func (s *S) CountModelsByProjectID(
 
 	return count, nil
 }
+
+// CountPendingModelsWithRaisedPriority returns the number of the fine-tuned models of the project that have
+// positive priorities and have not been loaded or cancelled yet.
+func (s *S) CountPendingModelsWithRaisedPriority(tenantID, projectID string) (int64, error) {
+	var count int64
+	if err := s.db.Model(&Model{}).
+		Where("tenant_id = ? AND project_id = ? AND priority > 0 AND loading_status IN ?", tenantID, projectID, pendingLoadingStatuses).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
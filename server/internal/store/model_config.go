@@ -48,6 +48,15 @@ func GetModelConfigInTransaction(tx *gorm.DB, k ModelKey) (*ModelConfig, error)
 	return c, nil
 }
 
+// ListModelConfigsByTenantID returns all model configs for a tenant.
+func (s *S) ListModelConfigsByTenantID(tenantID string) ([]*ModelConfig, error) {
+	var cs []*ModelConfig
+	if err := s.db.Where("tenant_id = ?", tenantID).Find(&cs).Error; err != nil {
+		return nil, err
+	}
+	return cs, nil
+}
+
 // UpdateModelConfig updates the model config.
 func (s *S) UpdateModelConfig(k ModelKey, encodedConfig []byte) error {
 	return UpdateModelConfigInTransaction(s.db, k, encodedConfig)
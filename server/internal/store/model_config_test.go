@@ -45,3 +45,25 @@ func TestModelConfig(t *testing.T) {
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
 }
+
+func TestListModelConfigsByTenantID(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	for _, c := range []*ModelConfig{
+		{ModelID: "m0", TenantID: "t0"},
+		{ModelID: "m0", ProjectID: "p0", TenantID: "t0"},
+		{ModelID: "m1", TenantID: "t1"},
+	} {
+		err := st.CreateModelConfig(c)
+		assert.NoError(t, err)
+	}
+
+	cs, err := st.ListModelConfigsByTenantID("t0")
+	assert.NoError(t, err)
+	assert.Len(t, cs, 2)
+	for _, c := range cs {
+		assert.Equal(t, "t0", c.TenantID)
+		assert.Equal(t, "m0", c.ModelID)
+	}
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"gorm.io/gorm"
+)
+
+// ModelEvent represents a state change of a model. The ID of the event is used as its revision.
+type ModelEvent struct {
+	gorm.Model
+
+	TenantID string `gorm:"index"`
+	ModelID  string
+
+	// ProjectID is set only for project-scoped base models. This is set to empty
+	// for global-scoped base models and fine-tuned models.
+	ProjectID string
+
+	IsBaseModel bool
+
+	Type v1.ModelEventType
+}
+
+// CreateModelEvent creates a new model event.
+func (s *S) CreateModelEvent(e *ModelEvent) error {
+	return CreateModelEventInTransaction(s.db, e)
+}
+
+// CreateModelEventInTransaction creates a new model event in a transaction.
+func CreateModelEventInTransaction(tx *gorm.DB, e *ModelEvent) error {
+	if err := tx.Create(e).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListModelEventsAfterRevision lists the events of the tenant whose revisions are greater than the given one
+// in the ascending order of revisions.
+func (s *S) ListModelEventsAfterRevision(tenantID string, revision uint, limit int) ([]*ModelEvent, error) {
+	var es []*ModelEvent
+	if err := s.db.
+		Where("tenant_id = ? AND id > ?", tenantID, revision).
+		Order("id").
+		Limit(limit).
+		Find(&es).Error; err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
+// GetFirstModelEventRevisionCreatedAfter returns the revision of the first event created after the given time.
+// Zero is returned if there is no such event.
+func (s *S) GetFirstModelEventRevisionCreatedAfter(t time.Time) (uint, error) {
+	var e ModelEvent
+	if err := s.db.Where("created_at > ?", t).Order("id").First(&e).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return e.ID, nil
+}
+
+// HasModelEventCreatedAfter returns true if an event of the given type has been recorded for the model
+// after the given time.
+func (s *S) HasModelEventCreatedAfter(k ModelKey, isBaseModel bool, t v1.ModelEventType, after time.Time) (bool, error) {
+	var n int64
+	if err := s.db.Model(&ModelEvent{}).
+		Where("tenant_id = ? AND model_id = ? AND project_id = ? AND is_base_model = ? AND type = ? AND created_at > ?",
+			k.TenantID, k.ModelID, k.ProjectID, isBaseModel, t, after).
+		Count(&n).Error; err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// GetLatestModelEventRevision returns the revision of the latest event. Zero is returned if there is no event.
+func (s *S) GetLatestModelEventRevision() (uint, error) {
+	var e ModelEvent
+	if err := s.db.Order("id DESC").First(&e).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return e.ID, nil
+}
+
+// GetOldestModelEventRevision returns the revision of the oldest event that has not been pruned.
+// Zero is returned if there is no event.
+func (s *S) GetOldestModelEventRevision() (uint, error) {
+	var e ModelEvent
+	if err := s.db.Order("id").First(&e).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return e.ID, nil
+}
+
+// DeleteModelEventsCreatedBefore deletes the events created before the given time. The latest event is
+// always kept so that the revisions of new events keep increasing.
+func (s *S) DeleteModelEventsCreatedBefore(t time.Time) (int64, error) {
+	latest, err := s.GetLatestModelEventRevision()
+	if err != nil {
+		return 0, err
+	}
+	res := s.db.Unscoped().
+		Where("created_at < ? AND id < ?", t, latest).
+		Delete(&ModelEvent{})
+	if err := res.Error; err != nil {
+		return 0, err
+	}
+	return res.RowsAffected, nil
+}
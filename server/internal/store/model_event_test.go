@@ -0,0 +1,69 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelEvent(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	rev, err := st.GetLatestModelEventRevision()
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), rev)
+
+	for _, e := range []*ModelEvent{
+		{ModelID: "m0", TenantID: "t0", Type: v1.ModelEventType_MODEL_EVENT_TYPE_CREATED},
+		{ModelID: "m1", TenantID: "t1", Type: v1.ModelEventType_MODEL_EVENT_TYPE_CREATED},
+		{ModelID: "m0", TenantID: "t0", Type: v1.ModelEventType_MODEL_EVENT_TYPE_LOADED},
+	} {
+		err := st.CreateModelEvent(e)
+		assert.NoError(t, err)
+	}
+
+	es, err := st.ListModelEventsAfterRevision("t0", 0, 10)
+	assert.NoError(t, err)
+	assert.Len(t, es, 2)
+	assert.Equal(t, v1.ModelEventType_MODEL_EVENT_TYPE_CREATED, es[0].Type)
+	assert.Equal(t, v1.ModelEventType_MODEL_EVENT_TYPE_LOADED, es[1].Type)
+
+	es, err = st.ListModelEventsAfterRevision("t0", es[0].ID, 10)
+	assert.NoError(t, err)
+	assert.Len(t, es, 1)
+	assert.Equal(t, v1.ModelEventType_MODEL_EVENT_TYPE_LOADED, es[0].Type)
+
+	latest, err := st.GetLatestModelEventRevision()
+	assert.NoError(t, err)
+	assert.Equal(t, es[0].ID, latest)
+
+	first, err := st.GetFirstModelEventRevisionCreatedAfter(time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), first)
+	first, err = st.GetFirstModelEventRevisionCreatedAfter(time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, uint(0), first)
+
+	k := ModelKey{ModelID: "m0", TenantID: "t0"}
+	found, err := st.HasModelEventCreatedAfter(k, false, v1.ModelEventType_MODEL_EVENT_TYPE_LOADED, time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.True(t, found)
+	found, err = st.HasModelEventCreatedAfter(k, true, v1.ModelEventType_MODEL_EVENT_TYPE_LOADED, time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.False(t, found)
+	found, err = st.HasModelEventCreatedAfter(k, false, v1.ModelEventType_MODEL_EVENT_TYPE_LOADED, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// The latest event is kept.
+	n, err := st.DeleteModelEventsCreatedBefore(time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	oldest, err := st.GetOldestModelEventRevision()
+	assert.NoError(t, err)
+	assert.Equal(t, latest, oldest)
+}
@@ -0,0 +1,71 @@
+package store
+
+import (
+	"gorm.io/gorm"
+)
+
+// ModelFile represents a file of a loaded model in the object store.
+type ModelFile struct {
+	gorm.Model
+
+	TenantID string `gorm:"index:idx_model_file_model_id_tenant_id_project_id"`
+	ModelID  string `gorm:"index:idx_model_file_model_id_tenant_id_project_id"`
+
+	// ProjectID is set only for project-scoped base models. This is set to empty
+	// for global-scoped base models and fine-tuned models.
+	ProjectID string `gorm:"index:idx_model_file_model_id_tenant_id_project_id"`
+
+	// BasePath is the object store path that Path is relative to.
+	BasePath string
+	// Path is the path of the file relative to BasePath.
+	Path string
+
+	SizeBytes   int64
+	SHA256      string
+	ContentType string
+}
+
+// ReplaceModelFiles replaces the files of the model with the given ones.
+func (s *S) ReplaceModelFiles(k ModelKey, files []*ModelFile) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return ReplaceModelFilesInTransaction(tx, k, files)
+	})
+}
+
+// ReplaceModelFilesInTransaction replaces the files of the model with the given ones in a transaction.
+func ReplaceModelFilesInTransaction(tx *gorm.DB, k ModelKey, files []*ModelFile) error {
+	if err := DeleteModelFilesInTransaction(tx, k); err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	for _, f := range files {
+		f.TenantID = k.TenantID
+		f.ModelID = k.ModelID
+		f.ProjectID = k.ProjectID
+	}
+	if err := tx.Create(files).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListModelFiles lists the files of the model in the order of their paths.
+func (s *S) ListModelFiles(k ModelKey) ([]*ModelFile, error) {
+	var fs []*ModelFile
+	if err := k.buildQuery(s.db).Order("path").Find(&fs).Error; err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+// DeleteModelFilesInTransaction deletes the files of the model in a transaction. It does not return
+// an error when the model has no file.
+func DeleteModelFilesInTransaction(tx *gorm.DB, k ModelKey) error {
+	if err := k.buildQuery(tx.Unscoped()).Delete(&ModelFile{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelFiles(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	k := ModelKey{ModelID: "m0", ProjectID: "p0", TenantID: "t0"}
+	err := st.ReplaceModelFiles(k, []*ModelFile{
+		{BasePath: "models/m0", Path: "model.safetensors", SizeBytes: 100, SHA256: "s1", ContentType: "application/octet-stream"},
+		{BasePath: "models/m0", Path: "config.json", SizeBytes: 10, SHA256: "s0", ContentType: "application/json"},
+	})
+	assert.NoError(t, err)
+	// A model of another project.
+	err = st.ReplaceModelFiles(ModelKey{ModelID: "m0", TenantID: "t0"}, []*ModelFile{
+		{BasePath: "models/m0", Path: "model.gguf"},
+	})
+	assert.NoError(t, err)
+
+	fs, err := st.ListModelFiles(k)
+	assert.NoError(t, err)
+	assert.Len(t, fs, 2)
+	assert.Equal(t, "config.json", fs[0].Path)
+	assert.Equal(t, int64(10), fs[0].SizeBytes)
+	assert.Equal(t, "model.safetensors", fs[1].Path)
+	assert.Equal(t, k.ModelID, fs[1].ModelID)
+	assert.Equal(t, k.ProjectID, fs[1].ProjectID)
+
+	// The files are replaced when the model is loaded again.
+	err = st.ReplaceModelFiles(k, []*ModelFile{
+		{BasePath: "models/m0", Path: "model.gguf"},
+	})
+	assert.NoError(t, err)
+	fs, err = st.ListModelFiles(k)
+	assert.NoError(t, err)
+	assert.Len(t, fs, 1)
+	assert.Equal(t, "model.gguf", fs[0].Path)
+
+	err = DeleteModelFilesInTransaction(st.db, k)
+	assert.NoError(t, err)
+	fs, err = st.ListModelFiles(k)
+	assert.NoError(t, err)
+	assert.Empty(t, fs)
+
+	fs, err = st.ListModelFiles(ModelKey{ModelID: "m0", TenantID: "t0"})
+	assert.NoError(t, err)
+	assert.Len(t, fs, 1)
+}
@@ -0,0 +1,151 @@
+package store
+
+import (
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// ModelFormatArtifact represents the artifact of a base model in a format.
+type ModelFormatArtifact struct {
+	gorm.Model
+
+	TenantID string `gorm:"uniqueIndex:idx_model_format_artifact_model_id_tenant_id_project_id_format"`
+	ModelID  string `gorm:"uniqueIndex:idx_model_format_artifact_model_id_tenant_id_project_id_format"`
+
+	// ProjectID is the ID of the project to which the model belongs. It is empty if
+	// the model is globally scoped.
+	ProjectID string `gorm:"uniqueIndex:idx_model_format_artifact_model_id_tenant_id_project_id_format"`
+
+	Format v1.ModelFormat `gorm:"uniqueIndex:idx_model_format_artifact_model_id_tenant_id_project_id_format"`
+
+	// Path is the path of the entry point of the format in the object store.
+	Path string
+
+	// Attributes is the marshalled v1.FormatArtifactAttributes. It is empty if the artifact has no attribute.
+	Attributes []byte
+}
+
+// UnmarshalFormatArtifactAttributes unmarshals the attributes of a format artifact.
+func UnmarshalFormatArtifactAttributes(b []byte) (map[string]string, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var a v1.FormatArtifactAttributes
+	if err := proto.Unmarshal(b, &a); err != nil {
+		return nil, err
+	}
+	return a.Attributes, nil
+}
+
+// MarshalFormatArtifactAttributes marshals the attributes of a format artifact. It returns nil if there is no attribute.
+func MarshalFormatArtifactAttributes(attrs map[string]string) ([]byte, error) {
+	if len(attrs) == 0 {
+		return nil, nil
+	}
+	return proto.Marshal(&v1.FormatArtifactAttributes{Attributes: attrs})
+}
+
+// ReplaceModelFormatArtifacts replaces the format artifacts of the model with the given ones.
+func (s *S) ReplaceModelFormatArtifacts(k ModelKey, artifacts []*ModelFormatArtifact) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		return ReplaceModelFormatArtifactsInTransaction(tx, k, artifacts)
+	})
+}
+
+// ReplaceModelFormatArtifactsInTransaction replaces the format artifacts of the model with the given ones in a transaction.
+func ReplaceModelFormatArtifactsInTransaction(tx *gorm.DB, k ModelKey, artifacts []*ModelFormatArtifact) error {
+	if err := DeleteModelFormatArtifactsInTransaction(tx, k); err != nil {
+		return err
+	}
+	if len(artifacts) == 0 {
+		return nil
+	}
+
+	for _, a := range artifacts {
+		a.TenantID = k.TenantID
+		a.ModelID = k.ModelID
+		a.ProjectID = k.ProjectID
+	}
+	if err := tx.Create(artifacts).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListModelFormatArtifacts lists the format artifacts of the model in the order of their formats.
+func (s *S) ListModelFormatArtifacts(k ModelKey) ([]*ModelFormatArtifact, error) {
+	var as []*ModelFormatArtifact
+	if err := k.buildQuery(s.db).Order("format").Find(&as).Error; err != nil {
+		return nil, err
+	}
+	return as, nil
+}
+
+// DeleteModelFormatArtifactsInTransaction deletes the format artifacts of the model in a transaction. It does not
+// return an error when the model has no artifact.
+func DeleteModelFormatArtifactsInTransaction(tx *gorm.DB, k ModelKey) error {
+	if err := k.buildQuery(tx.Unscoped()).Delete(&ModelFormatArtifact{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// migrateModelFormatArtifacts creates the format artifacts of the loaded base models that have none.
+// Formats other than GGUF are served from the path of the base model.
+func migrateModelFormatArtifacts(db *gorm.DB) error {
+	var bms []*BaseModel
+	if err := db.
+		Where("path <> ?", "").
+		Where("NOT EXISTS (?)", db.Model(&ModelFormatArtifact{}).
+			Select("1").
+			Where("model_format_artifacts.tenant_id = base_models.tenant_id").
+			Where("model_format_artifacts.model_id = base_models.model_id").
+			Where("model_format_artifacts.project_id = base_models.project_id"),
+		).
+		Find(&bms).Error; err != nil {
+		return err
+	}
+	if len(bms) == 0 {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, bm := range bms {
+			formats, err := UnmarshalModelFormats(bm.Formats)
+			if err != nil {
+				return err
+			}
+			if len(formats) == 0 {
+				// For backward compatibility.
+				formats = []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF}
+			}
+
+			paths := map[v1.ModelFormat]string{
+				v1.ModelFormat_MODEL_FORMAT_GGUF: bm.GGUFModelPath,
+			}
+
+			var as []*ModelFormatArtifact
+			seen := map[v1.ModelFormat]bool{}
+			for _, f := range formats {
+				if seen[f] {
+					continue
+				}
+				seen[f] = true
+				p := paths[f]
+				if p == "" {
+					p = bm.Path
+				}
+				as = append(as, &ModelFormatArtifact{
+					Format: f,
+					Path:   p,
+				})
+			}
+			k := ModelKey{ModelID: bm.ModelID, ProjectID: bm.ProjectID, TenantID: bm.TenantID}
+			if err := ReplaceModelFormatArtifactsInTransaction(tx, k, as); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,122 @@
+package store
+
+import (
+	"testing"
+
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelFormatArtifacts(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	attrs, err := MarshalFormatArtifactAttributes(map[string]string{"quantization_type": "Q4_K_M"})
+	assert.NoError(t, err)
+
+	k := ModelKey{ModelID: "m0", ProjectID: "p0", TenantID: "t0"}
+	err = st.ReplaceModelFormatArtifacts(k, []*ModelFormatArtifact{
+		{Format: v1.ModelFormat_MODEL_FORMAT_ONNX, Path: "models/m0/model.onnx"},
+		{Format: v1.ModelFormat_MODEL_FORMAT_GGUF, Path: "models/m0.gguf", Attributes: attrs},
+	})
+	assert.NoError(t, err)
+	// A model of another project.
+	err = st.ReplaceModelFormatArtifacts(ModelKey{ModelID: "m0", TenantID: "t0"}, []*ModelFormatArtifact{
+		{Format: v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE, Path: "models/m0"},
+	})
+	assert.NoError(t, err)
+
+	as, err := st.ListModelFormatArtifacts(k)
+	assert.NoError(t, err)
+	assert.Len(t, as, 2)
+	assert.Equal(t, v1.ModelFormat_MODEL_FORMAT_GGUF, as[0].Format)
+	assert.Equal(t, "models/m0.gguf", as[0].Path)
+	got, err := UnmarshalFormatArtifactAttributes(as[0].Attributes)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"quantization_type": "Q4_K_M"}, got)
+	assert.Equal(t, v1.ModelFormat_MODEL_FORMAT_ONNX, as[1].Format)
+	assert.Equal(t, k.ProjectID, as[1].ProjectID)
+	got, err = UnmarshalFormatArtifactAttributes(as[1].Attributes)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	// The artifacts are replaced when the model is loaded again.
+	err = st.ReplaceModelFormatArtifacts(k, []*ModelFormatArtifact{
+		{Format: v1.ModelFormat_MODEL_FORMAT_GGUF, Path: "models/m0-q8.gguf"},
+	})
+	assert.NoError(t, err)
+	as, err = st.ListModelFormatArtifacts(k)
+	assert.NoError(t, err)
+	assert.Len(t, as, 1)
+	assert.Equal(t, "models/m0-q8.gguf", as[0].Path)
+
+	err = DeleteModelFormatArtifactsInTransaction(st.db, k)
+	assert.NoError(t, err)
+	as, err = st.ListModelFormatArtifacts(k)
+	assert.NoError(t, err)
+	assert.Empty(t, as)
+
+	as, err = st.ListModelFormatArtifacts(ModelKey{ModelID: "m0", TenantID: "t0"})
+	assert.NoError(t, err)
+	assert.Len(t, as, 1)
+}
+
+func TestMigrateModelFormatArtifacts(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	k0 := ModelKey{ModelID: "m0", TenantID: "t0"}
+	_, err := st.CreateBaseModel(k0, "models/m0", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_GGUF, v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE}, "models/m0.gguf", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", "", nil)
+	assert.NoError(t, err)
+
+	k1 := ModelKey{ModelID: "m1", TenantID: "t0"}
+	_, err = st.CreateBaseModel(k1, "models/m1", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_ONNX}, "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", "", nil)
+	assert.NoError(t, err)
+
+	// A model whose artifacts have already been recorded.
+	k2 := ModelKey{ModelID: "m2", TenantID: "t0"}
+	_, err = st.CreateBaseModel(k2, "models/m2", []v1.ModelFormat{v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE}, "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", "", nil)
+	assert.NoError(t, err)
+	err = st.ReplaceModelFormatArtifacts(k2, []*ModelFormatArtifact{
+		{Format: v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE, Path: "models/m2/hf"},
+	})
+	assert.NoError(t, err)
+
+	// A model that has not been loaded yet.
+	k3 := ModelKey{ModelID: "m3", TenantID: "t0"}
+	_, err = st.CreateBaseModelWithLoadingRequested(k3, v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", nil, "", "", 0)
+	assert.NoError(t, err)
+
+	err = migrateModelFormatArtifacts(st.db)
+	assert.NoError(t, err)
+
+	as, err := st.ListModelFormatArtifacts(k0)
+	assert.NoError(t, err)
+	assert.Len(t, as, 2)
+	assert.Equal(t, v1.ModelFormat_MODEL_FORMAT_GGUF, as[0].Format)
+	assert.Equal(t, "models/m0.gguf", as[0].Path)
+	assert.Equal(t, v1.ModelFormat_MODEL_FORMAT_HUGGING_FACE, as[1].Format)
+	assert.Equal(t, "models/m0", as[1].Path)
+
+	as, err = st.ListModelFormatArtifacts(k1)
+	assert.NoError(t, err)
+	assert.Len(t, as, 1)
+	assert.Equal(t, v1.ModelFormat_MODEL_FORMAT_ONNX, as[0].Format)
+	assert.Equal(t, "models/m1", as[0].Path)
+
+	as, err = st.ListModelFormatArtifacts(k2)
+	assert.NoError(t, err)
+	assert.Len(t, as, 1)
+	assert.Equal(t, "models/m2/hf", as[0].Path)
+
+	as, err = st.ListModelFormatArtifacts(k3)
+	assert.NoError(t, err)
+	assert.Empty(t, as)
+
+	// The migration is idempotent.
+	err = migrateModelFormatArtifacts(st.db)
+	assert.NoError(t, err)
+	as, err = st.ListModelFormatArtifacts(k0)
+	assert.NoError(t, err)
+	assert.Len(t, as, 2)
+}
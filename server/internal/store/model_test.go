@@ -3,6 +3,7 @@ package store
 import (
 	"errors"
 	"testing"
+	"time"
 
 	v1 "github.com/llmariner/model-manager/api/v1"
 	"github.com/stretchr/testify/assert"
@@ -232,19 +233,24 @@ func TestLoadingStatus(t *testing.T) {
 	assert.Len(t, gots, 1)
 	assert.Equal(t, modelID, gots[0].ModelID)
 
-	err = st.UpdateModelToLoadingStatus(modelID, tenantID)
+	err = st.UpdateModelToLoadingStatus(modelID, tenantID, "", time.Time{})
 	assert.NoError(t, err)
 
 	got, err := st.GetModelByModelIDAndTenantID(modelID, tenantID)
 	assert.NoError(t, err)
 	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING, got.LoadingStatus)
 
+	gots, err = st.ListLoadingModels(tenantID)
+	assert.NoError(t, err)
+	assert.Len(t, gots, 1)
+	assert.Equal(t, modelID, gots[0].ModelID)
+
 	// Calling again.
-	err = st.UpdateModelToLoadingStatus(modelID, tenantID)
+	err = st.UpdateModelToLoadingStatus(modelID, tenantID, "", time.Time{})
 	assert.Error(t, err)
 	assert.ErrorIs(t, err, ErrConcurrentUpdate)
 
-	err = st.UpdateModelToSucceededStatus(modelID, tenantID)
+	err = st.UpdateModelToSucceededStatus(modelID, tenantID, "", "", nil)
 	assert.NoError(t, err)
 
 	got, err = st.GetModelByModelIDAndTenantID(modelID, tenantID)
@@ -256,7 +262,7 @@ func TestLoadingStatus(t *testing.T) {
 	err = st.db.Save(m).Error
 	assert.NoError(t, err)
 
-	err = st.UpdateModelToFailedStatus(modelID, tenantID, "fake-error")
+	err = st.UpdateModelToFailedStatus(modelID, tenantID, "", "fake-error", time.Time{}, 0)
 	assert.NoError(t, err)
 
 	got, err = st.GetModelByModelIDAndTenantID(modelID, tenantID)
@@ -265,6 +271,159 @@ func TestLoadingStatus(t *testing.T) {
 	assert.Equal(t, "fake-error", got.LoadingFailureReason)
 }
 
+func TestModelLoadingLease(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	const (
+		modelID  = "m0"
+		tenantID = "tid0"
+	)
+
+	_, err := st.CreateModel(ModelSpec{
+		ModelID:       modelID,
+		TenantID:      tenantID,
+		Path:          "path",
+		LoadingStatus: v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+	})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	err = st.UpdateModelToLoadingStatus(modelID, tenantID, "c0/l0", now.Add(time.Minute))
+	assert.NoError(t, err)
+
+	// Only the lease holder can renew the lease.
+	err = st.RenewModelLoadingLease(modelID, tenantID, "c0/l1", now.Add(2*time.Minute))
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+	err = st.RenewModelLoadingLease(modelID, tenantID, "c0/l0", now.Add(2*time.Minute))
+	assert.NoError(t, err)
+
+	// The lease has not expired yet.
+	n, err := st.ReleaseExpiredModelLoadingLeases(now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+
+	n, err = st.ReleaseExpiredModelLoadingLeases(now.Add(3 * time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	got, err := st.GetModelByModelIDAndTenantID(modelID, tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, got.LoadingStatus)
+	assert.Empty(t, got.LoadingLeaseHolder)
+}
+
+func TestUpdateModelToSucceededStatusInTransaction(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	const (
+		modelID  = "m0"
+		tenantID = "tid0"
+	)
+	k := ModelKey{ModelID: modelID, TenantID: tenantID}
+
+	_, err := st.CreateModel(ModelSpec{
+		ModelID:       modelID,
+		TenantID:      tenantID,
+		Path:          "path",
+		LoadingStatus: v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+	})
+	assert.NoError(t, err)
+	err = st.UpdateModelToLoadingStatus(modelID, tenantID, "c0/l0", time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+
+	// The status is not updated if the files fail to be recorded.
+	errFiles := errors.New("files")
+	err = st.Transaction(func(tx *gorm.DB) error {
+		if err := UpdateModelToSucceededStatusInTransaction(tx, modelID, tenantID, "c0/l0", "c0", nil); err != nil {
+			return err
+		}
+		if err := ReplaceModelFilesInTransaction(tx, k, []*ModelFile{{BasePath: "path", Path: "f0", SizeBytes: 1}}); err != nil {
+			return err
+		}
+		return errFiles
+	})
+	assert.ErrorIs(t, err, errFiles)
+	got, err := st.GetModelByModelIDAndTenantID(modelID, tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_LOADING, got.LoadingStatus)
+	fs, err := st.ListModelFiles(k)
+	assert.NoError(t, err)
+	assert.Empty(t, fs)
+
+	// Only the lease holder can complete the loading.
+	err = st.Transaction(func(tx *gorm.DB) error {
+		return UpdateModelToSucceededStatusInTransaction(tx, modelID, tenantID, "c0/l1", "c0", nil)
+	})
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+
+	err = st.Transaction(func(tx *gorm.DB) error {
+		if err := UpdateModelToSucceededStatusInTransaction(tx, modelID, tenantID, "c0/l0", "c0", nil); err != nil {
+			return err
+		}
+		return ReplaceModelFilesInTransaction(tx, k, []*ModelFile{{BasePath: "path", Path: "f0", SizeBytes: 1}})
+	})
+	assert.NoError(t, err)
+	got, err = st.GetModelByModelIDAndTenantID(modelID, tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_SUCCEEDED, got.LoadingStatus)
+	fs, err = st.ListModelFiles(k)
+	assert.NoError(t, err)
+	assert.Len(t, fs, 1)
+}
+
+func TestModelLoadingRetry(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	const (
+		modelID  = "m0"
+		tenantID = "tid0"
+	)
+
+	_, err := st.CreateModel(ModelSpec{
+		ModelID:       modelID,
+		TenantID:      tenantID,
+		Path:          "path",
+		LoadingStatus: v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED,
+	})
+	assert.NoError(t, err)
+
+	now := time.Now()
+	err = st.UpdateModelToLoadingStatus(modelID, tenantID, "", time.Time{})
+	assert.NoError(t, err)
+	err = st.UpdateModelToFailedStatus(modelID, tenantID, "", "error", now.Add(time.Minute), 3)
+	assert.NoError(t, err)
+
+	n, err := st.RequeueFailedModels(now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), n)
+
+	n, err = st.RequeueFailedModels(now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+
+	got, err := st.GetModelByModelIDAndTenantID(modelID, tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, got.LoadingStatus)
+	assert.Equal(t, 1, got.LoadingAttemptCount)
+	assert.Empty(t, got.LoadingFailureReason)
+
+	err = st.UpdateModelToLoadingStatus(modelID, tenantID, "", time.Time{})
+	assert.NoError(t, err)
+	err = st.UpdateModelToFailedStatus(modelID, tenantID, "", "error", time.Time{}, 3)
+	assert.NoError(t, err)
+
+	err = st.UpdateModelToRetryRequestedStatus(modelID, tenantID)
+	assert.NoError(t, err)
+
+	got, err = st.GetModelByModelIDAndTenantID(modelID, tenantID)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.ModelLoadingStatus_MODEL_LOADING_STATUS_REQUESTED, got.LoadingStatus)
+	assert.Equal(t, 0, got.LoadingAttemptCount)
+}
+
 func TestListModelsByActivationStatusWithPagination(t *testing.T) {
 	st, tearDown := NewTest(t)
 	defer tearDown()
@@ -328,7 +487,7 @@ func TestUpdateModelLoadingStatusMessage(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	err = st.UpdateModelLoadingStatusMessage(modelID, tenantID, "current msg")
+	err = st.UpdateModelLoadingStatusMessage(modelID, tenantID, "current msg", nil)
 	assert.NoError(t, err)
 
 	got, err := st.GetModelByModelIDAndTenantID(modelID, tenantID)
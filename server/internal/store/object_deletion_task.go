@@ -0,0 +1,148 @@
+package store
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ObjectDeletionTask represents a task to delete the objects of a deleted model from the object store.
+// The task is executed by a loader as the server does not have credentials for the object store.
+type ObjectDeletionTask struct {
+	gorm.Model
+
+	TenantID string `gorm:"index"`
+
+	// ModelID is the ID of the deleted model.
+	ModelID string
+	// ProjectID is the ID of the project of the deleted model. It is empty if the model is globally
+	// scoped or fine-tuned.
+	ProjectID string
+
+	// Path is the object store path of the deleted model.
+	Path string
+	// ClusterID is the ID of the cluster whose object store has the objects. It is empty if the model was
+	// loaded before the cluster was recorded.
+	ClusterID string
+	// UploadsOnly is true if the task cleans up a loading that was abandoned while being cancelled. Only the
+	// objects recorded in the upload manifest at the path are deleted as the path might be shared with the
+	// objects of a loaded model.
+	UploadsOnly bool
+
+	// LeaseHolder is the ID of the loader that has acquired the task.
+	LeaseHolder string
+	// LeaseExpiresAt is the time when the lease expires. The task can be acquired by another loader
+	// once the lease expires.
+	LeaseExpiresAt time.Time
+}
+
+// CreateObjectDeletionTaskInTransaction creates a new object deletion task in a transaction.
+func CreateObjectDeletionTaskInTransaction(tx *gorm.DB, t *ObjectDeletionTask) error {
+	if err := tx.Create(t).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListAcquirableObjectDeletionTasks lists the tasks of the tenant that are not leased by any loader
+// in the ascending order of their creation. Only the tasks for the object store of the cluster and the
+// tasks whose cluster is unknown are listed.
+func (s *S) ListAcquirableObjectDeletionTasks(tenantID, clusterID string, now time.Time) ([]*ObjectDeletionTask, error) {
+	var ts []*ObjectDeletionTask
+	if err := s.db.
+		Where("tenant_id = ? AND lease_expires_at < ?", tenantID, now).
+		Where("(cluster_id = ? OR cluster_id = '')", clusterID).
+		Order("id").
+		Find(&ts).Error; err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// ListLeasedObjectDeletionTasks lists the tasks of the tenant that are leased by loaders.
+func (s *S) ListLeasedObjectDeletionTasks(tenantID string, now time.Time) ([]*ObjectDeletionTask, error) {
+	var ts []*ObjectDeletionTask
+	if err := s.db.
+		Where("tenant_id = ? AND lease_expires_at >= ?", tenantID, now).
+		Order("id").
+		Find(&ts).Error; err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// AcquireObjectDeletionTask grants the lease of the task to the lease holder. ErrConcurrentUpdate is returned
+// if the task has been acquired by another loader or deleted.
+func (s *S) AcquireObjectDeletionTask(id uint, holder string, now, expiresAt time.Time) error {
+	res := s.db.Model(&ObjectDeletionTask{}).
+		Where("id = ? AND lease_expires_at < ?", id, now).
+		Updates(map[string]interface{}{
+			"lease_holder":     holder,
+			"lease_expires_at": expiresAt,
+		})
+	if err := res.Error; err != nil {
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return ErrConcurrentUpdate
+	}
+	return nil
+}
+
+// DeleteObjectDeletionTask deletes a task.
+func (s *S) DeleteObjectDeletionTask(id uint) error {
+	res := s.db.Unscoped().Where("id = ?", id).Delete(&ObjectDeletionTask{})
+	if err := res.Error; err != nil {
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DeleteObjectDeletionTaskByLeaseHolder deletes a task of the tenant that is leased by the lease holder.
+func (s *S) DeleteObjectDeletionTaskByLeaseHolder(id uint, tenantID, holder string) error {
+	res := s.db.Unscoped().
+		Where("id = ? AND tenant_id = ? AND lease_holder = ?", id, tenantID, holder).
+		Delete(&ObjectDeletionTask{})
+	if err := res.Error; err != nil {
+		return err
+	}
+	if res.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListModelPaths lists the non-empty object store paths of the base models and the fine-tuned models.
+// The paths of GGUF model files are listed as well since they are not necessarily stored under the model
+// paths. The paths of all tenants are listed if the tenant ID is empty.
+func (s *S) ListModelPaths(tenantID string) ([]string, error) {
+	return ListModelPathsInTransaction(s.db, tenantID)
+}
+
+// ListModelPathsInTransaction lists the non-empty object store paths of the base models and the fine-tuned
+// models in a transaction. The paths of all tenants are listed if the tenant ID is empty.
+func ListModelPathsInTransaction(tx *gorm.DB, tenantID string) ([]string, error) {
+	var paths []string
+	for _, c := range []struct {
+		model  interface{}
+		column string
+	}{
+		{&BaseModel{}, "path"},
+		{&BaseModel{}, "gguf_model_path"},
+		{&Model{}, "path"},
+	} {
+		q := tx.Model(c.model).Where(c.column + " != ''")
+		if tenantID != "" {
+			q = q.Where("tenant_id = ?", tenantID)
+		}
+		var ps []string
+		if err := q.Distinct().Pluck(c.column, &ps).Error; err != nil {
+			return nil, err
+		}
+		paths = append(paths, ps...)
+	}
+	return paths, nil
+}
@@ -0,0 +1,90 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/llmariner/model-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestObjectDeletionTask(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	for _, task := range []*ObjectDeletionTask{
+		{TenantID: "t0", ModelID: "m0", Path: "models/m0", ClusterID: "c0"},
+		{TenantID: "t1", ModelID: "m1", Path: "models/m1", ClusterID: "c0"},
+		// A task whose cluster is unknown can be acquired by any cluster.
+		{TenantID: "t0", ModelID: "m2", Path: "models/m2"},
+		{TenantID: "t0", ModelID: "m3", Path: "models/m3", ClusterID: "c1"},
+	} {
+		err := CreateObjectDeletionTaskInTransaction(st.db, task)
+		assert.NoError(t, err)
+	}
+
+	now := time.Now()
+	ts, err := st.ListAcquirableObjectDeletionTasks("t0", "c0", now)
+	assert.NoError(t, err)
+	assert.Len(t, ts, 2)
+	assert.Equal(t, "m0", ts[0].ModelID)
+	assert.Equal(t, "m2", ts[1].ModelID)
+
+	err = st.AcquireObjectDeletionTask(ts[0].ID, "l0", now, now.Add(time.Minute))
+	assert.NoError(t, err)
+	err = st.AcquireObjectDeletionTask(ts[0].ID, "l1", now, now.Add(time.Minute))
+	assert.ErrorIs(t, err, ErrConcurrentUpdate)
+
+	got, err := st.ListAcquirableObjectDeletionTasks("t0", "c0", now)
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "m2", got[0].ModelID)
+
+	got, err = st.ListLeasedObjectDeletionTasks("t0", now)
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+	assert.Equal(t, "m0", got[0].ModelID)
+
+	// The task can be acquired again once the lease expires.
+	got, err = st.ListAcquirableObjectDeletionTasks("t0", "c0", now.Add(2*time.Minute))
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+
+	err = st.DeleteObjectDeletionTaskByLeaseHolder(ts[0].ID, "t0", "l1")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	err = st.DeleteObjectDeletionTaskByLeaseHolder(ts[0].ID, "t0", "l0")
+	assert.NoError(t, err)
+
+	err = st.DeleteObjectDeletionTask(ts[1].ID)
+	assert.NoError(t, err)
+	got, err = st.ListAcquirableObjectDeletionTasks("t0", "c0", now)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestListModelPaths(t *testing.T) {
+	st, tearDown := NewTest(t)
+	defer tearDown()
+
+	_, err := st.CreateBaseModel(ModelKey{ModelID: "bm0", TenantID: "t0"}, "models/bm0", nil, "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", "", nil)
+	assert.NoError(t, err)
+	// A base model that shares the path with another one.
+	_, err = st.CreateBaseModel(ModelKey{ModelID: "bm1", TenantID: "t0"}, "models/bm0", nil, "", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", "", nil)
+	assert.NoError(t, err)
+	_, err = st.CreateBaseModel(ModelKey{ModelID: "bm2", TenantID: "t1"}, "models/bm2", nil, "models/bm2.gguf", v1.SourceRepository_SOURCE_REPOSITORY_HUGGING_FACE, "", "", nil)
+	assert.NoError(t, err)
+	_, err = st.CreateModel(ModelSpec{ModelID: "m0", TenantID: "t0", Path: "models/m0"})
+	assert.NoError(t, err)
+	// A model that has not been loaded yet.
+	_, err = st.CreateModel(ModelSpec{ModelID: "m1", TenantID: "t0"})
+	assert.NoError(t, err)
+
+	paths, err := st.ListModelPaths("t0")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"models/bm0", "models/m0"}, paths)
+
+	paths, err = st.ListModelPaths("")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"models/bm0", "models/bm2", "models/bm2.gguf", "models/m0"}, paths)
+}
@@ -34,10 +34,18 @@ func autoMigrate(db *gorm.DB) error {
 		&ModelActivationStatus{},
 		&ModelConfig{},
 		&StorageConfig{},
+		&ModelEvent{},
+		&ObjectDeletionTask{},
+		&ModelFile{},
+		&ModelFormatArtifact{},
 	); err != nil {
 		return err
 	}
 
+	if err := migrateModelFormatArtifacts(db); err != nil {
+		return err
+	}
+
 	// The following indices are dropped with v1.22.0:
 	indices := []struct {
 		table interface{}
@@ -22,6 +22,11 @@ export enum ModelFormat {
   MODEL_FORMAT_HUGGING_FACE = "MODEL_FORMAT_HUGGING_FACE",
   MODEL_FORMAT_NVIDIA_TRITON = "MODEL_FORMAT_NVIDIA_TRITON",
   MODEL_FORMAT_OLLAMA = "MODEL_FORMAT_OLLAMA",
+  MODEL_FORMAT_ONNX = "MODEL_FORMAT_ONNX",
+  MODEL_FORMAT_MLX = "MODEL_FORMAT_MLX",
+  MODEL_FORMAT_GPTQ = "MODEL_FORMAT_GPTQ",
+  MODEL_FORMAT_AWQ = "MODEL_FORMAT_AWQ",
+  MODEL_FORMAT_VLLM = "MODEL_FORMAT_VLLM",
 }
 
 export enum ModelLoadingStatus {
@@ -30,6 +35,9 @@ export enum ModelLoadingStatus {
   MODEL_LOADING_STATUS_LOADING = "MODEL_LOADING_STATUS_LOADING",
   MODEL_LOADING_STATUS_SUCCEEDED = "MODEL_LOADING_STATUS_SUCCEEDED",
   MODEL_LOADING_STATUS_FAILED = "MODEL_LOADING_STATUS_FAILED",
+  MODEL_LOADING_STATUS_CANCELLING = "MODEL_LOADING_STATUS_CANCELLING",
+  MODEL_LOADING_STATUS_CANCELLED = "MODEL_LOADING_STATUS_CANCELLED",
+  MODEL_LOADING_STATUS_DEGRADED = "MODEL_LOADING_STATUS_DEGRADED",
 }
 
 export enum SourceRepository {
@@ -38,6 +46,8 @@ export enum SourceRepository {
   SOURCE_REPOSITORY_HUGGING_FACE = "SOURCE_REPOSITORY_HUGGING_FACE",
   SOURCE_REPOSITORY_OLLAMA = "SOURCE_REPOSITORY_OLLAMA",
   SOURCE_REPOSITORY_FINE_TUNING = "SOURCE_REPOSITORY_FINE_TUNING",
+  SOURCE_REPOSITORY_OCI = "SOURCE_REPOSITORY_OCI",
+  SOURCE_REPOSITORY_HTTP = "SOURCE_REPOSITORY_HTTP",
 }
 
 export enum ActivationStatus {
@@ -46,6 +56,20 @@ export enum ActivationStatus {
   ACTIVATION_STATUS_INACTIVE = "ACTIVATION_STATUS_INACTIVE",
 }
 
+export enum ModelEventType {
+  MODEL_EVENT_TYPE_UNSPECIFIED = "MODEL_EVENT_TYPE_UNSPECIFIED",
+  MODEL_EVENT_TYPE_CREATED = "MODEL_EVENT_TYPE_CREATED",
+  MODEL_EVENT_TYPE_LOADING_PROGRESS = "MODEL_EVENT_TYPE_LOADING_PROGRESS",
+  MODEL_EVENT_TYPE_LOADED = "MODEL_EVENT_TYPE_LOADED",
+  MODEL_EVENT_TYPE_FAILED = "MODEL_EVENT_TYPE_FAILED",
+  MODEL_EVENT_TYPE_ACTIVATED = "MODEL_EVENT_TYPE_ACTIVATED",
+  MODEL_EVENT_TYPE_DEACTIVATED = "MODEL_EVENT_TYPE_DEACTIVATED",
+  MODEL_EVENT_TYPE_CONFIG_UPDATED = "MODEL_EVENT_TYPE_CONFIG_UPDATED",
+  MODEL_EVENT_TYPE_DELETED = "MODEL_EVENT_TYPE_DELETED",
+  MODEL_EVENT_TYPE_CANCELLED = "MODEL_EVENT_TYPE_CANCELLED",
+  MODEL_EVENT_TYPE_DEGRADED = "MODEL_EVENT_TYPE_DEGRADED",
+}
+
 export enum AdapterType {
   ADAPTER_TYPE_UNSPECIFIED = "ADAPTER_TYPE_UNSPECIFIED",
   ADAPTER_TYPE_LORA = "ADAPTER_TYPE_LORA",
@@ -62,6 +86,50 @@ export type ModelFormats = {
   formats?: ModelFormat[]
 }
 
+export type ModelSizeFormatSize = {
+  format?: ModelFormat
+  size_bytes?: string
+}
+
+export type ModelSize = {
+  total_bytes?: string
+  format_sizes?: ModelSizeFormatSize[]
+}
+
+export type GGUFMetadata = {
+  architecture?: string
+  parameter_count?: string
+  context_length?: string
+  quantization_type?: string
+  tokenizer?: string
+  chat_template?: string
+}
+
+export type FormatArtifact = {
+  format?: ModelFormat
+  path?: string
+  attributes?: {[key: string]: string}
+}
+
+export type FormatArtifactAttributes = {
+  attributes?: {[key: string]: string}
+}
+
+export type HuggingFaceMetadata = {
+  architecture?: string
+  model_type?: string
+  dtype?: string
+  parameter_count?: string
+  max_position_embeddings?: string
+  vocab_size?: string
+  has_chat_template?: boolean
+}
+
+export type FilePatterns = {
+  include?: string[]
+  exclude?: string[]
+}
+
 export type ModelConfigRuntimeConfigResources = {
   gpu?: number
 }
@@ -100,6 +168,14 @@ export type Project = {
   kubernetes_namespace?: string
 }
 
+export type ModelLoadingProgress = {
+  total_bytes?: string
+  downloaded_bytes?: string
+  uploaded_bytes?: string
+  bytes_per_second?: string
+  eta_seconds?: string
+}
+
 export type Model = {
   id?: string
   created?: string
@@ -115,6 +191,14 @@ export type Model = {
   activation_status?: ActivationStatus
   config?: ModelConfig
   project?: Project
+  loading_progress?: ModelLoadingProgress
+  revision?: string
+  resolved_revision?: string
+  file_patterns?: FilePatterns
+  queue_position?: number
+  size?: ModelSize
+  gguf_metadata?: GGUFMetadata
+  hugging_face_metadata?: HuggingFaceMetadata
 }
 
 export type CreateModelRequest = {
@@ -126,6 +210,10 @@ export type CreateModelRequest = {
   model_file_location?: string
   config?: ModelConfig
   is_project_scoped?: boolean
+  revision?: string
+  file_patterns?: FilePatterns
+  checksum?: string
+  priority?: number
 }
 
 export type ListModelsRequest = {
@@ -175,6 +263,64 @@ export type DeactivateModelRequest = {
 export type DeactivateModelResponse = {
 }
 
+export type RetryModelLoadRequest = {
+  id?: string
+}
+
+export type RetryModelLoadResponse = {
+}
+
+export type CancelModelLoadRequest = {
+  id?: string
+}
+
+export type CancelModelLoadResponse = {
+}
+
+export type ListModelLoadQueueRequest = {
+}
+
+export type ModelLoadQueueEntry = {
+  model_id?: string
+  is_base_model?: boolean
+  project_id?: string
+  source_repository?: SourceRepository
+  priority?: number
+  position?: number
+}
+
+export type ListModelLoadQueueResponse = {
+  entries?: ModelLoadQueueEntry[]
+}
+
+export type ModelFile = {
+  path?: string
+  size_bytes?: string
+  sha256?: string
+  content_type?: string
+}
+
+export type GetModelFilesRequest = {
+  id?: string
+}
+
+export type GetModelFilesResponse = {
+  base_path?: string
+  files?: ModelFile[]
+}
+
+export type ModelEvent = {
+  revision?: string
+  type?: ModelEventType
+  model?: Model
+  created?: string
+}
+
+export type WatchModelsRequest = {
+  after_revision?: string
+  include_loading_models?: boolean
+}
+
 export type StorageConfig = {
   path_prefix?: string
 }
@@ -235,6 +381,21 @@ export type CreateBaseModelRequest = {
   gguf_model_path?: string
   source_repository?: SourceRepository
   project_id?: string
+  revision?: string
+  resolved_revision?: string
+  file_patterns?: FilePatterns
+  files?: ModelFile[]
+  files_base_path?: string
+  size?: ModelSize
+  gguf_metadata?: GGUFMetadata
+  hugging_face_metadata?: HuggingFaceMetadata
+  artifacts?: FormatArtifact[]
+  onnx_model_path?: string
+  mlx_model_path?: string
+  gptq_model_path?: string
+  awq_model_path?: string
+  vllm_model_path?: string
+  loader_id?: string
 }
 
 export type BaseModel = {
@@ -252,15 +413,25 @@ export type GetBaseModelPathResponse = {
   formats?: ModelFormat[]
   path?: string
   gguf_model_path?: string
+  size?: ModelSize
+  hugging_face_metadata?: HuggingFaceMetadata
+  artifacts?: FormatArtifact[]
+  onnx_model_path?: string
+  mlx_model_path?: string
+  gptq_model_path?: string
+  awq_model_path?: string
+  vllm_model_path?: string
 }
 
 export type CreateHFModelRepoRequest = {
   name?: string
   project_id?: string
+  revision?: string
 }
 
 export type HFModelRepo = {
   name?: string
+  revision?: string
 }
 
 export type GetHFModelRepoRequest = {
@@ -269,12 +440,20 @@ export type GetHFModelRepoRequest = {
 }
 
 export type AcquireUnloadedBaseModelRequest = {
+  loader_id?: string
+  supported_source_repositories?: SourceRepository[]
+  cluster_id?: string
+  free_disk_bytes?: string
 }
 
 export type AcquireUnloadedBaseModelResponse = {
   base_model_id?: string
   source_repository?: SourceRepository
   project_id?: string
+  revision?: string
+  file_patterns?: FilePatterns
+  model_file_location?: string
+  checksum?: string
 }
 
 export type UpdateBaseModelLoadingStatusRequestSuccess = {
@@ -282,6 +461,10 @@ export type UpdateBaseModelLoadingStatusRequestSuccess = {
 
 export type UpdateBaseModelLoadingStatusRequestFailure = {
   reason?: string
+  retryable?: boolean
+}
+
+export type UpdateBaseModelLoadingStatusRequestCancelled = {
 }
 
 
@@ -289,15 +472,23 @@ type BaseUpdateBaseModelLoadingStatusRequest = {
   id?: string
   project_id?: string
   status_message?: string
+  loader_id?: string
+  progress?: ModelLoadingProgress
+  upload_path?: string
 }
 
 export type UpdateBaseModelLoadingStatusRequest = BaseUpdateBaseModelLoadingStatusRequest
-  & OneOf<{ success: UpdateBaseModelLoadingStatusRequestSuccess; failure: UpdateBaseModelLoadingStatusRequestFailure }>
+  & OneOf<{ success: UpdateBaseModelLoadingStatusRequestSuccess; failure: UpdateBaseModelLoadingStatusRequestFailure; cancelled: UpdateBaseModelLoadingStatusRequestCancelled }>
 
 export type UpdateBaseModelLoadingStatusResponse = {
+  cancelled?: boolean
 }
 
 export type AcquireUnloadedModelRequest = {
+  loader_id?: string
+  supported_source_repositories?: SourceRepository[]
+  cluster_id?: string
+  free_disk_bytes?: string
 }
 
 export type AcquireUnloadedModelResponse = {
@@ -306,25 +497,89 @@ export type AcquireUnloadedModelResponse = {
   source_repository?: SourceRepository
   model_file_location?: string
   dest_path?: string
+  file_patterns?: FilePatterns
 }
 
 export type UpdateModelLoadingStatusRequestSuccess = {
+  files?: ModelFile[]
+  files_base_path?: string
+  size?: ModelSize
 }
 
 export type UpdateModelLoadingStatusRequestFailure = {
   reason?: string
+  retryable?: boolean
+}
+
+export type UpdateModelLoadingStatusRequestCancelled = {
 }
 
 
 type BaseUpdateModelLoadingStatusRequest = {
   id?: string
   status_message?: string
+  loader_id?: string
+  progress?: ModelLoadingProgress
 }
 
 export type UpdateModelLoadingStatusRequest = BaseUpdateModelLoadingStatusRequest
-  & OneOf<{ success: UpdateModelLoadingStatusRequestSuccess; failure: UpdateModelLoadingStatusRequestFailure }>
+  & OneOf<{ success: UpdateModelLoadingStatusRequestSuccess; failure: UpdateModelLoadingStatusRequestFailure; cancelled: UpdateModelLoadingStatusRequestCancelled }>
 
 export type UpdateModelLoadingStatusResponse = {
+  cancelled?: boolean
+}
+
+export type AcquireObjectDeletionTaskRequest = {
+  loader_id?: string
+}
+
+export type AcquireObjectDeletionTaskResponse = {
+  task_id?: string
+  path?: string
+  uploads_only?: boolean
+}
+
+export type CompleteObjectDeletionTaskRequest = {
+  task_id?: string
+  loader_id?: string
+}
+
+export type CompleteObjectDeletionTaskResponse = {
+}
+
+export type ListModelPathsRequest = {
+}
+
+export type ListModelPathsResponse = {
+  paths?: string[]
+}
+
+export type ListLoadedModelPathsRequest = {
+}
+
+export type LoadedModelPath = {
+  id?: string
+  project_id?: string
+  is_base_model?: boolean
+  path?: string
+  gguf_model_path?: string
+  loading_status?: ModelLoadingStatus
+  degraded_reason?: string
+}
+
+export type ListLoadedModelPathsResponse = {
+  models?: LoadedModelPath[]
+}
+
+export type UpdateModelDegradationRequest = {
+  id?: string
+  project_id?: string
+  is_base_model?: boolean
+  degraded?: boolean
+  reason?: string
+}
+
+export type UpdateModelDegradationResponse = {
 }
 
 export class ModelsService {
@@ -349,6 +604,21 @@ export class ModelsService {
   static DeactivateModel(req: DeactivateModelRequest, initReq?: fm.InitReq): Promise<DeactivateModelResponse> {
     return fm.fetchReq<DeactivateModelRequest, DeactivateModelResponse>(`/v1/models/${req["id"]}:deactivate`, {...initReq, method: "POST"})
   }
+  static RetryModelLoad(req: RetryModelLoadRequest, initReq?: fm.InitReq): Promise<RetryModelLoadResponse> {
+    return fm.fetchReq<RetryModelLoadRequest, RetryModelLoadResponse>(`/v1/models/${req["id"]}:retryLoad`, {...initReq, method: "POST"})
+  }
+  static CancelModelLoad(req: CancelModelLoadRequest, initReq?: fm.InitReq): Promise<CancelModelLoadResponse> {
+    return fm.fetchReq<CancelModelLoadRequest, CancelModelLoadResponse>(`/v1/models/${req["id"]}:cancelLoad`, {...initReq, method: "POST"})
+  }
+  static WatchModels(req: WatchModelsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ModelEvent>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<WatchModelsRequest, ModelEvent>(`/v1/models:watch?${fm.renderURLSearchParams(req, [])}`, entityNotifier, {...initReq, method: "GET"})
+  }
+  static ListModelLoadQueue(req: ListModelLoadQueueRequest, initReq?: fm.InitReq): Promise<ListModelLoadQueueResponse> {
+    return fm.fetchReq<ListModelLoadQueueRequest, ListModelLoadQueueResponse>(`/v1/models:loadQueue?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
+  }
+  static GetModelFiles(req: GetModelFilesRequest, initReq?: fm.InitReq): Promise<GetModelFilesResponse> {
+    return fm.fetchReq<GetModelFilesRequest, GetModelFilesResponse>(`/v1/models/${req["id"]}:files?${fm.renderURLSearchParams(req, ["id"])}`, {...initReq, method: "GET"})
+  }
 }
 export class ModelsWorkerService {
   static CreateStorageConfig(req: CreateStorageConfigRequest, initReq?: fm.InitReq): Promise<StorageConfig> {
@@ -399,4 +669,25 @@ export class ModelsWorkerService {
   static UpdateModelLoadingStatus(req: UpdateModelLoadingStatusRequest, initReq?: fm.InitReq): Promise<UpdateModelLoadingStatusResponse> {
     return fm.fetchReq<UpdateModelLoadingStatusRequest, UpdateModelLoadingStatusResponse>(`/llmariner.models.server.v1.ModelsWorkerService/UpdateModelLoadingStatus`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static WatchModels(req: WatchModelsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<ModelEvent>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<WatchModelsRequest, ModelEvent>(`/llmariner.models.server.v1.ModelsWorkerService/WatchModels`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static AcquireObjectDeletionTask(req: AcquireObjectDeletionTaskRequest, initReq?: fm.InitReq): Promise<AcquireObjectDeletionTaskResponse> {
+    return fm.fetchReq<AcquireObjectDeletionTaskRequest, AcquireObjectDeletionTaskResponse>(`/llmariner.models.server.v1.ModelsWorkerService/AcquireObjectDeletionTask`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static CompleteObjectDeletionTask(req: CompleteObjectDeletionTaskRequest, initReq?: fm.InitReq): Promise<CompleteObjectDeletionTaskResponse> {
+    return fm.fetchReq<CompleteObjectDeletionTaskRequest, CompleteObjectDeletionTaskResponse>(`/llmariner.models.server.v1.ModelsWorkerService/CompleteObjectDeletionTask`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static ListModelPaths(req: ListModelPathsRequest, initReq?: fm.InitReq): Promise<ListModelPathsResponse> {
+    return fm.fetchReq<ListModelPathsRequest, ListModelPathsResponse>(`/llmariner.models.server.v1.ModelsWorkerService/ListModelPaths`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static ListLoadedModelPaths(req: ListLoadedModelPathsRequest, initReq?: fm.InitReq): Promise<ListLoadedModelPathsResponse> {
+    return fm.fetchReq<ListLoadedModelPathsRequest, ListLoadedModelPathsResponse>(`/llmariner.models.server.v1.ModelsWorkerService/ListLoadedModelPaths`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static UpdateModelDegradation(req: UpdateModelDegradationRequest, initReq?: fm.InitReq): Promise<UpdateModelDegradationResponse> {
+    return fm.fetchReq<UpdateModelDegradationRequest, UpdateModelDegradationResponse>(`/llmariner.models.server.v1.ModelsWorkerService/UpdateModelDegradation`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static GetModelFiles(req: GetModelFilesRequest, initReq?: fm.InitReq): Promise<GetModelFilesResponse> {
+    return fm.fetchReq<GetModelFilesRequest, GetModelFilesResponse>(`/llmariner.models.server.v1.ModelsWorkerService/GetModelFiles`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
 }
\ No newline at end of file